@@ -15,15 +15,25 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	dotOutput       = "dot"
+	jsonGraphOutput = "json-graph"
+)
+
 type edgesOptions struct {
-	namespace    string
-	outputFormat string
+	namespace     string
+	outputFormat  string
+	timeWindow    string
+	allNamespaces bool
+	labelSelector string
 }
 
 func newEdgesOptions() *edgesOptions {
 	return &edgesOptions{
-		namespace:    "",
-		outputFormat: tableOutput,
+		namespace:     "",
+		outputFormat:  tableOutput,
+		timeWindow:    "1m",
+		allNamespaces: false,
 	}
 }
 
@@ -59,7 +69,10 @@ func newCmdEdges() *cobra.Command {
   * replicationcontrollers
   * statefulsets`,
 		Example: `  # Get all edges between pods in the test namespace.
-  linkerd edges po -n test`,
+  linkerd edges po -n test
+
+  # Render the dependencies between all meshed deployments with Graphviz.
+  linkerd edges deploy --all-namespaces -o dot | dot -Tsvg > mesh.svg`,
 		Args:      cobra.ExactArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -100,7 +113,10 @@ func newCmdEdges() *cobra.Command {
 	}
 
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the specified resource")
-	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\"")
+	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\", \"json\", \"dot\" or \"json-graph\"")
+	cmd.PersistentFlags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Stat window for the traffic over each edge (for example: \"10s\", \"1m\", \"10m\", \"1h\")")
+	cmd.PersistentFlags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If present, returns edges across all namespaces, ignoring the \"--namespace\" flag")
+	addLabelSelectorFlag(cmd.PersistentFlags(), &options.labelSelector)
 	return cmd
 }
 
@@ -118,10 +134,10 @@ func validateEdgesRequestInputs(targets []pb.Resource, options *edgesOptions) er
 	}
 
 	switch options.outputFormat {
-	case tableOutput, jsonOutput:
+	case tableOutput, jsonOutput, dotOutput, jsonGraphOutput:
 		return nil
 	default:
-		return fmt.Errorf("--output currently only supports %s and %s", tableOutput, jsonOutput)
+		return fmt.Errorf("--output currently only supports %s, %s, %s and %s", tableOutput, jsonOutput, dotOutput, jsonGraphOutput)
 	}
 }
 
@@ -139,8 +155,11 @@ func buildEdgesRequests(resources []string, options *edgesOptions) ([]*pb.EdgesR
 	requests := make([]*pb.EdgesRequest, 0)
 	for _, target := range targets {
 		requestParams := util.EdgesRequestParams{
-			ResourceType: target.Type,
-			Namespace:    options.namespace,
+			ResourceType:  target.Type,
+			Namespace:     options.namespace,
+			TimeWindow:    options.timeWindow,
+			AllNamespaces: options.allNamespaces,
+			LabelSelector: options.labelSelector,
 		}
 
 		req, err := util.BuildEdgesRequest(requestParams)
@@ -181,11 +200,28 @@ func renderEdgeStats(rows []*pb.Edge, options *edgesOptions) string {
 }
 
 type edgeRow struct {
-	src    string
-	dst    string
-	client string
-	server string
-	msg    string
+	src         string
+	dst         string
+	srcResource *pb.Resource
+	dstResource *pb.Resource
+	client      string
+	server      string
+	msg         string
+	protocol    string
+	*edgeStats
+	*edgeTCPStats
+}
+
+type edgeStats struct {
+	successRate float64
+	requestRate float64
+	latencyP99  uint64
+}
+
+type edgeTCPStats struct {
+	openConnections uint64
+	readBytesRate   float64
+	writeBytesRate  float64
 }
 
 const (
@@ -196,6 +232,15 @@ const (
 	msgHeader    = "MSG"
 )
 
+// edgeName returns how the table and JSON outputs refer to a resource, which
+// only includes its namespace when edges may span namespaces.
+func edgeName(resource *pb.Resource, options *edgesOptions) string {
+	if options.allNamespaces {
+		return resource.Namespace + "/" + resource.Name
+	}
+	return resource.Name
+}
+
 func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOptions) {
 	maxSrcLength := len(srcHeader)
 	maxDstLength := len(dstHeader)
@@ -223,20 +268,38 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 			}
 
 			row := edgeRow{
-				client: clientID,
-				server: serverID,
-				msg:    msg,
-				src:    r.Src.Name,
-				dst:    r.Dst.Name,
+				client:      clientID,
+				server:      serverID,
+				msg:         msg,
+				src:         edgeName(r.Src, options),
+				dst:         edgeName(r.Dst, options),
+				srcResource: r.Src,
+				dstResource: r.Dst,
+				protocol:    r.Protocol,
+			}
+
+			if r.Stats != nil {
+				row.edgeStats = &edgeStats{
+					successRate: getSuccessRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount()),
+					requestRate: getRequestRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount(), r.GetTimeWindow()),
+					latencyP99:  r.Stats.GetLatencyMsP99(),
+				}
+			}
+			if r.TcpStats != nil {
+				row.edgeTCPStats = &edgeTCPStats{
+					openConnections: r.TcpStats.GetOpenConnections(),
+					readBytesRate:   getByteRate(r.TcpStats.GetReadBytesTotal(), r.GetTimeWindow()),
+					writeBytesRate:  getByteRate(r.TcpStats.GetWriteBytesTotal(), r.GetTimeWindow()),
+				}
 			}
 
 			edgeRows = append(edgeRows, row)
 
-			if len(r.Src.Name) > maxSrcLength {
-				maxSrcLength = len(r.Src.Name)
+			if len(row.src) > maxSrcLength {
+				maxSrcLength = len(row.src)
 			}
-			if len(r.Dst.Name) > maxDstLength {
-				maxDstLength = len(r.Dst.Name)
+			if len(row.dst) > maxDstLength {
+				maxDstLength = len(row.dst)
 			}
 			if len(clientID) > maxClientLength {
 				maxClientLength = len(clientID)
@@ -266,6 +329,10 @@ func writeEdgesToBuffer(rows []*pb.Edge, w *tabwriter.Writer, options *edgesOpti
 		printEdgeTable(edgeRows, w, maxSrcLength, maxDstLength, maxClientLength, maxServerLength, maxMsgLength)
 	case jsonOutput:
 		printEdgesJSON(edgeRows, w)
+	case dotOutput:
+		printEdgesDot(edgeRows, w)
+	case jsonGraphOutput:
+		printEdgesJSONGraph(edgeRows, w)
 	}
 }
 
@@ -279,6 +346,10 @@ func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxDs
 	headers := []string{
 		fmt.Sprintf(srcTemplate, srcHeader),
 		fmt.Sprintf(dstTemplate, dstHeader),
+		"SUCCESS",
+		"RPS",
+		"LATENCY_P99",
+		"TCP_CONN",
 		fmt.Sprintf(clientTemplate, clientHeader),
 		fmt.Sprintf(serverTemplate, serverHeader),
 		fmt.Sprintf(msgTemplate, msgHeader),
@@ -288,17 +359,30 @@ func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxDs
 
 	fmt.Fprintln(w, strings.Join(headers, "\t"))
 
+	templateString := fmt.Sprintf("%s\t%s\t%%s\t%%s\t%%s\t%%s\t%s\t%s\t%s\t\n", srcTemplate, dstTemplate, clientTemplate, serverTemplate, msgTemplate)
+
 	for _, row := range edgeRows {
-		values := make([]interface{}, 0)
-		templateString := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t\n", srcTemplate, dstTemplate, clientTemplate, serverTemplate, msgTemplate)
+		success, rps, latency, conns := "-", "-", "-", "-"
+		if row.edgeStats != nil {
+			success = fmt.Sprintf("%.2f%%", row.successRate*100)
+			rps = fmt.Sprintf("%.1frps", row.requestRate)
+			latency = fmt.Sprintf("%dms", row.latencyP99)
+		}
+		if row.edgeTCPStats != nil {
+			conns = fmt.Sprintf("%d", row.openConnections)
+		}
 
-		values = append(values, []interface{}{
+		values := []interface{}{
 			row.src,
 			row.dst,
+			success,
+			rps,
+			latency,
+			conns,
 			row.client,
 			row.server,
 			row.msg,
-		}...)
+		}
 
 		fmt.Fprintf(w, templateString, values...)
 
@@ -308,7 +392,7 @@ func printEdgeTable(edgeRows []edgeRow, w *tabwriter.Writer, maxSrcLength, maxDs
 func renderEdges(buffer bytes.Buffer, options *edgesOptions) string {
 	var out string
 	switch options.outputFormat {
-	case jsonOutput:
+	case jsonOutput, dotOutput, jsonGraphOutput:
 		out = buffer.String()
 	default:
 		// strip left padding on the first column
@@ -320,11 +404,40 @@ func renderEdges(buffer bytes.Buffer, options *edgesOptions) string {
 }
 
 type edgesJSONStats struct {
-	Src    string `json:"src"`
-	Dst    string `json:"dst"`
-	Client string `json:"client_id"`
-	Server string `json:"server_id"`
-	Msg    string `json:"no_tls_reason"`
+	Src            string   `json:"src"`
+	Dst            string   `json:"dst"`
+	Client         string   `json:"client_id"`
+	Server         string   `json:"server_id"`
+	Msg            string   `json:"no_tls_reason"`
+	Protocol       string   `json:"protocol"`
+	Success        *float64 `json:"success"`
+	Rps            *float64 `json:"rps"`
+	LatencyMSp99   *uint64  `json:"latency_ms_p99"`
+	TCPConnections *uint64  `json:"tcp_open_connections"`
+	TCPReadBytes   *float64 `json:"tcp_read_bytes_rate"`
+	TCPWriteBytes  *float64 `json:"tcp_write_bytes_rate"`
+}
+
+func newEdgesJSONStats(row edgeRow, src, dst string) *edgesJSONStats {
+	entry := &edgesJSONStats{
+		Src:      src,
+		Dst:      dst,
+		Client:   row.client,
+		Server:   row.server,
+		Msg:      row.msg,
+		Protocol: row.protocol,
+	}
+	if row.edgeStats != nil {
+		entry.Success = &row.successRate
+		entry.Rps = &row.requestRate
+		entry.LatencyMSp99 = &row.latencyP99
+	}
+	if row.edgeTCPStats != nil {
+		entry.TCPConnections = &row.openConnections
+		entry.TCPReadBytes = &row.readBytesRate
+		entry.TCPWriteBytes = &row.writeBytesRate
+	}
+	return entry
 }
 
 func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
@@ -332,13 +445,7 @@ func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
 	entries := []*edgesJSONStats{}
 
 	for _, row := range edgeRows {
-		entry := &edgesJSONStats{
-			Src:    row.src,
-			Dst:    row.dst,
-			Client: row.client,
-			Server: row.server,
-			Msg:    row.msg}
-		entries = append(entries, entry)
+		entries = append(entries, newEdgesJSONStats(row, row.src, row.dst))
 	}
 
 	b, err := json.MarshalIndent(entries, "", "  ")
@@ -348,3 +455,110 @@ func printEdgesJSON(edgeRows []edgeRow, w *tabwriter.Writer) {
 	}
 	fmt.Fprintf(w, "%s\n", b)
 }
+
+// edgeNodeID identifies a resource in the graph outputs, which always include
+// the namespace so that graphs from different namespaces can be merged.
+func edgeNodeID(resource *pb.Resource) string {
+	if resource.Namespace == "" {
+		return resource.Name
+	}
+	return resource.Namespace + "/" + resource.Name
+}
+
+func printEdgesDot(edgeRows []edgeRow, w *tabwriter.Writer) {
+	fmt.Fprintln(w, "digraph linkerd {")
+	for _, row := range edgeRows {
+		src, dst := edgeNodeID(row.srcResource), edgeNodeID(row.dstResource)
+		label := "-"
+		if row.edgeStats != nil {
+			label = fmt.Sprintf("%.2f%% %.1frps %dms", row.successRate*100, row.requestRate, row.latencyP99)
+		}
+		if row.protocol == "tcp" {
+			if row.edgeTCPStats != nil {
+				label = fmt.Sprintf("%d conns %.1fB/s read %.1fB/s written", row.openConnections, row.readBytesRate, row.writeBytesRate)
+			}
+			// TCP-only edges are dashed, to tell them apart from HTTP edges
+			fmt.Fprintf(w, "  %q -> %q [label=%q, style=dashed];\n", src, dst, label)
+			continue
+		}
+		fmt.Fprintf(w, "  %q -> %q [label=%q];\n", src, dst, label)
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// The json-graph output follows the JSON Graph Format,
+// see http://jsongraphformat.info
+type jsonGraph struct {
+	Graph jsonGraphBody `json:"graph"`
+}
+
+type jsonGraphBody struct {
+	Directed bool             `json:"directed"`
+	Nodes    []*jsonGraphNode `json:"nodes"`
+	Edges    []*jsonGraphEdge `json:"edges"`
+}
+
+type jsonGraphNode struct {
+	ID       string            `json:"id"`
+	Label    string            `json:"label"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+type jsonGraphEdge struct {
+	Source   string          `json:"source"`
+	Target   string          `json:"target"`
+	Metadata *edgesJSONStats `json:"metadata"`
+}
+
+func printEdgesJSONGraph(edgeRows []edgeRow, w *tabwriter.Writer) {
+	// avoid nil initialization so that an empty graph gets marshalled with
+	// empty arrays vs null
+	graph := jsonGraph{
+		Graph: jsonGraphBody{
+			Directed: true,
+			Nodes:    []*jsonGraphNode{},
+			Edges:    []*jsonGraphEdge{},
+		},
+	}
+
+	nodes := map[string]*jsonGraphNode{}
+	addNode := func(resource *pb.Resource) string {
+		id := edgeNodeID(resource)
+		if _, ok := nodes[id]; !ok {
+			nodes[id] = &jsonGraphNode{
+				ID:    id,
+				Label: resource.Name,
+				Metadata: map[string]string{
+					"namespace": resource.Namespace,
+					"type":      resource.Type,
+				},
+			}
+		}
+		return id
+	}
+
+	for _, row := range edgeRows {
+		src := addNode(row.srcResource)
+		dst := addNode(row.dstResource)
+
+		graph.Graph.Edges = append(graph.Graph.Edges, &jsonGraphEdge{
+			Source:   src,
+			Target:   dst,
+			Metadata: newEdgesJSONStats(row, src, dst),
+		})
+	}
+
+	for _, node := range nodes {
+		graph.Graph.Nodes = append(graph.Graph.Nodes, node)
+	}
+	sort.Slice(graph.Graph.Nodes, func(i, j int) bool {
+		return graph.Graph.Nodes[i].ID < graph.Graph.Nodes[j].ID
+	})
+
+	b, err := json.MarshalIndent(graph, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error marshalling JSON: %s\n", err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", b)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
 )
 
 type edgesParamsExp struct {
@@ -73,10 +74,66 @@ func TestEdges(t *testing.T) {
 		}, t)
 	})
 
-	t.Run("Returns an error if outputFormat specified is not table or json", func(t *testing.T) {
+	options.outputFormat = dotOutput
+	t.Run("Returns edges (dot)", func(t *testing.T) {
+		testEdgesCall(edgesParamsExp{
+			options:      options,
+			resourceType: "pod",
+			resSrc:       resSrc,
+			resDst:       resDst,
+			resClient:    resClient,
+			resServer:    resServer,
+			resMsg:       resMsg,
+			file:         "edges_one_output_dot.golden",
+		}, t)
+	})
+
+	options.outputFormat = jsonGraphOutput
+	t.Run("Returns edges (json-graph)", func(t *testing.T) {
+		testEdgesCall(edgesParamsExp{
+			options:      options,
+			resourceType: "pod",
+			resSrc:       resSrc,
+			resDst:       resDst,
+			resClient:    resClient,
+			resServer:    resServer,
+			resMsg:       resMsg,
+			file:         "edges_one_output_json_graph.golden",
+		}, t)
+	})
+
+	t.Run("Returns TCP-only edges", func(t *testing.T) {
+		response := public.GenEdgesResponse("pod", resSrc, resDst, resClient, resServer, resMsg)
+		okResp := response.GetOk()
+		okResp.Edges = append(okResp.Edges, &pb.Edge{
+			Src:        &pb.Resource{Name: "web-57b7f9db85-297dw", Type: "pod"},
+			Dst:        &pb.Resource{Name: "redis-7b8d7c6bd9-xk5wn", Type: "pod"},
+			ClientId:   "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			ServerId:   "redis.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			TimeWindow: "1m",
+			Protocol:   "tcp",
+			TcpStats: &pb.TcpStats{
+				OpenConnections: 3,
+				ReadBytesTotal:  6000,
+				WriteBytesTotal: 1200,
+			},
+		})
+
+		for format, file := range map[string]string{
+			tableOutput: "edges_tcp_output.golden",
+			dotOutput:   "edges_tcp_output_dot.golden",
+		} {
+			options := newEdgesOptions()
+			options.outputFormat = format
+			output := renderEdgeStats(edgesRespToRows(&response), options)
+			diffTestdata(t, file, output)
+		}
+	})
+
+	t.Run("Returns an error if outputFormat specified is not supported", func(t *testing.T) {
 		options.outputFormat = wideOutput
 		args := []string{"pod"}
-		expectedError := "--output currently only supports table and json"
+		expectedError := "--output currently only supports table, json, dot and json-graph"
 
 		_, err := buildEdgesRequests(args, options)
 		if err == nil || err.Error() != expectedError {
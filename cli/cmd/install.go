@@ -57,8 +57,11 @@ type (
 		LinkerdNamespaceLabel    string
 		ControllerUID            int64
 		EnableH2Upgrade          bool
+		EnableAuthz              bool
 		NoInitContainer          bool
 		WebhookFailurePolicy     string
+		TopLevelOwnerKinds       string
+		OwnerResources           []ownerResource
 
 		Configs configJSONs
 
@@ -79,6 +82,10 @@ type (
 
 	configJSONs struct{ Global, Proxy, Install string }
 
+	// ownerResource is a resource that owner resolution may read, and that
+	// the control plane is granted access to.
+	ownerResource struct{ Group, Resource string }
+
 	resources   struct{ CPU, Memory constraints }
 	constraints struct{ Request, Limit string }
 
@@ -126,8 +133,11 @@ type (
 		highAvailability    bool
 		controllerUID       int64
 		disableH2Upgrade    bool
+		enableAuthz         bool
 		noInitContainer     bool
 		skipChecks          bool
+		topLevelOwnerKinds  string
+		ownerResources      string
 		identityOptions     *installIdentityOptions
 		*proxyConfigOptions
 
@@ -176,7 +186,10 @@ func newInstallOptionsWithDefaults() *installOptions {
 		highAvailability:    false,
 		controllerUID:       2103,
 		disableH2Upgrade:    false,
+		enableAuthz:         false,
 		noInitContainer:     false,
+		topLevelOwnerKinds:  strings.Join(k8s.DefaultTopLevelOwnerKinds, ","),
+		ownerResources:      "",
 		proxyConfigOptions: &proxyConfigOptions{
 			proxyVersion:           version.Version,
 			ignoreCluster:          false,
@@ -428,6 +441,18 @@ func (options *installOptions) recordableFlagSet() *pflag.FlagSet {
 		&options.disableH2Upgrade, "disable-h2-upgrade", options.disableH2Upgrade,
 		"Prevents the controller from instructing proxies to perform transparent HTTP/2 upgrading (default false)",
 	)
+	flags.BoolVar(
+		&options.enableAuthz, "enable-authz", options.enableAuthz,
+		"Require public API and tap callers to present a Kubernetes bearer token, and only serve them the namespaces RBAC allows them to read; the dashboard forwards the token of each user, so it must be reached through a proxy that authenticates users and sets it (default false)",
+	)
+	flags.StringVar(
+		&options.topLevelOwnerKinds, "top-level-owner-kinds", options.topLevelOwnerKinds,
+		"Comma-separated list of kinds at which the control plane stops resolving the owners of pods, even if they are owned by another object (e.g. \"deployment,rollout\" for Argo Rollouts)",
+	)
+	flags.StringVar(
+		&options.ownerResources, "owner-resources", options.ownerResources,
+		"Comma-separated list of custom resources, as resource.group, that the control plane is granted read access to in order to resolve the owners of pods through them (e.g. \"etcdclusters.etcd.database.coreos.com\")",
+	)
 	flags.DurationVar(
 		&options.identityOptions.issuanceLifetime, "identity-issuance-lifetime", options.identityOptions.issuanceLifetime,
 		"The amount of time for which the Identity issuer should certify identity",
@@ -522,9 +547,31 @@ func (options *installOptions) validate() error {
 		return errors.New("--proxy-log-level must not be empty")
 	}
 
+	if _, err := parseOwnerResources(options.ownerResources); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// parseOwnerResources parses a comma-separated list of resources, each
+// given as resource.group.
+func parseOwnerResources(list string) ([]ownerResource, error) {
+	resources := []ownerResource{}
+	for _, spec := range strings.Split(list, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		parts := strings.SplitN(spec, ".", 2)
+		if len(parts) != 2 || len(validation.IsDNS1123Label(parts[0])) > 0 || len(validation.IsDNS1123Subdomain(parts[1])) > 0 {
+			return nil, fmt.Errorf("--owner-resources must be a list of resource.group, was: %s", spec)
+		}
+		resources = append(resources, ownerResource{Group: parts[1], Resource: parts[0]})
+	}
+	return resources, nil
+}
+
 func (options *installOptions) handleHA() {
 	if options.highAvailability {
 		if options.controllerReplicas == defaultControllerReplicas {
@@ -549,6 +596,11 @@ func (options *installOptions) buildValuesWithoutIdentity(configs *pb.All) (*ins
 		return nil, err
 	}
 
+	ownerResources, err := parseOwnerResources(options.ownerResources)
+	if err != nil {
+		return nil, err
+	}
+
 	values := &installValues{
 		// Container images:
 		ControllerImage: fmt.Sprintf("%s/controller:%s", options.dockerRegistry, configs.GetGlobal().GetVersion()),
@@ -574,9 +626,12 @@ func (options *installOptions) buildValuesWithoutIdentity(configs *pb.All) (*ins
 		ControllerLogLevel:   options.controllerLogLevel,
 		ControllerUID:        options.controllerUID,
 		EnableH2Upgrade:      !options.disableH2Upgrade,
+		EnableAuthz:          options.enableAuthz,
 		NoInitContainer:      options.noInitContainer,
 		WebhookFailurePolicy: "Ignore",
 		PrometheusLogLevel:   toPromLogLevel(strings.ToLower(options.controllerLogLevel)),
+		TopLevelOwnerKinds:   options.topLevelOwnerKinds,
+		OwnerResources:       ownerResources,
 
 		Configs: configJSONs{
 			Global:  globalJSON,
@@ -654,6 +709,7 @@ func (values *installValues) render(w io.Writer, configs *pb.All) error {
 			{Name: "templates/controller-rbac.yaml"},
 			{Name: "templates/web-rbac.yaml"},
 			{Name: "templates/serviceprofile-crd.yaml"},
+			{Name: "templates/slo-crd.yaml"},
 			{Name: "templates/prometheus-rbac.yaml"},
 			{Name: "templates/grafana-rbac.yaml"},
 			{Name: "templates/proxy_injector-rbac.yaml"},
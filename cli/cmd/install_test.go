@@ -53,6 +53,9 @@ func TestRender(t *testing.T) {
 		LinkerdNamespaceLabel:    "LinkerdNamespaceLabel",
 		ControllerUID:            2103,
 		EnableH2Upgrade:          true,
+		EnableAuthz:              true,
+		TopLevelOwnerKinds:       "TopLevelOwnerKinds",
+		OwnerResources:           []ownerResource{{Group: "OwnerGroup", Resource: "ownerresources"}},
 		NoInitContainer:          false,
 		WebhookFailurePolicy:     "WebhookFailurePolicy",
 		Configs: configJSONs{
@@ -220,6 +223,32 @@ func TestValidate(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("Properly validates owner resources", func(t *testing.T) {
+		testCases := []struct {
+			input string
+			valid bool
+		}{
+			{"", true},
+			{"rollouts.argoproj.io", true},
+			{"rollouts.argoproj.io, etcdclusters.etcd.database.coreos.com", true},
+			{"rollouts", false},
+			{"Rollouts.argoproj.io", false},
+			{"rollouts.argoproj_io", false},
+		}
+
+		options := testInstallOptions()
+		for _, tc := range testCases {
+			options.ownerResources = tc.input
+			err := options.validate()
+			if tc.valid && err != nil {
+				t.Fatalf("Error not expected: %s", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatalf("Expected %q to be rejected, got nothing", tc.input)
+			}
+		}
+	})
 }
 
 func fakeGenerateWebhookTLS(webhook string) (*tlsValues, error) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/linkerd/linkerd2/pkg/profiles"
+	"github.com/linkerd/linkerd2/pkg/tap"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -20,6 +21,7 @@ type profileOptions struct {
 	tap           string
 	tapDuration   time.Duration
 	tapRouteLimit uint
+	tapReplay     string
 }
 
 func newProfileOptions() *profileOptions {
@@ -32,6 +34,7 @@ func newProfileOptions() *profileOptions {
 		tap:           "",
 		tapDuration:   5 * time.Second,
 		tapRouteLimit: 20,
+		tapReplay:     "",
 	}
 }
 
@@ -52,6 +55,9 @@ func (options *profileOptions) validate() error {
 	if outputs != 1 {
 		return errors.New("You must specify exactly one of --template or --open-api or --proto or --tap")
 	}
+	if options.tapReplay != "" && options.tap == "" {
+		return errors.New("--tap-replay can only be used with --tap")
+	}
 
 	// a DNS-1035 label must consist of lower case alphanumeric characters or '-',
 	// start with an alphabetic character, and end with an alphanumeric character
@@ -88,6 +94,9 @@ func newCmdProfile() *cobra.Command {
 
   # Generate a profile by watching live traffic based off tap data.
   linkerd profile -n emojivoto web-svc --tap deploy/web --tap-duration 10s --tap-route-limit 5
+
+  # Generate a profile from tap data recorded with "linkerd tap --record".
+  linkerd profile -n emojivoto web-svc --tap deploy/web --tap-replay web.tap
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -103,6 +112,9 @@ func newCmdProfile() *cobra.Command {
 			} else if options.openAPI != "" {
 				return profiles.RenderOpenAPI(options.openAPI, options.namespace, options.name, os.Stdout)
 			} else if options.tap != "" {
+				if options.tapReplay != "" {
+					return profiles.RenderTapOutputProfile(tap.NewReplayClient(options.tapReplay), options.tap, options.namespace, options.name, options.tapDuration, int(options.tapRouteLimit), os.Stdout)
+				}
 				return profiles.RenderTapOutputProfile(checkPublicAPIClientOrExit(), options.tap, options.namespace, options.name, options.tapDuration, int(options.tapRouteLimit), os.Stdout)
 			} else if options.proto != "" {
 				return profiles.RenderProto(options.proto, options.namespace, options.name, os.Stdout)
@@ -117,6 +129,7 @@ func newCmdProfile() *cobra.Command {
 	cmd.PersistentFlags().StringVar(&options.openAPI, "open-api", options.openAPI, "Output a service profile based on the given OpenAPI spec file")
 	cmd.PersistentFlags().StringVar(&options.tap, "tap", options.tap, "Output a service profile based on tap data for the given target resource")
 	cmd.PersistentFlags().DurationVar(&options.tapDuration, "tap-duration", options.tapDuration, "Duration over which tap data is collected (for example: \"10s\", \"1m\", \"10m\")")
+	cmd.PersistentFlags().StringVar(&options.tapReplay, "tap-replay", options.tapReplay, "Read the tap data from this file, recorded with \"linkerd tap --record\", instead of live traffic")
 	cmd.PersistentFlags().UintVar(&options.tapRouteLimit, "tap-route-limit", options.tapRouteLimit, "Max number of routes to add to the profile")
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the service")
 	cmd.PersistentFlags().StringVar(&options.proto, "proto", options.proto, "Output a service profile based on the given Protobuf spec file")
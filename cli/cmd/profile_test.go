@@ -140,4 +140,23 @@ func TestValidateOptions(t *testing.T) {
 	if err == nil || err.Error() != exp.Error() {
 		t.Fatalf("validateOptions returned unexpected error: %s (expected: %s) for options: %+v", err, exp, options)
 	}
+
+	options = newProfileOptions()
+	options.template = true
+	options.name = serviceName
+	options.tapReplay = "web.tap"
+	exp = errors.New("--tap-replay can only be used with --tap")
+	err = options.validate()
+	if err == nil || err.Error() != exp.Error() {
+		t.Fatalf("validateOptions returned unexpected error: %s (expected: %s) for options: %+v", err, exp, options)
+	}
+
+	options = newProfileOptions()
+	options.tap = "deploy/web"
+	options.name = serviceName
+	options.tapReplay = "web.tap"
+	err = options.validate()
+	if err != nil {
+		t.Fatalf("validateOptions returned unexpected error (%s) for options: %+v", err, options)
+	}
 }
@@ -108,6 +108,7 @@ func init() {
 	RootCmd.AddCommand(newCmdMetrics())
 	RootCmd.AddCommand(newCmdProfile())
 	RootCmd.AddCommand(newCmdRoutes())
+	RootCmd.AddCommand(newCmdSLO())
 	RootCmd.AddCommand(newCmdStat())
 	RootCmd.AddCommand(newCmdTap())
 	RootCmd.AddCommand(newCmdTop())
@@ -117,9 +118,10 @@ func init() {
 }
 
 type statOptionsBase struct {
-	namespace    string
-	timeWindow   string
-	outputFormat string
+	namespace     string
+	timeWindow    string
+	outputFormat  string
+	labelSelector string
 }
 
 func newStatOptionsBase() *statOptionsBase {
@@ -130,6 +132,14 @@ func newStatOptionsBase() *statOptionsBase {
 	}
 }
 
+// addLabelSelectorFlag registers the "--selector" flag, shared by the commands
+// that select resources, which restricts them to the resources whose labels
+// match.
+func addLabelSelectorFlag(flags *pflag.FlagSet, selector *string) {
+	flags.StringVar(selector, "selector", *selector,
+		"Only select resources whose labels match this selector, as passed to \"kubectl get --selector\" (for example: \"app=web,tier in (frontend,api)\")")
+}
+
 func (o *statOptionsBase) validateOutputFormat() error {
 	switch o.outputFormat {
 	case tableOutput, jsonOutput, wideOutput:
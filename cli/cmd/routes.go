@@ -51,7 +51,10 @@ This command will only display traffic which is sent to a service that has a Ser
   linkerd routes service/webapp -n test
 
   # Routes for calls from the traffic deployment to the webapp service in the test namespace.
-  linkerd routes deploy/traffic -n test --to svc/webapp`,
+  linkerd routes deploy/traffic -n test --to svc/webapp
+
+  # Routes for calls to the apex service of the webapp-split traffic split, from all clients.
+  linkerd routes ts/webapp-split -n test`,
 		Args:      cobra.ExactArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -75,6 +78,7 @@ This command will only display traffic which is sent to a service that has a Ser
 	cmd.PersistentFlags().StringVarP(&options.timeWindow, "time-window", "t", options.timeWindow, "Stat window (for example: \"10s\", \"1m\", \"10m\", \"1h\")")
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource, "If present, shows outbound stats to the specified resource")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace, "Sets the namespace used to lookup the \"--to\" resource; by default the current \"--namespace\" is used")
+	addLabelSelectorFlag(cmd.PersistentFlags(), &options.labelSelector)
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, fmt.Sprintf("Output format; one of: \"%s\", \"%s\", or \"%s\"", tableOutput, wideOutput, jsonOutput))
 
 	return cmd
@@ -302,10 +306,11 @@ func buildTopRoutesRequest(resource string, options *routesOptions) (*pb.TopRout
 
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:   options.timeWindow,
-			ResourceName: target.Name,
-			ResourceType: target.Type,
-			Namespace:    options.namespace,
+			TimeWindow:    options.timeWindow,
+			ResourceName:  target.Name,
+			ResourceType:  target.Type,
+			Namespace:     options.namespace,
+			LabelSelector: options.labelSelector,
 		},
 	}
 
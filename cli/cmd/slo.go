@@ -0,0 +1,285 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/spf13/cobra"
+)
+
+type sloOptions struct {
+	namespace     string
+	allNamespaces bool
+	outputFormat  string
+}
+
+func newSLOOptions() *sloOptions {
+	return &sloOptions{
+		namespace:     "default",
+		allNamespaces: false,
+		outputFormat:  tableOutput,
+	}
+}
+
+func newCmdSLO() *cobra.Command {
+	options := newSLOOptions()
+
+	cmd := &cobra.Command{
+		Use:   "slo [flags] [NAME]",
+		Short: "Display error budgets and burn rates of service level objectives",
+		Long: `Display error budgets and burn rates of service level objectives.
+
+  Service level objectives are ServiceLevelObjective resources that name a
+  resource, and optionally one of its routes, together with the ratio of
+  requests that must succeed or complete within a latency threshold over a
+  window.
+
+  BUDGET is the share of the window's error budget that is left, and the
+  burn rates show how fast the budget is being spent over shorter windows:
+  a burn rate of 1 spends exactly the whole budget over the objective's
+  window.`,
+		Example: `  # Get all objectives in the emojivoto namespace.
+  linkerd slo -n emojivoto
+
+  # Get a single objective.
+  linkerd slo -n emojivoto web-availability
+
+  # Get all objectives in all namespaces, as JSON.
+  linkerd slo --all-namespaces -o json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := buildSLOStatusRequest(args, options)
+			if err != nil {
+				return fmt.Errorf("Error creating SLO status request: %s", err)
+			}
+
+			resp, err := requestSLOStatusFromAPI(checkPublicAPIClientOrExit(), req)
+			if err != nil {
+				return err
+			}
+
+			statuses := resp.GetOk().GetSlos()
+			if len(statuses) == 0 && options.outputFormat == tableOutput {
+				fmt.Fprintln(os.Stderr, "No service level objectives found.")
+				return nil
+			}
+
+			_, err = fmt.Print(renderSLOStatuses(statuses, options))
+			return err
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace, "Namespace of the specified objectives")
+	cmd.PersistentFlags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If present, returns objectives across all namespaces, ignoring the \"--namespace\" flag")
+	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\"")
+	return cmd
+}
+
+func buildSLOStatusRequest(args []string, options *sloOptions) (*pb.SLOStatusRequest, error) {
+	switch options.outputFormat {
+	case tableOutput, jsonOutput:
+	default:
+		return nil, fmt.Errorf("--output currently only supports %s and %s", tableOutput, jsonOutput)
+	}
+
+	req := &pb.SLOStatusRequest{Namespace: options.namespace}
+	if options.allNamespaces {
+		if len(args) > 0 {
+			return nil, fmt.Errorf("a named objective cannot be combined with --all-namespaces; remove %s from query", args[0])
+		}
+		req.Namespace = ""
+	}
+	if len(args) > 0 {
+		req.Name = args[0]
+	}
+
+	return req, nil
+}
+
+func requestSLOStatusFromAPI(client pb.ApiClient, req *pb.SLOStatusRequest) (*pb.SLOStatusResponse, error) {
+	resp, err := client.SLOStatus(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("SLOStatus API error: %+v", err)
+	}
+	if e := resp.GetError(); e != nil {
+		return nil, fmt.Errorf("SLOStatus API response error: %+v", e.Error)
+	}
+	return resp, nil
+}
+
+func renderSLOStatuses(statuses []*pb.SLOStatus, options *sloOptions) string {
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', tabwriter.AlignRight)
+
+	switch options.outputFormat {
+	case jsonOutput:
+		printSLOStatusJSON(statuses, w)
+	default:
+		printSLOStatusTable(statuses, w, options)
+	}
+	w.Flush()
+
+	if options.outputFormat == jsonOutput {
+		return buffer.String()
+	}
+
+	// strip left padding on the first column
+	out := string(buffer.Bytes()[padding:])
+	out = strings.Replace(out, "\n"+strings.Repeat(" ", padding), "\n", -1)
+
+	// errors are listed after the table so they don't stretch its columns
+	for _, status := range statuses {
+		if status.Error != "" {
+			out += fmt.Sprintf("Error evaluating %s/%s: %s\n", status.Namespace, status.Name, status.Error)
+		}
+	}
+	return out
+}
+
+func printSLOStatusTable(statuses []*pb.SLOStatus, w *tabwriter.Writer, options *sloOptions) {
+	headers := []string{"NAME", "TARGET", "OBJECTIVE", "WINDOW", "SLI", "BUDGET", "BURN_RATES"}
+	if options.allNamespaces {
+		headers = append([]string{"NAMESPACE"}, headers...)
+	}
+
+	rows := make([][]string, 0, len(statuses))
+	for _, status := range statuses {
+		row := []string{
+			status.Name,
+			sloTargetName(status),
+			sloObjectiveString(status),
+			status.Window,
+			"-",
+			"-",
+			"-",
+		}
+		if status.Error == "" {
+			if status.TotalCount > 0 {
+				row[4] = fmt.Sprintf("%.2f%%", 100*float64(status.GoodCount)/float64(status.TotalCount))
+			}
+			row[5] = fmt.Sprintf("%.2f%%", 100*status.ErrorBudgetRemaining)
+			row[6] = sloBurnRatesString(status.BurnRates)
+		}
+		if options.allNamespaces {
+			row = append([]string{status.Namespace}, row...)
+		}
+		rows = append(rows, row)
+	}
+
+	// pad each column to its widest value, to left-align it in the
+	// right-aligned tabwriter
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = len(header)
+		for _, row := range rows {
+			if len(row[i]) > widths[i] {
+				widths[i] = len(row[i])
+			}
+		}
+	}
+
+	for _, row := range append([][]string{headers}, rows...) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		fmt.Fprintf(w, "%s\t\n", strings.Join(cells, "\t"))
+	}
+}
+
+func sloTargetName(status *pb.SLOStatus) string {
+	if status.Target == nil {
+		return "-"
+	}
+	name := fmt.Sprintf("%s/%s", k8s.ShortNameFromCanonicalResourceName(status.Target.Type), status.Target.Name)
+	if status.Route != "" {
+		name = fmt.Sprintf("%s [%s]", name, status.Route)
+	}
+	return name
+}
+
+func sloObjectiveString(status *pb.SLOStatus) string {
+	if status.Objective == 0 {
+		return "-"
+	}
+	if status.LatencyThresholdMs > 0 {
+		return fmt.Sprintf("%.2f%% < %dms", 100*status.Objective, status.LatencyThresholdMs)
+	}
+	return fmt.Sprintf("%.2f%% success", 100*status.Objective)
+}
+
+func sloBurnRatesString(burnRates []*pb.SLOStatus_BurnRate) string {
+	if len(burnRates) == 0 {
+		return "-"
+	}
+	rates := make([]string, len(burnRates))
+	for i, burnRate := range burnRates {
+		rates[i] = fmt.Sprintf("%s:%.2f", burnRate.Window, burnRate.Rate)
+	}
+	return strings.Join(rates, " ")
+}
+
+type jsonSLOStatus struct {
+	Namespace            string             `json:"namespace"`
+	Name                 string             `json:"name"`
+	Target               string             `json:"target,omitempty"`
+	Route                string             `json:"route,omitempty"`
+	Objective            float64            `json:"objective,omitempty"`
+	LatencyThresholdMs   uint32             `json:"latency_threshold_ms,omitempty"`
+	Window               string             `json:"window"`
+	GoodCount            uint64             `json:"good_count"`
+	TotalCount           uint64             `json:"total_count"`
+	Sli                  *float64           `json:"sli"`
+	ErrorBudgetRemaining *float64           `json:"error_budget_remaining"`
+	BurnRates            map[string]float64 `json:"burn_rates,omitempty"`
+	Error                string             `json:"error,omitempty"`
+}
+
+func printSLOStatusJSON(statuses []*pb.SLOStatus, w *tabwriter.Writer) {
+	// avoid nil initialization so that if there are no objectives it gets marshalled as an empty array vs null
+	entries := []*jsonSLOStatus{}
+
+	for _, status := range statuses {
+		entry := &jsonSLOStatus{
+			Namespace:          status.Namespace,
+			Name:               status.Name,
+			Route:              status.Route,
+			Objective:          status.Objective,
+			LatencyThresholdMs: status.LatencyThresholdMs,
+			Window:             status.Window,
+			GoodCount:          status.GoodCount,
+			TotalCount:         status.TotalCount,
+			Error:              status.Error,
+		}
+		if status.Target != nil {
+			entry.Target = fmt.Sprintf("%s/%s", status.Target.Type, status.Target.Name)
+		}
+		if status.Error == "" {
+			budget := status.ErrorBudgetRemaining
+			entry.ErrorBudgetRemaining = &budget
+			if status.TotalCount > 0 {
+				sli := float64(status.GoodCount) / float64(status.TotalCount)
+				entry.Sli = &sli
+			}
+			entry.BurnRates = make(map[string]float64)
+			for _, burnRate := range status.BurnRates {
+				entry.BurnRates[burnRate.Window] = burnRate.Rate
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error marshalling JSON: %s\n", err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", b)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+func genSLOStatusResponse() pb.SLOStatusResponse {
+	return pb.SLOStatusResponse{
+		Response: &pb.SLOStatusResponse_Ok_{
+			Ok: &pb.SLOStatusResponse_Ok{
+				Slos: []*pb.SLOStatus{
+					{
+						Name:                 "web-availability",
+						Namespace:            "emojivoto",
+						Target:               &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: "web"},
+						Objective:            0.999,
+						Window:               "30d",
+						GoodCount:            99950,
+						TotalCount:           100000,
+						ErrorBudgetRemaining: 0.5,
+						BurnRates: []*pb.SLOStatus_BurnRate{
+							{Window: "5m", Rate: 0},
+							{Window: "1h", Rate: 1.25},
+							{Window: "6h", Rate: 0.5},
+						},
+					},
+					{
+						Name:                 "web-list-latency",
+						Namespace:            "emojivoto",
+						Target:               &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: "web"},
+						Route:                "GET /api/list",
+						Objective:            0.99,
+						LatencyThresholdMs:   100,
+						Window:               "7d",
+						ErrorBudgetRemaining: 1,
+						BurnRates: []*pb.SLOStatus_BurnRate{
+							{Window: "1h", Rate: 0},
+						},
+					},
+					{
+						Name:      "voting-latency",
+						Namespace: "emojivoto",
+						Target:    &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: "voting"},
+						Objective: 0.99,
+						Window:    "30d",
+						Error:     "latency threshold [250ms] is not a latency histogram bucket bound",
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSLO(t *testing.T) {
+	response := genSLOStatusResponse()
+	mockClient := &public.MockAPIClient{SLOStatusResponseToReturn: &response}
+
+	testCases := []struct {
+		outputFormat  string
+		allNamespaces bool
+		file          string
+	}{
+		{tableOutput, false, "slo_output.golden"},
+		{tableOutput, true, "slo_all_namespaces_output.golden"},
+		{jsonOutput, false, "slo_output_json.golden"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.file, func(t *testing.T) {
+			options := newSLOOptions()
+			options.namespace = "emojivoto"
+			options.outputFormat = tc.outputFormat
+			options.allNamespaces = tc.allNamespaces
+
+			req, err := buildSLOStatusRequest([]string{}, options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			resp, err := requestSLOStatusFromAPI(mockClient, req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			diffTestdata(t, tc.file, renderSLOStatuses(resp.GetOk().GetSlos(), options))
+		})
+	}
+
+	t.Run("Builds requests from the namespace flags", func(t *testing.T) {
+		options := newSLOOptions()
+		options.namespace = "emojivoto"
+
+		req, err := buildSLOStatusRequest([]string{"web-availability"}, options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if req.Namespace != "emojivoto" || req.Name != "web-availability" {
+			t.Fatalf("Unexpected request: %+v", req)
+		}
+
+		options.allNamespaces = true
+		req, err = buildSLOStatusRequest([]string{}, options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if req.Namespace != "" {
+			t.Fatalf("Expected request across all namespaces, got: %+v", req)
+		}
+	})
+
+	t.Run("Returns an error for invalid options", func(t *testing.T) {
+		options := newSLOOptions()
+		options.allNamespaces = true
+		expectedError := "a named objective cannot be combined with --all-namespaces; remove web-availability from query"
+		_, err := buildSLOStatusRequest([]string{"web-availability"}, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+
+		options = newSLOOptions()
+		options.outputFormat = wideOutput
+		expectedError = "--output currently only supports table and json"
+		_, err = buildSLOStatusRequest([]string{}, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+}
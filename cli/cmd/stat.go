@@ -5,7 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"os"
+	"path"
 	"sort"
 	"strings"
 	"text/tabwriter"
@@ -20,11 +22,15 @@ import (
 
 type statOptions struct {
 	statOptionsBase
-	toNamespace   string
-	toResource    string
-	fromNamespace string
-	fromResource  string
-	allNamespaces bool
+	toNamespace        string
+	toResource         string
+	fromNamespace      string
+	fromResource       string
+	allNamespaces      bool
+	history            string
+	historyStep        string
+	latencyPercentiles []string
+	byStatus           bool
 }
 
 type indexedResults struct {
@@ -35,12 +41,16 @@ type indexedResults struct {
 
 func newStatOptions() *statOptions {
 	return &statOptions{
-		statOptionsBase: *newStatOptionsBase(),
-		toNamespace:     "",
-		toResource:      "",
-		fromNamespace:   "",
-		fromResource:    "",
-		allNamespaces:   false,
+		statOptionsBase:    *newStatOptionsBase(),
+		toNamespace:        "",
+		toResource:         "",
+		fromNamespace:      "",
+		fromResource:       "",
+		allNamespaces:      false,
+		history:            "",
+		historyStep:        "",
+		latencyPercentiles: []string{},
+		byStatus:           false,
 	}
 }
 
@@ -68,6 +78,7 @@ func newCmdStat() *cobra.Command {
   * po mypod1 mypod2
   * rc/my-replication-controller
   * sts/my-statefulset
+  * ts/my-split
   * authority
   * au/my-authority
   * all
@@ -82,6 +93,7 @@ func newCmdStat() *cobra.Command {
   * statefulsets
   * authorities (not supported in --from)
   * services (only supported if a --from is also specified, or as a --to)
+  * trafficsplits (not supported in --from or --to, or as a target of --to)
   * all (all resource types, not supported in --from or --to)
 
 This command will hide resources that have completed, such as pods that are in the Succeeded or Failed phases.
@@ -117,10 +129,33 @@ If no resource name is specified, displays stats about all resources of the spec
   linkerd stat namespaces --from ns/default
 
   # Get all inbound stats to the test namespace.
-  linkerd stat ns/test`,
+  linkerd stat ns/test
+
+  # Get all deployments in the test namespace labeled tier=backend.
+  linkerd stat deploy -n test --selector tier=backend
+
+  # Get the p99.9 latency of all deployments in the test namespace, as estimated from the latency histogram.
+  linkerd stat deploy -n test -o wide --latency-percentiles p999
+
+  # Get the weight and actual share of requests of each leaf of the web-split traffic split.
+  linkerd stat ts/web-split -n test
+
+  # Get the request rate of each HTTP status class and gRPC status code of all deployments in the test namespace.
+  linkerd stat deploy -n test --by-status
+
+  # Get the last hour of inbound stats to the web deployment, one point every 5 minutes.
+  linkerd stat deploy/web --history 1h --history-step 5m`,
 		Args:      cobra.MinimumNArgs(1),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.history != "" {
+				// unless explicitly set, each point aggregates over one step
+				if !cmd.Flags().Changed("time-window") {
+					options.timeWindow = ""
+				}
+				return runStatHistory(args, options)
+			}
+
 			reqs, err := buildStatSummaryRequests(args, options)
 			if err != nil {
 				return fmt.Errorf("error creating metrics request while making stats request: %v", err)
@@ -164,7 +199,12 @@ If no resource name is specified, displays stats about all resources of the spec
 	cmd.PersistentFlags().StringVar(&options.fromResource, "from", options.fromResource, "If present, restricts outbound stats from the specified resource name")
 	cmd.PersistentFlags().StringVar(&options.fromNamespace, "from-namespace", options.fromNamespace, "Sets the namespace used from lookup the \"--from\" resource; by default the current \"--namespace\" is used")
 	cmd.PersistentFlags().BoolVar(&options.allNamespaces, "all-namespaces", options.allNamespaces, "If present, returns stats across all namespaces, ignoring the \"--namespace\" flag")
+	addLabelSelectorFlag(cmd.PersistentFlags(), &options.labelSelector)
 	cmd.PersistentFlags().StringVarP(&options.outputFormat, "output", "o", options.outputFormat, "Output format; one of: \"table\" or \"json\" or \"wide\"")
+	cmd.PersistentFlags().StringSliceVar(&options.latencyPercentiles, "latency-percentiles", options.latencyPercentiles, "Additional latency percentiles to display with \"-o wide\" or \"-o json\" (for example: \"p90,p999\")")
+	cmd.PersistentFlags().BoolVar(&options.byStatus, "by-status", options.byStatus, "If present, breaks down the request rate by HTTP status class and gRPC status code")
+	cmd.PersistentFlags().StringVar(&options.history, "history", options.history, "If present, displays how stats evolved over this duration (for example: \"30m\", \"1h\", \"6h\")")
+	cmd.PersistentFlags().StringVar(&options.historyStep, "history-step", options.historyStep, fmt.Sprintf("Interval between points of the \"--history\"; by default the history is split into %d points", historyPoints))
 
 	return cmd
 }
@@ -213,11 +253,24 @@ type rowStats struct {
 	tcpOpenConnections uint64
 	tcpReadBytes       float64
 	tcpWriteBytes      float64
+	latencyPercentiles []float64
+	httpStatusRates    map[string]float64
+	grpcStatusRates    map[string]float64
 }
 
 type row struct {
 	meshed string
 	*rowStats
+	*tsStats
+}
+
+// tsStats are the stats specific to a leaf of a TrafficSplit.
+type tsStats struct {
+	apex   string
+	leaf   string
+	weight string
+	// share is the leaf's share of the requests sent to the split
+	share float64
 }
 
 var (
@@ -248,6 +301,10 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 
 		namespace := r.Resource.Namespace
 		key := fmt.Sprintf("%s/%s", namespace, name)
+		if r.TsStats != nil {
+			// each leaf of a split has its own row
+			key = fmt.Sprintf("%s/%s", key, r.TsStats.Leaf)
+		}
 		resourceKey := r.Resource.Type
 
 		if _, ok := statTables[resourceKey]; !ok {
@@ -263,13 +320,21 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 		}
 
 		meshedCount := fmt.Sprintf("%d/%d", r.MeshedPodCount, r.RunningPodCount)
-		if resourceKey == k8s.Authority {
+		if resourceKey == k8s.Authority || resourceKey == k8s.TrafficSplit {
 			meshedCount = "-"
 		}
 		statTables[resourceKey][key] = &row{
 			meshed: meshedCount,
 		}
 
+		if r.TsStats != nil {
+			statTables[resourceKey][key].tsStats = &tsStats{
+				apex:   r.TsStats.Apex,
+				leaf:   r.TsStats.Leaf,
+				weight: r.TsStats.Weight,
+			}
+		}
+
 		if r.Stats != nil {
 			statTables[resourceKey][key].rowStats = &rowStats{
 				requestRate:        getRequestRate(r.Stats.GetSuccessCount(), r.Stats.GetFailureCount(), r.TimeWindow),
@@ -280,19 +345,30 @@ func writeStatsToBuffer(rows []*pb.StatTable_PodGroup_Row, w *tabwriter.Writer,
 				tcpOpenConnections: r.GetTcpStats().GetOpenConnections(),
 				tcpReadBytes:       getByteRate(r.GetTcpStats().GetReadBytesTotal(), r.TimeWindow),
 				tcpWriteBytes:      getByteRate(r.GetTcpStats().GetWriteBytesTotal(), r.TimeWindow),
+				latencyPercentiles: getLatencyPercentiles(r.Stats.GetLatencyHistogram(), options.latencyPercentiles),
+				httpStatusRates:    getStatusRates(r.GetStatusBreakdown().GetHttpStatusClasses(), r.TimeWindow),
+				grpcStatusRates:    getStatusRates(r.GetStatusBreakdown().GetGrpcStatusCodes(), r.TimeWindow),
 			}
 		}
 	}
 
+	if splits, ok := statTables[k8s.TrafficSplit]; ok {
+		setTrafficSplitShares(splits)
+	}
+
 	switch options.outputFormat {
 	case tableOutput, wideOutput:
 		if len(statTables) == 0 {
 			fmt.Fprintln(os.Stderr, "No traffic found.")
 			os.Exit(0)
 		}
+		if options.byStatus {
+			printStatusTables(statTables, w, maxNameLength, maxNamespaceLength, options)
+			return
+		}
 		printStatTables(statTables, w, maxNameLength, maxNamespaceLength, options)
 	case jsonOutput:
-		printStatJSON(statTables, w)
+		printStatJSON(statTables, w, options)
 	}
 }
 
@@ -324,10 +400,32 @@ func showTCPBytes(options *statOptions, resourceType string) bool {
 }
 
 func showTCPConns(resourceType string) bool {
-	return resourceType != k8s.Authority
+	return resourceType != k8s.Authority && resourceType != k8s.TrafficSplit
+}
+
+// setTrafficSplitShares computes the share of the requests sent to each split
+// that were routed to each of its leaves, so that it can be compared with the
+// leaves' weights.
+func setTrafficSplitShares(splits map[string]*row) {
+	totals := make(map[string]float64)
+	for key, r := range splits {
+		if r.rowStats != nil {
+			totals[path.Dir(key)] += r.requestRate
+		}
+	}
+	for key, r := range splits {
+		if r.tsStats != nil && r.rowStats != nil && totals[path.Dir(key)] > 0 {
+			r.share = r.requestRate / totals[path.Dir(key)]
+		}
+	}
 }
 
 func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType string, w *tabwriter.Writer, maxNameLength int, maxNamespaceLength int, options *statOptions) {
+	if resourceType == k8s.TrafficSplit {
+		printTrafficSplitTable(stats, resourceTypeLabel, w, maxNameLength, maxNamespaceLength, options)
+		return
+	}
+
 	headers := make([]string, 0)
 	if options.allNamespaces {
 		headers = append(headers,
@@ -341,8 +439,11 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 		"LATENCY_P50",
 		"LATENCY_P95",
 		"LATENCY_P99",
-		"TCP_CONN",
 	}...)
+	for _, percentile := range options.latencyPercentiles {
+		headers = append(headers, "LATENCY_"+strings.ToUpper(percentile))
+	}
+	headers = append(headers, "TCP_CONN")
 
 	if showTCPBytes(options, resourceType) {
 		headers = append(headers, []string{
@@ -359,25 +460,33 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 	for _, key := range sortedKeys {
 		namespace, name := namespaceName(resourceTypeLabel, key)
 		values := make([]interface{}, 0)
-		templateString := "%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t%d\t\n"
-		templateStringEmpty := "%s\t%s\t-\t-\t-\t-\t-\t-\t\n"
-
-		if showTCPBytes(options, resourceType) {
-			templateString = "%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t%d\t%.1fB/s\t%.1fB/s\t\n"
-			templateStringEmpty = "%s\t%s\t-\t-\t-\t-\t-\t-\t-\t-\t\n"
-		}
-
-		if !showTCPConns(resourceType) {
-			// always show TCP Connections as - for Authorities
-			templateString = "%s\t%s\t%.2f%%\t%.1frps\t%dms\t%dms\t%dms\t-\t\n"
-		}
 
+		// the format of each column, in the order of the headers
+		keyColumns := []string{"%s", "%s"}
 		if options.allNamespaces {
 			values = append(values,
 				namespace+strings.Repeat(" ", maxNamespaceLength-len(namespace)))
-			templateString = "%s\t" + templateString
-			templateStringEmpty = "%s\t" + templateStringEmpty
+			keyColumns = append(keyColumns, "%s")
+		}
+		statColumns := []string{"%.2f%%", "%.1frps", "%dms", "%dms", "%dms"}
+		for range options.latencyPercentiles {
+			statColumns = append(statColumns, "%s")
+		}
+		if showTCPConns(resourceType) {
+			statColumns = append(statColumns, "%d")
+		} else {
+			// always show TCP Connections as - for Authorities
+			statColumns = append(statColumns, "-")
+		}
+		if showTCPBytes(options, resourceType) {
+			statColumns = append(statColumns, "%.1fB/s", "%.1fB/s")
 		}
+		emptyColumns := make([]string, len(statColumns))
+		for i := range emptyColumns {
+			emptyColumns[i] = "-"
+		}
+		templateString := strings.Join(keyColumns, "\t") + "\t" + strings.Join(statColumns, "\t") + "\t\n"
+		templateStringEmpty := strings.Join(keyColumns, "\t") + "\t" + strings.Join(emptyColumns, "\t") + "\t\n"
 
 		padding := 0
 		if maxNameLength > len(name) {
@@ -397,6 +506,14 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 				stats[key].latencyP99,
 			}...)
 
+			for _, latency := range stats[key].latencyPercentiles {
+				if math.IsNaN(latency) {
+					values = append(values, "-")
+				} else {
+					values = append(values, fmt.Sprintf("%dms", uint64(math.Round(latency))))
+				}
+			}
+
 			if showTCPConns(resourceType) {
 				values = append(values, stats[key].tcpOpenConnections)
 			}
@@ -415,6 +532,198 @@ func printSingleStatTable(stats map[string]*row, resourceTypeLabel, resourceType
 	}
 }
 
+func printStatusTables(statTables map[string]map[string]*row, w *tabwriter.Writer, maxNameLength int, maxNamespaceLength int, options *statOptions) {
+	usePrefix := len(statTables) > 1
+
+	firstDisplayedStat := true // don't print a newline before the first stat
+	for _, resourceType := range k8s.AllResources {
+		if stats, ok := statTables[resourceType]; ok {
+			if !firstDisplayedStat {
+				fmt.Fprint(w, "\n")
+			}
+			firstDisplayedStat = false
+			resourceTypeLabel := resourceType
+			if !usePrefix {
+				resourceTypeLabel = ""
+			}
+			printSingleStatusTable(stats, resourceTypeLabel, w, maxNameLength, maxNamespaceLength, options)
+		}
+	}
+}
+
+// printSingleStatusTable prints the request rate of each HTTP status class
+// and gRPC status code observed for any of the resources, so that resources
+// share the same columns.
+func printSingleStatusTable(stats map[string]*row, resourceTypeLabel string, w *tabwriter.Writer, maxNameLength int, maxNamespaceLength int, options *statOptions) {
+	httpClasses := make(map[string]bool)
+	grpcCodes := make(map[string]bool)
+	for _, r := range stats {
+		if r.rowStats == nil {
+			continue
+		}
+		for class := range r.httpStatusRates {
+			httpClasses[class] = true
+		}
+		for code := range r.grpcStatusRates {
+			grpcCodes[code] = true
+		}
+	}
+	sortedHTTPClasses := sortedStatuses(httpClasses)
+	sortedGRPCCodes := sortedStatuses(grpcCodes)
+
+	headers := make([]string, 0)
+	if options.allNamespaces {
+		headers = append(headers,
+			namespaceHeader+strings.Repeat(" ", maxNamespaceLength-len(namespaceHeader)))
+	}
+	headers = append(headers, []string{
+		nameHeader + strings.Repeat(" ", maxNameLength-len(nameHeader)),
+		"SUCCESS",
+		"RPS",
+	}...)
+	for _, class := range sortedHTTPClasses {
+		headers = append(headers, strings.ToUpper(class))
+	}
+	for _, code := range sortedGRPCCodes {
+		headers = append(headers, "GRPC_"+code)
+	}
+
+	fmt.Fprintln(w, strings.Join(headers, "\t")+"\t")
+
+	for _, key := range sortStatsKeys(stats) {
+		namespace, name := namespaceName(resourceTypeLabel, key)
+		values := make([]string, 0)
+		if options.allNamespaces {
+			values = append(values, namespace+strings.Repeat(" ", maxNamespaceLength-len(namespace)))
+		}
+		values = append(values, name+strings.Repeat(" ", maxNameLength-len(name)))
+
+		r := stats[key].rowStats
+		if r == nil {
+			for len(values) < len(headers) {
+				values = append(values, "-")
+			}
+			fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+			continue
+		}
+
+		values = append(values,
+			fmt.Sprintf("%.2f%%", r.successRate*100),
+			fmt.Sprintf("%.1frps", r.requestRate),
+		)
+		for _, class := range sortedHTTPClasses {
+			values = append(values, statusRateString(r.httpStatusRates, class))
+		}
+		for _, code := range sortedGRPCCodes {
+			values = append(values, statusRateString(r.grpcStatusRates, code))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+	}
+}
+
+func sortedStatuses(statuses map[string]bool) []string {
+	sorted := make([]string, 0, len(statuses))
+	for status := range statuses {
+		sorted = append(sorted, status)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func statusRateString(rates map[string]float64, status string) string {
+	rate, ok := rates[status]
+	if !ok {
+		return "-"
+	}
+	return fmt.Sprintf("%.1frps", rate)
+}
+
+// printTrafficSplitTable prints one row for each leaf of each split, with the
+// leaf's configured weight next to its actual share of the requests.
+func printTrafficSplitTable(stats map[string]*row, resourceTypeLabel string, w *tabwriter.Writer, maxNameLength int, maxNamespaceLength int, options *statOptions) {
+	headers := make([]string, 0)
+	if options.allNamespaces {
+		headers = append(headers,
+			namespaceHeader+strings.Repeat(" ", maxNamespaceLength-len(namespaceHeader)))
+	}
+	headers = append(headers, nameHeader+strings.Repeat(" ", maxNameLength-len(nameHeader)))
+
+	// pad the apex and leaf columns to their widest value, to left-align
+	// them in the right-aligned tabwriter
+	maxApexLength := len("APEX")
+	maxLeafLength := len("LEAF")
+	for _, r := range stats {
+		if r.tsStats == nil {
+			continue
+		}
+		if len(r.apex) > maxApexLength {
+			maxApexLength = len(r.apex)
+		}
+		if len(r.leaf) > maxLeafLength {
+			maxLeafLength = len(r.leaf)
+		}
+	}
+	headers = append(headers, []string{
+		"APEX" + strings.Repeat(" ", maxApexLength-len("APEX")),
+		"LEAF" + strings.Repeat(" ", maxLeafLength-len("LEAF")),
+		"WEIGHT",
+		"SHARE",
+		"SUCCESS",
+		"RPS",
+		"LATENCY_P50",
+		"LATENCY_P95",
+		"LATENCY_P99",
+	}...)
+	for _, percentile := range options.latencyPercentiles {
+		headers = append(headers, "LATENCY_"+strings.ToUpper(percentile))
+	}
+
+	fmt.Fprintln(w, strings.Join(headers, "\t")+"\t")
+
+	for _, key := range sortStatsKeys(stats) {
+		r := stats[key]
+		if r.tsStats == nil {
+			continue
+		}
+		namespace, name := namespaceName(resourceTypeLabel, key)
+		values := make([]string, 0)
+		if options.allNamespaces {
+			values = append(values, namespace+strings.Repeat(" ", maxNamespaceLength-len(namespace)))
+		}
+		values = append(values,
+			name+strings.Repeat(" ", maxNameLength-len(name)),
+			r.apex+strings.Repeat(" ", maxApexLength-len(r.apex)),
+			r.leaf+strings.Repeat(" ", maxLeafLength-len(r.leaf)),
+			r.weight,
+		)
+
+		if r.rowStats == nil {
+			for len(values) < len(headers) {
+				values = append(values, "-")
+			}
+			fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+			continue
+		}
+
+		values = append(values,
+			fmt.Sprintf("%.2f%%", r.share*100),
+			fmt.Sprintf("%.2f%%", r.successRate*100),
+			fmt.Sprintf("%.1frps", r.requestRate),
+			fmt.Sprintf("%dms", r.latencyP50),
+			fmt.Sprintf("%dms", r.latencyP95),
+			fmt.Sprintf("%dms", r.latencyP99),
+		)
+		for _, latency := range r.latencyPercentiles {
+			if math.IsNaN(latency) {
+				values = append(values, "-")
+			} else {
+				values = append(values, fmt.Sprintf("%dms", uint64(math.Round(latency))))
+			}
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t")+"\t")
+	}
+}
+
 func namespaceName(resourceType string, key string) (string, string) {
 	parts := strings.Split(key, "/")
 	namespace := parts[0]
@@ -437,9 +746,17 @@ type jsonStats struct {
 	TCPConnections *uint64  `json:"tcp_open_connections"`
 	TCPReadBytes   *float64 `json:"tcp_read_bytes_rate"`
 	TCPWriteBytes  *float64 `json:"tcp_write_bytes_rate"`
+
+	Apex                 string             `json:"apex,omitempty"`
+	Leaf                 string             `json:"leaf,omitempty"`
+	Weight               string             `json:"weight,omitempty"`
+	Share                *float64           `json:"share,omitempty"`
+	LatencyMSPercentiles map[string]*uint64 `json:"latency_ms_percentiles,omitempty"`
+	HTTPStatusRps        map[string]float64 `json:"http_status_rps,omitempty"`
+	GRPCStatusRps        map[string]float64 `json:"grpc_status_rps,omitempty"`
 }
 
-func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
+func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer, options *statOptions) {
 	// avoid nil initialization so that if there are not stats it gets marshalled as an empty array vs null
 	entries := []*jsonStats{}
 	for _, resourceType := range k8s.AllResources {
@@ -453,6 +770,14 @@ func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
 					Name:      name,
 					Meshed:    stats[key].meshed,
 				}
+				if stats[key].tsStats != nil {
+					entry.Apex = stats[key].apex
+					entry.Leaf = stats[key].leaf
+					entry.Weight = stats[key].weight
+					if stats[key].rowStats != nil {
+						entry.Share = &stats[key].share
+					}
+				}
 				if stats[key].rowStats != nil {
 					entry.Success = &stats[key].successRate
 					entry.Rps = &stats[key].requestRate
@@ -460,6 +785,23 @@ func printStatJSON(statTables map[string]map[string]*row, w *tabwriter.Writer) {
 					entry.LatencyMSp95 = &stats[key].latencyP95
 					entry.LatencyMSp99 = &stats[key].latencyP99
 
+					if len(options.latencyPercentiles) > 0 {
+						entry.LatencyMSPercentiles = make(map[string]*uint64)
+						for i, percentile := range options.latencyPercentiles {
+							var latency *uint64
+							if !math.IsNaN(stats[key].latencyPercentiles[i]) {
+								value := uint64(math.Round(stats[key].latencyPercentiles[i]))
+								latency = &value
+							}
+							entry.LatencyMSPercentiles[percentile] = latency
+						}
+					}
+
+					if options.byStatus {
+						entry.HTTPStatusRps = stats[key].httpStatusRates
+						entry.GRPCStatusRps = stats[key].grpcStatusRates
+					}
+
 					if showTCPConns(resourceType) {
 						entry.TCPConnections = &stats[key].tcpOpenConnections
 						entry.TCPReadBytes = &stats[key].tcpReadBytes
@@ -489,6 +831,23 @@ func getNamePrefix(resourceType string) string {
 }
 
 func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.StatSummaryRequest, error) {
+	paramsList, err := buildStatRequestParams(resources, options)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*pb.StatSummaryRequest, 0)
+	for _, requestParams := range paramsList {
+		req, err := util.BuildStatSummaryRequest(requestParams)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+func buildStatRequestParams(resources []string, options *statOptions) ([]util.StatsSummaryRequestParams, error) {
 	targets, err := util.BuildResources(options.namespace, resources)
 	if err != nil {
 		return nil, err
@@ -508,7 +867,7 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 		}
 	}
 
-	requests := make([]*pb.StatSummaryRequest, 0)
+	paramsList := make([]util.StatsSummaryRequestParams, 0)
 	for _, target := range targets {
 		err = options.validate(target.Type)
 		if err != nil {
@@ -517,28 +876,26 @@ func buildStatSummaryRequests(resources []string, options *statOptions) ([]*pb.S
 
 		requestParams := util.StatsSummaryRequestParams{
 			StatsBaseRequestParams: util.StatsBaseRequestParams{
-				TimeWindow:    options.timeWindow,
-				ResourceName:  target.Name,
-				ResourceType:  target.Type,
-				Namespace:     options.namespace,
-				AllNamespaces: options.allNamespaces,
+				TimeWindow:       options.timeWindow,
+				ResourceName:     target.Name,
+				ResourceType:     target.Type,
+				Namespace:        options.namespace,
+				AllNamespaces:    options.allNamespaces,
+				LatencyHistogram: len(options.latencyPercentiles) > 0,
+				LabelSelector:    options.labelSelector,
 			},
-			ToName:        toRes.Name,
-			ToType:        toRes.Type,
-			ToNamespace:   options.toNamespace,
-			FromName:      fromRes.Name,
-			FromType:      fromRes.Type,
-			FromNamespace: options.fromNamespace,
-			TCPStats:      true,
+			ToName:          toRes.Name,
+			ToType:          toRes.Type,
+			ToNamespace:     options.toNamespace,
+			FromName:        fromRes.Name,
+			FromType:        fromRes.Type,
+			FromNamespace:   options.fromNamespace,
+			TCPStats:        true,
+			StatusBreakdown: options.byStatus,
 		}
-
-		req, err := util.BuildStatSummaryRequest(requestParams)
-		if err != nil {
-			return nil, err
-		}
-		requests = append(requests, req)
+		paramsList = append(paramsList, requestParams)
 	}
-	return requests, nil
+	return paramsList, nil
 }
 
 func sortStatsKeys(stats map[string]*row) []string {
@@ -565,6 +922,11 @@ func (o *statOptions) validate(resourceType string) error {
 		}
 	}
 
+	err = o.validateLatencyPercentiles()
+	if err != nil {
+		return err
+	}
+
 	return o.validateOutputFormat()
 }
 
@@ -602,6 +964,55 @@ func (o *statOptions) validateNamespaceFlags() error {
 	return nil
 }
 
+// validateLatencyPercentiles validates the --latency-percentiles, which are
+// only displayed by the wide and json output formats.
+func (o *statOptions) validateLatencyPercentiles() error {
+	if len(o.latencyPercentiles) == 0 {
+		return nil
+	}
+
+	if o.outputFormat != wideOutput && o.outputFormat != jsonOutput {
+		return fmt.Errorf("--latency-percentiles flag requires the %s or %s output format", wideOutput, jsonOutput)
+	}
+
+	for _, percentile := range o.latencyPercentiles {
+		_, err := util.ParsePercentile(percentile)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getLatencyPercentiles estimates the given percentiles from a latency
+// histogram. Percentiles that cannot be estimated are NaN.
+func getLatencyPercentiles(histogram *pb.LatencyHistogram, percentiles []string) []float64 {
+	latencies := make([]float64, len(percentiles))
+	for i, percentile := range percentiles {
+		q, err := util.ParsePercentile(percentile)
+		if err != nil {
+			latencies[i] = math.NaN()
+			continue
+		}
+		latencies[i] = util.HistogramQuantile(q, histogram)
+	}
+	return latencies
+}
+
+// getStatusRates converts the response counts of a status breakdown into
+// request rates over the time window.
+func getStatusRates(counts map[string]uint64, timeWindow string) map[string]float64 {
+	if len(counts) == 0 {
+		return nil
+	}
+	rates := make(map[string]float64, len(counts))
+	for status, count := range counts {
+		rates[status] = getRequestRate(count, 0, timeWindow)
+	}
+	return rates
+}
+
 // get byte rate calculates the read/write byte rate
 func getByteRate(bytes uint64, timeWindow string) float64 {
 	windowLength, err := time.ParseDuration(timeWindow)
@@ -611,3 +1022,291 @@ func getByteRate(bytes uint64, timeWindow string) float64 {
 	}
 	return float64(bytes) / windowLength.Seconds()
 }
+
+// historyPoints is the number of points displayed by `stat --history` when no
+// step is given.
+const historyPoints = 20
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+func runStatHistory(args []string, options *statOptions) error {
+	reqs, err := buildStatTimeSeriesRequests(args, options)
+	if err != nil {
+		return fmt.Errorf("error creating metrics request while making stats request: %v", err)
+	}
+
+	client := checkPublicAPIClientOrExit()
+	series := make([]*pb.StatTimeSeries, 0)
+	for _, req := range reqs {
+		resp, err := requestStatHistoryFromAPI(client, req)
+		if err != nil {
+			return err
+		}
+		series = append(series, resp.GetOk().GetSeries()...)
+	}
+
+	output := renderStatHistory(series, options)
+	_, err = fmt.Print(output)
+
+	return err
+}
+
+func requestStatHistoryFromAPI(client pb.ApiClient, req *pb.StatTimeSeriesRequest) (*pb.StatTimeSeriesResponse, error) {
+	resp, err := client.StatTimeSeries(context.Background(), req)
+	if err != nil {
+		return nil, fmt.Errorf("StatTimeSeries API error: %v", err)
+	}
+	if e := resp.GetError(); e != nil {
+		return nil, fmt.Errorf("StatTimeSeries API response error: %v", e.Error)
+	}
+
+	return resp, nil
+}
+
+func buildStatTimeSeriesRequests(resources []string, options *statOptions) ([]*pb.StatTimeSeriesRequest, error) {
+	step, err := options.getHistoryStep()
+	if err != nil {
+		return nil, err
+	}
+
+	paramsList, err := buildStatRequestParams(resources, options)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]*pb.StatTimeSeriesRequest, 0)
+	for _, requestParams := range paramsList {
+		req, err := util.BuildStatTimeSeriesRequest(util.StatTimeSeriesRequestParams{
+			StatsSummaryRequestParams: requestParams,
+			Range:                     options.history,
+			Step:                      step,
+		})
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, req)
+	}
+	return requests, nil
+}
+
+// getHistoryStep returns the --history-step, defaulting to a step that splits
+// the --history into historyPoints points. The default is formatted in whole
+// seconds, as Prometheus only accepts durations with a single unit.
+func (o *statOptions) getHistoryStep() (string, error) {
+	if o.historyStep != "" {
+		return o.historyStep, nil
+	}
+
+	history, err := time.ParseDuration(o.history)
+	if err != nil {
+		return "", err
+	}
+
+	step := history / historyPoints
+	if step < time.Second {
+		step = time.Second
+	}
+	return fmt.Sprintf("%ds", int64(step/time.Second)), nil
+}
+
+func renderStatHistory(series []*pb.StatTimeSeries, options *statOptions) string {
+	var buffer bytes.Buffer
+	w := tabwriter.NewWriter(&buffer, 0, 0, padding, ' ', tabwriter.AlignRight)
+	writeStatHistoryToBuffer(series, w, options)
+	w.Flush()
+
+	return renderStats(buffer, &options.statOptionsBase)
+}
+
+func writeStatHistoryToBuffer(series []*pb.StatTimeSeries, w *tabwriter.Writer, options *statOptions) {
+	switch options.outputFormat {
+	case tableOutput, wideOutput:
+		if len(series) == 0 {
+			fmt.Fprintln(os.Stderr, "No traffic found.")
+			os.Exit(0)
+		}
+		printStatHistoryTable(series, w, options)
+	case jsonOutput:
+		printStatHistoryJSON(series, w)
+	}
+}
+
+func printStatHistoryTable(series []*pb.StatTimeSeries, w *tabwriter.Writer, options *statOptions) {
+	resourceTypes := make(map[string]bool)
+	for _, s := range series {
+		resourceTypes[s.Resource.Type] = true
+	}
+	usePrefix := len(resourceTypes) > 1
+
+	names := make([]string, len(series))
+	maxNameLength := len(nameHeader)
+	maxNamespaceLength := len(namespaceHeader)
+	for i, s := range series {
+		names[i] = s.Resource.Name
+		if usePrefix {
+			names[i] = getNamePrefix(s.Resource.Type) + names[i]
+		}
+		if len(names[i]) > maxNameLength {
+			maxNameLength = len(names[i])
+		}
+		if len(s.Resource.Namespace) > maxNamespaceLength {
+			maxNamespaceLength = len(s.Resource.Namespace)
+		}
+	}
+
+	headers := make([]string, 0)
+	if options.allNamespaces {
+		headers = append(headers,
+			namespaceHeader+strings.Repeat(" ", maxNamespaceLength-len(namespaceHeader)))
+	}
+	headers = append(headers, []string{
+		nameHeader + strings.Repeat(" ", maxNameLength-len(nameHeader)),
+		"SUCCESS",
+		"RPS",
+	}...)
+	if options.outputFormat == wideOutput {
+		headers = append(headers, "LATENCY_P50", "LATENCY_P95")
+	}
+	headers = append(headers, "LATENCY_P99\t") // trailing \t is required to format last column
+
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for i, s := range series {
+		values := make([]string, 0)
+		if options.allNamespaces {
+			values = append(values,
+				s.Resource.Namespace+strings.Repeat(" ", maxNamespaceLength-len(s.Resource.Namespace)))
+		}
+		values = append(values, names[i]+strings.Repeat(" ", maxNameLength-len(names[i])))
+
+		successRates := make([]float64, len(s.Points))
+		requestRates := make([]float64, len(s.Points))
+		latencyP50s := make([]float64, len(s.Points))
+		latencyP95s := make([]float64, len(s.Points))
+		latencyP99s := make([]float64, len(s.Points))
+		for j, p := range s.Points {
+			successRates[j] = math.NaN()
+			if p.Stats.GetSuccessCount()+p.Stats.GetFailureCount() > 0 {
+				successRates[j] = getSuccessRate(p.Stats.GetSuccessCount(), p.Stats.GetFailureCount())
+			}
+			requestRates[j] = getRequestRate(p.Stats.GetSuccessCount(), p.Stats.GetFailureCount(), s.TimeWindow)
+			latencyP50s[j] = float64(p.Stats.GetLatencyMsP50())
+			latencyP95s[j] = float64(p.Stats.GetLatencyMsP95())
+			latencyP99s[j] = float64(p.Stats.GetLatencyMsP99())
+		}
+
+		latestSuccess := "-"
+		if len(successRates) > 0 && !math.IsNaN(successRates[len(successRates)-1]) {
+			latestSuccess = fmt.Sprintf("%.2f%%", successRates[len(successRates)-1]*100)
+		}
+		values = append(values,
+			sparkline(successRates, 1)+" "+latestSuccess,
+			sparkline(requestRates, maxValue(requestRates))+" "+fmt.Sprintf("%.1frps", lastValue(requestRates)),
+		)
+		if options.outputFormat == wideOutput {
+			values = append(values,
+				sparkline(latencyP50s, maxValue(latencyP50s))+" "+fmt.Sprintf("%.0fms", lastValue(latencyP50s)),
+				sparkline(latencyP95s, maxValue(latencyP95s))+" "+fmt.Sprintf("%.0fms", lastValue(latencyP95s)),
+			)
+		}
+		values = append(values,
+			sparkline(latencyP99s, maxValue(latencyP99s))+" "+fmt.Sprintf("%.0fms", lastValue(latencyP99s)),
+		)
+
+		fmt.Fprintf(w, "%s\t\n", strings.Join(values, "\t"))
+	}
+}
+
+// sparkline renders values as block characters scaled between 0 and max. NaN
+// values, used for points without traffic, are rendered as blanks.
+func sparkline(values []float64, max float64) string {
+	var b strings.Builder
+	for _, v := range values {
+		switch {
+		case math.IsNaN(v):
+			b.WriteRune(' ')
+		case max <= 0:
+			b.WriteRune(sparklineTicks[0])
+		default:
+			ix := int(math.Round(v / max * float64(len(sparklineTicks)-1)))
+			if ix < 0 {
+				ix = 0
+			} else if ix >= len(sparklineTicks) {
+				ix = len(sparklineTicks) - 1
+			}
+			b.WriteRune(sparklineTicks[ix])
+		}
+	}
+	return b.String()
+}
+
+func maxValue(values []float64) float64 {
+	max := 0.0
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+func lastValue(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	return values[len(values)-1]
+}
+
+type jsonStatHistory struct {
+	Namespace  string                  `json:"namespace"`
+	Kind       string                  `json:"kind"`
+	Name       string                  `json:"name"`
+	TimeWindow string                  `json:"time_window"`
+	Points     []*jsonStatHistoryPoint `json:"points"`
+}
+
+// Using a pointer for the success rate, which is null for points without
+// traffic
+type jsonStatHistoryPoint struct {
+	TimestampMs  int64    `json:"timestamp_ms"`
+	Success      *float64 `json:"success"`
+	Rps          float64  `json:"rps"`
+	LatencyMSp50 uint64   `json:"latency_ms_p50"`
+	LatencyMSp95 uint64   `json:"latency_ms_p95"`
+	LatencyMSp99 uint64   `json:"latency_ms_p99"`
+}
+
+func printStatHistoryJSON(series []*pb.StatTimeSeries, w *tabwriter.Writer) {
+	// avoid nil initialization so that if there are not stats it gets marshalled as an empty array vs null
+	entries := []*jsonStatHistory{}
+	for _, s := range series {
+		entry := &jsonStatHistory{
+			Namespace:  s.Resource.Namespace,
+			Kind:       s.Resource.Type,
+			Name:       s.Resource.Name,
+			TimeWindow: s.TimeWindow,
+			Points:     []*jsonStatHistoryPoint{},
+		}
+		for _, p := range s.Points {
+			point := &jsonStatHistoryPoint{
+				TimestampMs:  p.TimestampMs,
+				Rps:          getRequestRate(p.Stats.GetSuccessCount(), p.Stats.GetFailureCount(), s.TimeWindow),
+				LatencyMSp50: p.Stats.GetLatencyMsP50(),
+				LatencyMSp95: p.Stats.GetLatencyMsP95(),
+				LatencyMSp99: p.Stats.GetLatencyMsP99(),
+			}
+			if p.Stats.GetSuccessCount()+p.Stats.GetFailureCount() > 0 {
+				success := getSuccessRate(p.Stats.GetSuccessCount(), p.Stats.GetFailureCount())
+				point.Success = &success
+			}
+			entry.Points = append(entry.Points, point)
+		}
+		entries = append(entries, entry)
+	}
+	b, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+	fmt.Fprintf(w, "%s\n", b)
+}
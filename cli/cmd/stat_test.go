@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"math"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 )
 
@@ -87,6 +90,100 @@ func TestStat(t *testing.T) {
 		}, t)
 	})
 
+	options = newStatOptions()
+	options.outputFormat = wideOutput
+	options.latencyPercentiles = []string{"p90", "p999"}
+	t.Run("Returns additional latency percentiles", func(t *testing.T) {
+		testStatPercentilesCall(options, "stat_one_percentiles_output.golden", t)
+	})
+
+	options = newStatOptions()
+	options.outputFormat = jsonOutput
+	options.latencyPercentiles = []string{"p90", "p999"}
+	t.Run("Returns additional latency percentiles (json)", func(t *testing.T) {
+		testStatPercentilesCall(options, "stat_one_percentiles_output_json.golden", t)
+	})
+
+	options = newStatOptions()
+	options.byStatus = true
+	t.Run("Returns the status breakdown", func(t *testing.T) {
+		testStatByStatusCall(options, "stat_one_by_status_output.golden", t)
+	})
+
+	options = newStatOptions()
+	options.outputFormat = jsonOutput
+	options.byStatus = true
+	t.Run("Returns the status breakdown (json)", func(t *testing.T) {
+		testStatByStatusCall(options, "stat_one_by_status_output_json.golden", t)
+	})
+
+	options = newStatOptions()
+	t.Run("Returns the leaves of a traffic split", func(t *testing.T) {
+		testStatTrafficSplitCall(options, "stat_one_ts_output.golden", t)
+	})
+
+	options = newStatOptions()
+	options.outputFormat = jsonOutput
+	t.Run("Returns the leaves of a traffic split (json)", func(t *testing.T) {
+		testStatTrafficSplitCall(options, "stat_one_ts_output_json.golden", t)
+	})
+
+	t.Run("Rejects --latency-percentiles with the table output format", func(t *testing.T) {
+		options := newStatOptions()
+		options.latencyPercentiles = []string{"p999"}
+		args := []string{"ns"}
+		expectedError := "--latency-percentiles flag requires the wide or json output format"
+
+		_, err := buildStatSummaryRequests(args, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Rejects invalid --latency-percentiles", func(t *testing.T) {
+		options := newStatOptions()
+		options.outputFormat = wideOutput
+		options.latencyPercentiles = []string{"99th"}
+		args := []string{"ns"}
+		expectedError := "invalid percentile [99th], must be of the form p50, p99 or p999"
+
+		_, err := buildStatSummaryRequests(args, options)
+		if err == nil || err.Error() != expectedError {
+			t.Fatalf("Expected error [%s] instead got [%s]", expectedError, err)
+		}
+	})
+
+	t.Run("Sends the --selector as a structured label selector", func(t *testing.T) {
+		options := newStatOptions()
+		options.labelSelector = "tier=backend,env notin (dev)"
+		args := []string{"deploy"}
+
+		reqs, err := buildStatSummaryRequests(args, options)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := &pb.LabelSelector{
+			MatchLabels: map[string]string{"tier": "backend"},
+			MatchExpressions: []*pb.LabelSelectorRequirement{
+				{Key: "env", Operator: pb.LabelSelectorRequirement_NOT_IN, Values: []string{"dev"}},
+			},
+		}
+		if labels := reqs[0].GetSelector().GetLabels(); !proto.Equal(labels, expected) {
+			t.Fatalf("Expected label selector %+v, got %+v", expected, labels)
+		}
+	})
+
+	t.Run("Rejects an invalid --selector", func(t *testing.T) {
+		options := newStatOptions()
+		options.labelSelector = "tier in (backend"
+		args := []string{"deploy"}
+
+		if _, err := buildStatSummaryRequests(args, options); err == nil {
+			t.Fatalf("Expected an invalid selector to be rejected")
+		}
+	})
+
 	t.Run("Returns an error for named resource queries with the --all-namespaces flag", func(t *testing.T) {
 		options := newStatOptions()
 		options.allNamespaces = true
@@ -150,6 +247,225 @@ func TestStat(t *testing.T) {
 	})
 }
 
+func TestStatHistory(t *testing.T) {
+	successCounts := []uint64{59, 119, 29, 0, 89}
+
+	options := newStatOptions()
+	options.history = "5m"
+	t.Run("Returns namespace stats history", func(t *testing.T) {
+		testStatHistoryCall(options, []string{"emojivoto1"}, successCounts, "stat_history_output.golden", t)
+	})
+
+	options = newStatOptions()
+	options.history = "5m"
+	options.allNamespaces = true
+	options.outputFormat = wideOutput
+	t.Run("Returns all namespace stats history (wide)", func(t *testing.T) {
+		testStatHistoryCall(options, []string{"emojivoto1", "emojivoto2"}, successCounts, "stat_history_all_output_wide.golden", t)
+	})
+
+	options = newStatOptions()
+	options.history = "5m"
+	options.outputFormat = jsonOutput
+	t.Run("Returns namespace stats history (json)", func(t *testing.T) {
+		testStatHistoryCall(options, []string{"emojivoto1"}, successCounts, "stat_history_output_json.golden", t)
+	})
+
+	t.Run("Splits the history into the default number of points", func(t *testing.T) {
+		expectations := map[string]string{
+			"1h":  "180s",
+			"10s": "1s",
+		}
+
+		for history, expectedStep := range expectations {
+			options := newStatOptions()
+			options.history = history
+			reqs, err := buildStatTimeSeriesRequests([]string{"ns"}, options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if reqs[0].Range != history || reqs[0].Step != expectedStep {
+				t.Fatalf("Expected range [%s] and step [%s], got [%s] and [%s]", history, expectedStep, reqs[0].Range, reqs[0].Step)
+			}
+		}
+	})
+
+	t.Run("Rejects an invalid --history", func(t *testing.T) {
+		options := newStatOptions()
+		options.history = "an hour"
+
+		_, err := buildStatTimeSeriesRequests([]string{"ns"}, options)
+		if err == nil {
+			t.Fatalf("Expected an error for --history [%s]", options.history)
+		}
+	})
+}
+
+func testStatPercentilesCall(options *statOptions, file string, t *testing.T) {
+	mockClient := &public.MockAPIClient{}
+	response := public.GenStatSummaryResponse("emoji", k8s.Namespace, []string{"emojivoto1", "emojivoto2"}, &public.PodCounts{
+		MeshedPods:  1,
+		RunningPods: 2,
+		FailedPods:  0,
+	}, true, true)
+
+	// only the first namespace reports a histogram
+	rows := response.GetOk().StatTables[0].GetPodGroup().Rows
+	rows[0].Stats.LatencyHistogram = &pb.LatencyHistogram{
+		Buckets: []*pb.LatencyHistogram_Bucket{
+			{LeMs: 100, Count: 100},
+			{LeMs: 200, Count: 120},
+			{LeMs: 1000, Count: 123},
+			{LeMs: math.Inf(1), Count: 123},
+		},
+	}
+	mockClient.StatSummaryResponseToReturn = &response
+
+	reqs, err := buildStatSummaryRequests([]string{"ns"}, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reqs[0].LatencyHistogram {
+		t.Fatalf("Expected the latency histogram to be requested")
+	}
+
+	resp, err := requestStatsFromAPI(mockClient, reqs[0])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := renderStatStats(respToRows(resp), options)
+
+	diffTestdata(t, file, output)
+}
+
+func testStatByStatusCall(options *statOptions, file string, t *testing.T) {
+	mockClient := &public.MockAPIClient{}
+	response := public.GenStatSummaryResponse("emoji", k8s.Namespace, []string{"emojivoto1", "emojivoto2"}, &public.PodCounts{
+		MeshedPods:  1,
+		RunningPods: 2,
+		FailedPods:  0,
+	}, true, false)
+
+	// only the first namespace reports gRPC responses
+	rows := response.GetOk().StatTables[0].GetPodGroup().Rows
+	rows[0].StatusBreakdown = &pb.StatusBreakdown{
+		HttpStatusClasses: map[string]uint64{"2xx": 123},
+		GrpcStatusCodes:   map[string]uint64{"OK": 120, "UNAVAILABLE": 3},
+	}
+	rows[1].StatusBreakdown = &pb.StatusBreakdown{
+		HttpStatusClasses: map[string]uint64{"2xx": 117, "5xx": 6},
+	}
+	mockClient.StatSummaryResponseToReturn = &response
+
+	reqs, err := buildStatSummaryRequests([]string{"ns"}, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reqs[0].StatusBreakdown {
+		t.Fatalf("Expected the status breakdown to be requested")
+	}
+
+	resp, err := requestStatsFromAPI(mockClient, reqs[0])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := renderStatStats(respToRows(resp), options)
+
+	diffTestdata(t, file, output)
+}
+
+func testStatTrafficSplitCall(options *statOptions, file string, t *testing.T) {
+	leaf := func(name, weight string, stats *pb.BasicStats) *pb.StatTable_PodGroup_Row {
+		return &pb.StatTable_PodGroup_Row{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      k8s.TrafficSplit,
+				Name:      "web-split",
+			},
+			TimeWindow: "1m",
+			Stats:      stats,
+			TsStats: &pb.TrafficSplitStats{
+				Apex:   "web-svc",
+				Leaf:   name,
+				Weight: weight,
+			},
+		}
+	}
+
+	mockClient := &public.MockAPIClient{}
+	response := pb.StatSummaryResponse{
+		Response: &pb.StatSummaryResponse_Ok_{
+			Ok: &pb.StatSummaryResponse_Ok{
+				StatTables: []*pb.StatTable{
+					{
+						Table: &pb.StatTable_PodGroup_{
+							PodGroup: &pb.StatTable_PodGroup{
+								Rows: []*pb.StatTable_PodGroup_Row{
+									leaf("web-v1", "800m", &pb.BasicStats{
+										SuccessCount: 108,
+										LatencyMsP50: 12,
+										LatencyMsP95: 45,
+										LatencyMsP99: 98,
+									}),
+									leaf("web-v2", "200m", &pb.BasicStats{
+										SuccessCount: 36,
+										FailureCount: 4,
+										LatencyMsP50: 15,
+										LatencyMsP95: 60,
+										LatencyMsP99: 120,
+									}),
+									leaf("web-v3", "0", nil),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	mockClient.StatSummaryResponseToReturn = &response
+
+	reqs, err := buildStatSummaryRequests([]string{"ts"}, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if reqs[0].Selector.Resource.Type != k8s.TrafficSplit {
+		t.Fatalf("Expected a trafficsplit request, got: %+v", reqs[0])
+	}
+
+	resp, err := requestStatsFromAPI(mockClient, reqs[0])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := renderStatStats(respToRows(resp), options)
+
+	diffTestdata(t, file, output)
+}
+
+func testStatHistoryCall(options *statOptions, resNs []string, successCounts []uint64, file string, t *testing.T) {
+	mockClient := &public.MockAPIClient{}
+	response := public.GenStatTimeSeriesResponse("emoji", k8s.Namespace, resNs, successCounts)
+
+	mockClient.StatTimeSeriesResponseToReturn = &response
+
+	reqs, err := buildStatTimeSeriesRequests([]string{"ns"}, options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := requestStatHistoryFromAPI(mockClient, reqs[0])
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := renderStatHistory(resp.GetOk().GetSeries(), options)
+
+	diffTestdata(t, file, output)
+}
+
 func testStatCall(exp paramsExp, t *testing.T) {
 	mockClient := &public.MockAPIClient{}
 	response := public.GenStatSummaryResponse("emoji", k8s.Namespace, exp.resNs, exp.counts, true, true)
@@ -2,31 +2,57 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/addr"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tap"
+	"github.com/linkerd/linkerd2/pkg/version"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// harOutput writes tap output as a HAR document.
+const harOutput = "har"
+
+// samplingReportInterval is how often the proportion of requests observed by
+// a tap is reported, when some were dropped.
+const samplingReportInterval = 5 * time.Second
+
 type tapOptions struct {
-	namespace   string
-	toResource  string
-	toNamespace string
-	maxRps      float32
-	scheme      string
-	method      string
-	authority   string
-	path        string
-	output      string
+	namespace     string
+	labelSelector string
+	toResource    string
+	toNamespace   string
+	maxRps        float32
+	scheme        string
+	method        string
+	authority     string
+	path          string
+	status        string
+	grpcStatus    string
+	slowerThan    time.Duration
+	output        string
+	record        string
+	trace         bool
 }
 
 func newTapOptions() *tapOptions {
@@ -39,7 +65,12 @@ func newTapOptions() *tapOptions {
 		method:      "",
 		authority:   "",
 		path:        "",
+		status:      "",
+		grpcStatus:  "",
+		slowerThan:  0,
 		output:      "",
+		record:      "",
+		trace:       false,
 	}
 }
 
@@ -47,17 +78,18 @@ func newCmdTap() *cobra.Command {
 	options := newTapOptions()
 
 	cmd := &cobra.Command{
-		Use:   "tap [flags] (RESOURCE)",
+		Use:   "tap [flags] (RESOURCE)...",
 		Short: "Listen to a traffic stream",
 		Long: `Listen to a traffic stream.
 
   The RESOURCE argument specifies the target resource(s) to tap:
-  (TYPE [NAME] | TYPE/NAME)
+  (TYPE [NAME...] | TYPE/NAME...)
 
   Examples:
   * deploy
   * deploy/my-deploy
   * deploy my-deploy
+  * deploy/my-deploy sts/my-statefulset
   * ds/my-daemonset
   * job/my-job
   * ns/my-ns
@@ -79,45 +111,33 @@ func newCmdTap() *cobra.Command {
   # tap the web-dlbvj pod in the default namespace
   linkerd tap pod/web-dlbvj
 
+  # tap the web and api deployments, labelling each event with its target
+  linkerd tap deploy/web deploy/api
+
   # tap the test namespace, filter by request to prod namespace
-  linkerd tap ns/test --to ns/prod`,
-		Args:      cobra.RangeArgs(1, 2),
-		ValidArgs: util.ValidTargets,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			requestParams := util.TapRequestParams{
-				Resource:    strings.Join(args, "/"),
-				Namespace:   options.namespace,
-				ToResource:  options.toResource,
-				ToNamespace: options.toNamespace,
-				MaxRps:      options.maxRps,
-				Scheme:      options.scheme,
-				Method:      options.method,
-				Authority:   options.authority,
-				Path:        options.path,
-			}
+  linkerd tap ns/test --to ns/prod
 
-			req, err := util.BuildTapByResourceRequest(requestParams)
-			if err != nil {
-				return err
-			}
+  # tap the web deployment, only showing failed requests that took over 500ms
+  linkerd tap deploy/web --status 5xx --slower-than 500ms
 
-			wide := false
-			switch options.output {
-			// TODO: support more output formats?
-			case "":
-				// default output format.
-			case wideOutput:
-				wide = true
-			default:
-				return fmt.Errorf("output format \"%s\" not recognized", options.output)
-			}
+  # tap the web deployment, printing one JSON object per event
+  linkerd tap deploy/web -o json | jq .
+
+  # capture the requests of the web deployment as a HAR file, until interrupted
+  linkerd tap deploy/web -o har > web.har
 
-			return requestTapByResourceFromAPI(os.Stdout, checkPublicAPIClientOrExit(), req, wide)
+  # show the requests of the web deployment along with the requests they caused
+  linkerd tap deploy/web --trace`,
+		Args:      cobra.MinimumNArgs(1),
+		ValidArgs: util.ValidTargets,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(checkPublicAPIClientOrExit(), args)
 		},
 	}
 
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace,
 		"Namespace of the specified resource")
+	addLabelSelectorFlag(cmd.PersistentFlags(), &options.labelSelector)
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource,
 		"Display requests to this resource")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace,
@@ -132,28 +152,154 @@ func newCmdTap() *cobra.Command {
 		"Display requests with this :authority")
 	cmd.PersistentFlags().StringVar(&options.path, "path", options.path,
 		"Display requests with paths that start with this prefix")
+	cmd.PersistentFlags().StringVar(&options.status, "status", options.status,
+		"Display requests whose response has this HTTP status, class of statuses or range of statuses (for example: \"503\", \"5xx\" or \"500-503\")")
+	cmd.PersistentFlags().StringVar(&options.grpcStatus, "grpc-status", options.grpcStatus,
+		"Display requests whose response ends with this gRPC status, by name or number (for example: \"Unavailable\" or \"14\")")
+	cmd.PersistentFlags().DurationVar(&options.slowerThan, "slower-than", options.slowerThan,
+		"Display requests that take longer than this to complete (for example: \"500ms\")")
 	cmd.PersistentFlags().StringVarP(&options.output, "output", "o", options.output,
-		"Output format. One of: wide")
+		fmt.Sprintf("Output format. One of: \"%s\", \"%s\" or \"%s\"", wideOutput, jsonOutput, harOutput))
+	cmd.PersistentFlags().StringVar(&options.record, "record", options.record,
+		"Also write the tap events to this file, so that they can be replayed with \"linkerd tap replay\"")
+	cmd.PersistentFlags().BoolVar(&options.trace, "trace", options.trace,
+		"Group requests into trees of the requests that caused them, inferred from their timing: a request is attributed to the request its source was serving when it started, if there was exactly one. Trace headers are not used, as tap events do not carry them")
+
+	cmd.AddCommand(newCmdTapReplay(options))
 
 	return cmd
 }
 
-func requestTapByResourceFromAPI(w io.Writer, client pb.ApiClient, req *pb.TapByResourceRequest, wide bool) error {
-	var resource string
-	if wide {
-		resource = req.Target.Resource.GetType()
+func newCmdTapReplay(options *tapOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "replay [flags] FILE (RESOURCE)...",
+		Short: "Replay a traffic stream recorded with \"linkerd tap --record\"",
+		Long: `Replay a traffic stream recorded with "linkerd tap --record".
+
+  The recorded events are filtered by the RESOURCE argument and the tap flags,
+  as they would be by a live tap.`,
+		Example: `  # record the traffic of the web deployment in the default namespace
+  linkerd tap deploy/web --record web.tap
+
+  # replay the recorded requests to the voting deployment
+  linkerd tap replay web.tap deploy/web --to deploy/voting`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.run(tap.NewReplayClient(args[0]), args[1:])
+		},
+	}
+}
+
+// run taps the resources named by args through client, rendering the events
+// according to the output format and recording them if requested.
+func (o *tapOptions) run(client pb.ApiClient, args []string) error {
+	resources := tapResources(args)
+	requestParams := util.TapRequestParams{
+		Resource:       resources[0],
+		Resources:      resources[1:],
+		Namespace:      o.namespace,
+		LabelSelector:  o.labelSelector,
+		ToResource:     o.toResource,
+		ToNamespace:    o.toNamespace,
+		MaxRps:         o.maxRps,
+		Scheme:         o.scheme,
+		Method:         o.method,
+		Authority:      o.authority,
+		Path:           o.path,
+		Status:         o.status,
+		GrpcStatus:     o.grpcStatus,
+		SlowerThan:     o.slowerThan,
+		ReportSampling: true,
+	}
+
+	req, err := util.BuildTapByResourceRequest(requestParams)
+	if err != nil {
+		return err
+	}
+
+	output := o.output
+	switch output {
+	case "", wideOutput, jsonOutput, harOutput:
+	default:
+		return fmt.Errorf("output format \"%s\" not recognized", output)
+	}
+	if o.trace {
+		if output != "" {
+			return errors.New("--trace cannot be used with --output")
+		}
+		output = traceOutput
+	}
+
+	if o.record != "" {
+		file, err := os.Create(o.record)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		client = tap.NewRecordingClient(client, tap.NewRecorder(file))
+	}
+
+	return requestTapByResourceFromAPI(os.Stdout, client, req, output)
+}
+
+// tapResources returns the resources named by args, which are either a TYPE
+// followed by any number of NAMEs, or any number of TYPE/NAMEs.
+func tapResources(args []string) []string {
+	if len(args) < 2 || strings.Contains(args[0], "/") {
+		return args
 	}
 
-	rsp, err := client.TapByResource(context.Background(), req)
+	resources := make([]string, len(args)-1)
+	for i, name := range args[1:] {
+		resources[i] = fmt.Sprintf("%s/%s", args[0], name)
+	}
+	return resources
+}
+
+func requestTapByResourceFromAPI(w io.Writer, client pb.ApiClient, req *pb.TapByResourceRequest, output string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if output == harOutput {
+		// the HAR document is written once the tap stream ends, so end it
+		// rather than exiting when interrupted
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		defer signal.Stop(interrupt)
+		go func() {
+			select {
+			case <-interrupt:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	rsp, err := client.TapByResource(ctx, req)
 	if err != nil {
 		return err
 	}
-	return renderTap(w, rsp, resource)
+
+	// events are only labelled with their target when there are several
+	labelTargets := len(tap.Targets(req)) > 1
+
+	switch output {
+	case wideOutput:
+		return renderTap(w, rsp, req.Target.Resource.GetType(), labelTargets)
+	case jsonOutput:
+		return renderTapJSON(w, rsp, req.Target.Resource.GetType())
+	case harOutput:
+		return renderTapHAR(w, rsp, tap.Clock(rsp))
+	case traceOutput:
+		return renderTapTraces(w, rsp, tap.Clock(rsp))
+	default:
+		return renderTap(w, rsp, "", labelTargets)
+	}
 }
 
-func renderTap(w io.Writer, tapClient pb.Api_TapByResourceClient, resource string) error {
+func renderTap(w io.Writer, tapClient pb.Api_TapByResourceClient, resource string, labelTargets bool) error {
 	tableWriter := tabwriter.NewWriter(w, 0, 0, 0, ' ', tabwriter.AlignRight)
-	err := writeTapEventsToBuffer(tapClient, tableWriter, resource)
+	err := writeTapEventsToBuffer(tapClient, tableWriter, resource, labelTargets)
 	if err != nil {
 		return err
 	}
@@ -162,7 +308,8 @@ func renderTap(w io.Writer, tapClient pb.Api_TapByResourceClient, resource strin
 	return nil
 }
 
-func writeTapEventsToBuffer(tapClient pb.Api_TapByResourceClient, w *tabwriter.Writer, resource string) error {
+func writeTapEventsToBuffer(tapClient pb.Api_TapByResourceClient, w *tabwriter.Writer, resource string, labelTargets bool) error {
+	sampling := newSamplingReporter(os.Stderr, time.Now)
 	for {
 		log.Debug("Waiting for data...")
 		event, err := tapClient.Recv()
@@ -173,7 +320,15 @@ func writeTapEventsToBuffer(tapClient pb.Api_TapByResourceClient, w *tabwriter.W
 			fmt.Fprintln(os.Stderr, err)
 			break
 		}
-		_, err = fmt.Fprintln(w, renderTapEvent(event, resource))
+		if event.GetSampling() != nil {
+			sampling.add(event.GetSampling())
+			continue
+		}
+		line := renderTapEvent(event, resource)
+		if labelTargets {
+			line = fmt.Sprintf("[%s] %s", tapTargetName(event.GetTarget()), line)
+		}
+		_, err = fmt.Fprintln(w, line)
 		if err != nil {
 			return err
 		}
@@ -182,6 +337,14 @@ func writeTapEventsToBuffer(tapClient pb.Api_TapByResourceClient, w *tabwriter.W
 	return nil
 }
 
+// tapTargetName names the tap target that an event was reported for.
+func tapTargetName(target *pb.Resource) string {
+	if target.GetName() == "" {
+		return target.GetType()
+	}
+	return fmt.Sprintf("%s/%s", target.GetType(), target.GetName())
+}
+
 // renderTapEvent renders a Public API TapEvent to a string.
 func renderTapEvent(event *pb.TapEvent, resource string) string {
 	dst := dst(event)
@@ -282,6 +445,45 @@ func renderTapEvent(event *pb.TapEvent, resource string) string {
 	}
 }
 
+// samplingReporter accumulates the Sampling events of a tap and, when some
+// requests were dropped, periodically reports the proportion that was
+// observed.
+type samplingReporter struct {
+	w        io.Writer
+	now      func() time.Time
+	since    time.Time
+	observed uint64
+	dropped  uint64
+}
+
+func newSamplingReporter(w io.Writer, now func() time.Time) *samplingReporter {
+	return &samplingReporter{w: w, now: now, since: now()}
+}
+
+func (r *samplingReporter) add(sampling *pb.TapEvent_Sampling) {
+	r.observed += sampling.GetObserved()
+	r.dropped += sampling.GetDropped()
+
+	now := r.now()
+	if now.Sub(r.since) < samplingReportInterval {
+		return
+	}
+	if r.dropped > 0 {
+		fmt.Fprintf(r.w, "sampling: observed %s over the last %s; use --max-rps to observe more\n",
+			samplingRatio(r.observed, r.dropped), now.Sub(r.since).Round(time.Second))
+	}
+	r.since, r.observed, r.dropped = now, 0, 0
+}
+
+// samplingRatio describes the proportion of requests that were observed.
+func samplingRatio(observed, dropped uint64) string {
+	total := observed + dropped
+	if total == 0 {
+		return "0 of 0 requests"
+	}
+	return fmt.Sprintf("%d of ~%d requests (%.1f%%)", observed, total, 100*float64(observed)/float64(total))
+}
+
 // src returns the source peer of a `TapEvent`.
 func src(event *pb.TapEvent) peer {
 	return peer{
@@ -357,3 +559,410 @@ func routeLabels(event *pb.TapEvent) string {
 
 	return out
 }
+
+type tapEventJSON struct {
+	Target         string            `json:"target,omitempty"`
+	Source         peerJSON          `json:"source"`
+	Destination    peerJSON          `json:"destination"`
+	ProxyDirection string            `json:"proxyDirection"`
+	TLS            string            `json:"tls,omitempty"`
+	RouteLabels    map[string]string `json:"routeLabels,omitempty"`
+	RequestInit    *requestInitJSON  `json:"requestInit,omitempty"`
+	ResponseInit   *responseInitJSON `json:"responseInit,omitempty"`
+	ResponseEnd    *responseEndJSON  `json:"responseEnd,omitempty"`
+}
+
+type samplingEventJSON struct {
+	Sampling samplingJSON `json:"sampling"`
+}
+
+type samplingJSON struct {
+	Pod          string  `json:"pod"`
+	Namespace    string  `json:"namespace"`
+	WindowMicros int64   `json:"windowMicros"`
+	Observed     uint64  `json:"observed"`
+	Dropped      uint64  `json:"dropped"`
+	Ratio        float64 `json:"ratio"`
+}
+
+type peerJSON struct {
+	Address   string `json:"address"`
+	Resource  string `json:"resource,omitempty"`
+	Pod       string `json:"pod,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type requestInitJSON struct {
+	ID        string `json:"id"`
+	Method    string `json:"method"`
+	Scheme    string `json:"scheme,omitempty"`
+	Authority string `json:"authority"`
+	Path      string `json:"path"`
+}
+
+type responseInitJSON struct {
+	ID            string `json:"id"`
+	Status        uint32 `json:"status"`
+	LatencyMicros int64  `json:"latencyMicros"`
+}
+
+type responseEndJSON struct {
+	ID             string  `json:"id"`
+	GrpcStatus     string  `json:"grpcStatus,omitempty"`
+	ResetErrorCode *uint32 `json:"resetErrorCode,omitempty"`
+	DurationMicros int64   `json:"durationMicros"`
+	ResponseBytes  uint64  `json:"responseBytes"`
+}
+
+// renderTapJSON writes every event received from tapClient to w as a JSON
+// object on its own line. The source and destination are resolved to
+// resources of kind `resource` where possible.
+func renderTapJSON(w io.Writer, tapClient pb.Api_TapByResourceClient, resource string) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	for {
+		log.Debug("Waiting for data...")
+		event, err := tapClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return nil
+		}
+		if sampling := event.GetSampling(); sampling != nil {
+			if err := encoder.Encode(samplingToJSON(sampling)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := encoder.Encode(tapEventToJSON(event, resource)); err != nil {
+			return err
+		}
+	}
+}
+
+func tapEventToJSON(event *pb.TapEvent, resource string) tapEventJSON {
+	dst := dst(event)
+	src := src(event)
+
+	ev := tapEventJSON{
+		Source:         src.toJSON(resource),
+		Destination:    dst.toJSON(resource),
+		ProxyDirection: event.GetProxyDirection().String(),
+		RouteLabels:    event.GetRouteMeta().GetLabels(),
+	}
+	if event.GetTarget() != nil {
+		ev.Target = tapTargetName(event.GetTarget())
+	}
+	switch event.GetProxyDirection() {
+	case pb.TapEvent_INBOUND:
+		ev.TLS = src.tlsStatus()
+	case pb.TapEvent_OUTBOUND:
+		ev.TLS = dst.tlsStatus()
+	}
+
+	id := func(id *pb.TapEvent_Http_StreamId) string {
+		return fmt.Sprintf("%d:%d", id.GetBase(), id.GetStream())
+	}
+
+	switch httpEv := event.GetHttp().GetEvent().(type) {
+	case *pb.TapEvent_Http_RequestInit_:
+		ev.RequestInit = &requestInitJSON{
+			ID:        id(httpEv.RequestInit.GetId()),
+			Method:    tap.MethodString(httpEv.RequestInit.GetMethod()),
+			Scheme:    tap.SchemeString(httpEv.RequestInit.GetScheme()),
+			Authority: httpEv.RequestInit.GetAuthority(),
+			Path:      httpEv.RequestInit.GetPath(),
+		}
+
+	case *pb.TapEvent_Http_ResponseInit_:
+		ev.ResponseInit = &responseInitJSON{
+			ID:            id(httpEv.ResponseInit.GetId()),
+			Status:        httpEv.ResponseInit.GetHttpStatus(),
+			LatencyMicros: durationMicros(httpEv.ResponseInit.GetSinceRequestInit()),
+		}
+
+	case *pb.TapEvent_Http_ResponseEnd_:
+		ev.ResponseEnd = &responseEndJSON{
+			ID:             id(httpEv.ResponseEnd.GetId()),
+			DurationMicros: durationMicros(httpEv.ResponseEnd.GetSinceResponseInit()),
+			ResponseBytes:  httpEv.ResponseEnd.GetResponseBytes(),
+		}
+		switch eos := httpEv.ResponseEnd.GetEos().GetEnd().(type) {
+		case *pb.Eos_GrpcStatusCode:
+			ev.ResponseEnd.GrpcStatus = codes.Code(eos.GrpcStatusCode).String()
+		case *pb.Eos_ResetErrorCode:
+			ev.ResponseEnd.ResetErrorCode = &eos.ResetErrorCode
+		}
+	}
+
+	return ev
+}
+
+func samplingToJSON(sampling *pb.TapEvent_Sampling) samplingEventJSON {
+	ratio := 1.0
+	if total := sampling.GetObserved() + sampling.GetDropped(); total > 0 {
+		ratio = float64(sampling.GetObserved()) / float64(total)
+	}
+	return samplingEventJSON{
+		Sampling: samplingJSON{
+			Pod:          sampling.GetPod(),
+			Namespace:    sampling.GetNamespace(),
+			WindowMicros: durationMicros(sampling.GetWindow()),
+			Observed:     sampling.GetObserved(),
+			Dropped:      sampling.GetDropped(),
+			Ratio:        ratio,
+		},
+	}
+}
+
+// toJSON describes the peer, resolving it to a resource of kind
+// `resourceKind` if it belongs to one, as formatResource does.
+func (p *peer) toJSON(resourceKind string) peerJSON {
+	pj := peerJSON{
+		Address:   addr.PublicAddressToString(p.address),
+		Pod:       p.labels[k8s.Pod],
+		Namespace: p.labels[k8s.Namespace],
+	}
+	if resourceName, exists := p.labels[resourceKind]; exists && resourceKind != k8s.Namespace {
+		pj.Resource = fmt.Sprintf("%s/%s", resourceKind, resourceName)
+	}
+	return pj
+}
+
+func durationMicros(d *duration.Duration) int64 {
+	dur, err := ptypes.Duration(d)
+	if err != nil {
+		return 0
+	}
+	return dur.Nanoseconds() / int64(time.Microsecond)
+}
+
+// The HAR 1.2 format, as specified at
+// http://www.softwareishard.com/blog/har-12-spec/. Sizes that tap does not
+// report are set to -1, as the specification requires.
+type (
+	harDocument struct {
+		Log harLog `json:"log"`
+	}
+
+	harLog struct {
+		Version string     `json:"version"`
+		Creator harCreator `json:"creator"`
+		Entries []harEntry `json:"entries"`
+		Comment string     `json:"comment,omitempty"`
+	}
+
+	harCreator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+
+	harEntry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         harTimings  `json:"timings"`
+		ServerIPAddress string      `json:"serverIPAddress,omitempty"`
+		Connection      string      `json:"connection,omitempty"`
+	}
+
+	harRequest struct {
+		Method      string         `json:"method"`
+		URL         string         `json:"url"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		QueryString []harNameValue `json:"queryString"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int            `json:"bodySize"`
+	}
+
+	harResponse struct {
+		Status      uint32         `json:"status"`
+		StatusText  string         `json:"statusText"`
+		HTTPVersion string         `json:"httpVersion"`
+		Cookies     []harNameValue `json:"cookies"`
+		Headers     []harNameValue `json:"headers"`
+		Content     harContent     `json:"content"`
+		RedirectURL string         `json:"redirectURL"`
+		HeadersSize int            `json:"headersSize"`
+		BodySize    int64          `json:"bodySize"`
+		Comment     string         `json:"comment,omitempty"`
+	}
+
+	harContent struct {
+		Size     int64  `json:"size"`
+		MimeType string `json:"mimeType"`
+	}
+
+	harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	harTimings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+)
+
+// harStream holds the events of a request, up to the end of its response.
+type harStream struct {
+	started time.Time
+	event   *pb.TapEvent
+	reqInit *pb.TapEvent_Http_RequestInit
+	rspInit *pb.TapEvent_Http_ResponseInit
+	rspEnd  *pb.TapEvent_Http_ResponseEnd
+}
+
+// renderTapHAR pairs the request and response events received from tapClient
+// by stream and writes them to w as a HAR document once the stream ends.
+// Requests whose response has not ended by then are left out. now provides
+// the time at which the last event was received, at which requests are
+// considered to have started.
+func renderTapHAR(w io.Writer, tapClient pb.Api_TapByResourceClient, now func() time.Time) error {
+	outstanding := make(map[topRequestID]*harStream)
+	completed := []*harStream{}
+	var observed, dropped uint64
+
+	for {
+		log.Debug("Waiting for data...")
+		event, err := tapClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if status.Code(err) != codes.Canceled {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			break
+		}
+
+		if sampling := event.GetSampling(); sampling != nil {
+			observed += sampling.GetObserved()
+			dropped += sampling.GetDropped()
+			continue
+		}
+
+		id := topRequestID{
+			src: addr.PublicAddressToString(event.GetSource()),
+			dst: addr.PublicAddressToString(event.GetDestination()),
+		}
+		switch ev := event.GetHttp().GetEvent().(type) {
+		case *pb.TapEvent_Http_RequestInit_:
+			id.stream = ev.RequestInit.GetId().GetStream()
+			outstanding[id] = &harStream{started: now(), event: event, reqInit: ev.RequestInit}
+
+		case *pb.TapEvent_Http_ResponseInit_:
+			id.stream = ev.ResponseInit.GetId().GetStream()
+			if stream, ok := outstanding[id]; ok {
+				stream.rspInit = ev.ResponseInit
+			}
+
+		case *pb.TapEvent_Http_ResponseEnd_:
+			id.stream = ev.ResponseEnd.GetId().GetStream()
+			if stream, ok := outstanding[id]; ok {
+				stream.rspEnd = ev.ResponseEnd
+				completed = append(completed, stream)
+				delete(outstanding, id)
+			}
+		}
+	}
+
+	sort.SliceStable(completed, func(i, j int) bool {
+		return completed[i].started.Before(completed[j].started)
+	})
+	entries := make([]harEntry, len(completed))
+	for i, stream := range completed {
+		entries[i] = stream.toHAR()
+	}
+
+	comment := ""
+	if dropped > 0 {
+		comment = fmt.Sprintf("observed %s", samplingRatio(observed, dropped))
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(harDocument{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "linkerd tap", Version: version.Version},
+			Entries: entries,
+			Comment: comment,
+		},
+	})
+}
+
+func (s *harStream) toHAR() harEntry {
+	rspEnd := s.rspEnd
+	wait := durationMillis(s.rspInit.GetSinceRequestInit())
+	receive := durationMillis(rspEnd.GetSinceResponseInit())
+	if s.rspInit == nil {
+		// the stream was reset before a response was received
+		wait = durationMillis(rspEnd.GetSinceRequestInit())
+	}
+
+	scheme := strings.ToLower(tap.SchemeString(s.reqInit.GetScheme()))
+	if scheme == "" {
+		scheme = "http"
+	}
+	queryString := []harNameValue{}
+	if u, err := url.Parse(s.reqInit.GetPath()); err == nil {
+		for name, values := range u.Query() {
+			for _, value := range values {
+				queryString = append(queryString, harNameValue{Name: name, Value: value})
+			}
+		}
+		sort.SliceStable(queryString, func(i, j int) bool { return queryString[i].Name < queryString[j].Name })
+	}
+
+	comment := ""
+	switch eos := rspEnd.GetEos().GetEnd().(type) {
+	case *pb.Eos_GrpcStatusCode:
+		comment = fmt.Sprintf("grpc-status=%s", codes.Code(eos.GrpcStatusCode))
+	case *pb.Eos_ResetErrorCode:
+		comment = fmt.Sprintf("reset-error=%d", eos.ResetErrorCode)
+	}
+
+	// tap events do not carry headers
+	entry := harEntry{
+		StartedDateTime: s.started.Format(time.RFC3339Nano),
+		Time:            wait + receive,
+		Request: harRequest{
+			Method:      tap.MethodString(s.reqInit.GetMethod()),
+			URL:         fmt.Sprintf("%s://%s%s", scheme, s.reqInit.GetAuthority(), s.reqInit.GetPath()),
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			QueryString: queryString,
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: harResponse{
+			Status:      s.rspInit.GetHttpStatus(),
+			StatusText:  http.StatusText(int(s.rspInit.GetHttpStatus())),
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			Content:     harContent{Size: int64(rspEnd.GetResponseBytes())},
+			HeadersSize: -1,
+			BodySize:    int64(rspEnd.GetResponseBytes()),
+			Comment:     comment,
+		},
+		Timings: harTimings{Wait: wait, Receive: receive},
+	}
+	if dst := s.event.GetDestination(); dst != nil {
+		entry.ServerIPAddress = addr.PublicIPToString(dst.GetIp())
+		entry.Connection = strconv.FormatUint(uint64(dst.GetPort()), 10)
+	}
+	return entry
+}
+
+func durationMillis(d *duration.Duration) float64 {
+	return float64(durationMicros(d)) / 1000
+}
@@ -3,9 +3,13 @@ package cmd
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/linkerd/linkerd2/controller/api/public"
@@ -18,7 +22,7 @@ import (
 
 const targetName = "pod-666"
 
-func busyTest(t *testing.T, wide bool) {
+func busyTest(t *testing.T, outputFormat string) {
 	resourceType := k8s.Pod
 	params := util.TapRequestParams{
 		Resource:  resourceType + "/" + targetName,
@@ -80,16 +84,14 @@ func busyTest(t *testing.T, wide bool) {
 	}
 
 	writer := bytes.NewBufferString("")
-	err = requestTapByResourceFromAPI(writer, mockAPIClient, req, wide)
+	err = requestTapByResourceFromAPI(writer, mockAPIClient, req, outputFormat)
 	if err != nil {
 		t.Fatalf("Unexpected error: %v", err)
 	}
 
-	var goldenFilePath string
-	if wide {
-		goldenFilePath = "testdata/tap_busy_output_wide.golden"
-	} else {
-		goldenFilePath = "testdata/tap_busy_output.golden"
+	goldenFilePath := "testdata/tap_busy_output.golden"
+	if outputFormat != "" {
+		goldenFilePath = fmt.Sprintf("testdata/tap_busy_output_%s.golden", outputFormat)
 	}
 
 	goldenFileBytes, err := ioutil.ReadFile(goldenFilePath)
@@ -105,11 +107,15 @@ func busyTest(t *testing.T, wide bool) {
 
 func TestRequestTapByResourceFromAPI(t *testing.T) {
 	t.Run("Should render busy response if everything went well", func(t *testing.T) {
-		busyTest(t, false)
+		busyTest(t, "")
 	})
 
 	t.Run("Should render wide busy response if everything went well", func(t *testing.T) {
-		busyTest(t, true)
+		busyTest(t, wideOutput)
+	})
+
+	t.Run("Should render JSON busy response if everything went well", func(t *testing.T) {
+		busyTest(t, jsonOutput)
 	})
 
 	t.Run("Should render empty response if no events returned", func(t *testing.T) {
@@ -133,7 +139,7 @@ func TestRequestTapByResourceFromAPI(t *testing.T) {
 		}
 
 		writer := bytes.NewBufferString("")
-		err = requestTapByResourceFromAPI(writer, mockAPIClient, req, false)
+		err = requestTapByResourceFromAPI(writer, mockAPIClient, req, "")
 		if err != nil {
 			t.Fatalf("Unexpected error: %v", err)
 		}
@@ -171,13 +177,78 @@ func TestRequestTapByResourceFromAPI(t *testing.T) {
 		}
 
 		writer := bytes.NewBufferString("")
-		err = requestTapByResourceFromAPI(writer, mockAPIClient, req, false)
+		err = requestTapByResourceFromAPI(writer, mockAPIClient, req, "")
 		if err == nil {
 			t.Fatalf("Expecting error, got nothing but output [%s]", writer.String())
 		}
 	})
 }
 
+func TestTapMultipleTargets(t *testing.T) {
+	t.Run("Parses several targets", func(t *testing.T) {
+		expectations := []struct {
+			args      []string
+			resources []string
+		}{
+			{args: []string{"deploy"}, resources: []string{"deploy"}},
+			{args: []string{"deploy/web"}, resources: []string{"deploy/web"}},
+			{args: []string{"deploy", "web"}, resources: []string{"deploy/web"}},
+			{args: []string{"deploy", "web", "api"}, resources: []string{"deploy/web", "deploy/api"}},
+			{args: []string{"deploy/web", "sts/api"}, resources: []string{"deploy/web", "sts/api"}},
+		}
+
+		for _, exp := range expectations {
+			resources := tapResources(exp.args)
+			if !reflect.DeepEqual(resources, exp.resources) {
+				t.Fatalf("Expected %v to name %v, got %v", exp.args, exp.resources, resources)
+			}
+		}
+	})
+
+	t.Run("Labels events with their target", func(t *testing.T) {
+		req, err := util.BuildTapByResourceRequest(util.TapRequestParams{
+			Resource:  "deploy/web",
+			Resources: []string{"deploy/api"},
+			Namespace: "emojivoto",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		event := func(target string, path string) pb.TapEvent {
+			ev := util.CreateTapEvent(&pb.TapEvent_Http{
+				Event: &pb.TapEvent_Http_RequestInit_{
+					RequestInit: &pb.TapEvent_Http_RequestInit{
+						Id:        &pb.TapEvent_Http_StreamId{Base: 1},
+						Authority: "svc:8080",
+						Path:      path,
+					},
+				},
+			}, map[string]string{}, pb.TapEvent_INBOUND)
+			ev.Target = &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: target}
+			return ev
+		}
+
+		mockAPIClient := &public.MockAPIClient{}
+		mockAPIClient.APITapByResourceClientToReturn = &public.MockAPITapByResourceClient{
+			TapEventsToReturn: []pb.TapEvent{event("web", "/api/list"), event("api", "/list")},
+		}
+
+		writer := bytes.NewBufferString("")
+		if err := requestTapByResourceFromAPI(writer, mockAPIClient, req, ""); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(writer.String()), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("Expected 2 events, got %q", writer.String())
+		}
+		if !strings.HasPrefix(lines[0], "[deployment/web] req ") || !strings.HasPrefix(lines[1], "[deployment/api] req ") {
+			t.Fatalf("Expected events to be labelled with their target, got %q", writer.String())
+		}
+	})
+}
+
 func TestEventToString(t *testing.T) {
 	toTapEvent := func(httpEvent *pb.TapEvent_Http) *pb.TapEvent {
 		streamID := &pb.TapEvent_Http_StreamId{
@@ -341,3 +412,112 @@ func TestEventToString(t *testing.T) {
 		}
 	})
 }
+
+func TestRenderTapHAR(t *testing.T) {
+	streamID := func(stream uint64) *pb.TapEvent_Http_StreamId {
+		return &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream}
+	}
+	event := func(httpEvent *pb.TapEvent_Http) pb.TapEvent {
+		return util.CreateTapEvent(httpEvent, map[string]string{"deployment": "web", "namespace": "emojivoto"}, pb.TapEvent_OUTBOUND)
+	}
+
+	events := []pb.TapEvent{
+		event(&pb.TapEvent_Http{Event: &pb.TapEvent_Http_RequestInit_{RequestInit: &pb.TapEvent_Http_RequestInit{
+			Id:        streamID(1),
+			Method:    &pb.HttpMethod{Type: &pb.HttpMethod_Registered_{Registered: pb.HttpMethod_GET}},
+			Scheme:    &pb.Scheme{Type: &pb.Scheme_Registered_{Registered: pb.Scheme_HTTPS}},
+			Authority: "web.emojivoto.svc.cluster.local:8080",
+			Path:      "/api/list?limit=10&sort=name",
+		}}}),
+		event(&pb.TapEvent_Http{Event: &pb.TapEvent_Http_RequestInit_{RequestInit: &pb.TapEvent_Http_RequestInit{
+			Id:        streamID(2),
+			Method:    &pb.HttpMethod{Type: &pb.HttpMethod_Registered_{Registered: pb.HttpMethod_POST}},
+			Authority: "voting.emojivoto.svc.cluster.local:8080",
+			Path:      "/emojivoto.v1.VotingService/VoteDoughnut",
+		}}}),
+		event(&pb.TapEvent_Http{Event: &pb.TapEvent_Http_RequestInit_{RequestInit: &pb.TapEvent_Http_RequestInit{
+			Id:        streamID(3),
+			Method:    &pb.HttpMethod{Type: &pb.HttpMethod_Registered_{Registered: pb.HttpMethod_GET}},
+			Authority: "web.emojivoto.svc.cluster.local:8080",
+			Path:      "/never-ends",
+		}}}),
+		event(&pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseInit_{ResponseInit: &pb.TapEvent_Http_ResponseInit{
+			Id:               streamID(1),
+			SinceRequestInit: &duration.Duration{Nanos: 1500000},
+			HttpStatus:       200,
+		}}}),
+		event(&pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseEnd_{ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+			Id:               streamID(2),
+			SinceRequestInit: &duration.Duration{Nanos: 3000000},
+			ResponseBytes:    0,
+			Eos:              &pb.Eos{End: &pb.Eos_ResetErrorCode{ResetErrorCode: 2}},
+		}}}),
+		event(&pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseEnd_{ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+			Id:                streamID(1),
+			SinceRequestInit:  &duration.Duration{Nanos: 3500000},
+			SinceResponseInit: &duration.Duration{Nanos: 2000000},
+			ResponseBytes:     512,
+		}}}),
+		{Event: &pb.TapEvent_Sampling_{Sampling: &pb.TapEvent_Sampling{Pod: "web-5b9d4b5f8f-x8h9k", Namespace: "emojivoto", Observed: 3, Dropped: 9}}},
+	}
+
+	tapClient := &public.MockAPITapByResourceClient{TapEventsToReturn: events}
+	start := time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+	now := func() time.Time {
+		start = start.Add(time.Millisecond)
+		return start
+	}
+
+	writer := bytes.NewBufferString("")
+	if err := renderTapHAR(writer, tapClient, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	diffTestdata(t, "tap_har_output.golden", writer.String())
+}
+
+func TestSamplingReporter(t *testing.T) {
+	start := time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	writer := bytes.NewBufferString("")
+	reporter := newSamplingReporter(writer, func() time.Time { return now })
+
+	sampling := func(observed, dropped uint64) *pb.TapEvent_Sampling {
+		return &pb.TapEvent_Sampling{Pod: "web-5b9d4b5f8f-x8h9k", Namespace: "emojivoto", Observed: observed, Dropped: dropped}
+	}
+
+	reporter.add(sampling(10, 30))
+	now = start.Add(samplingReportInterval)
+	reporter.add(sampling(15, 25))
+	// nothing was dropped in the next interval, so it is not reported
+	now = start.Add(2 * samplingReportInterval)
+	reporter.add(sampling(20, 0))
+
+	expected := "sampling: observed 25 of ~80 requests (31.2%) over the last 5s; use --max-rps to observe more\n"
+	if writer.String() != expected {
+		t.Fatalf("Expected %q, got %q", expected, writer.String())
+	}
+}
+
+func TestSamplingToJSON(t *testing.T) {
+	sampling := &pb.TapEvent_Sampling{
+		Pod:       "web-5b9d4b5f8f-x8h9k",
+		Namespace: "emojivoto",
+		Window:    &duration.Duration{Seconds: 1},
+		Observed:  25,
+		Dropped:   75,
+	}
+
+	expected := samplingEventJSON{
+		Sampling: samplingJSON{
+			Pod:          "web-5b9d4b5f8f-x8h9k",
+			Namespace:    "emojivoto",
+			WindowMicros: 1000000,
+			Observed:     25,
+			Dropped:      75,
+			Ratio:        0.25,
+		},
+	}
+	if actual := samplingToJSON(sampling); actual != expected {
+		t.Fatalf("Expected %+v, got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,338 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
+	"github.com/linkerd/linkerd2/pkg/tap"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// traceOutput renders tap output as trees of correlated requests. It is
+// selected with --trace rather than --output.
+const traceOutput = "trace"
+
+const (
+	// traceLinger is how long a request is held back once it has ended, so
+	// that the requests it made that are reported late can be attached to it.
+	traceLinger = time.Second
+	// traceMaxAge is how long a request is held back if it does not end.
+	traceMaxAge = 30 * time.Second
+)
+
+// traceSpan is a request from one endpoint to another, as reported by the
+// proxy of its source (outbound), of its destination (inbound) or both.
+type traceSpan struct {
+	event   *pb.TapEvent
+	reqInit *pb.TapEvent_Http_RequestInit
+	rspInit *pb.TapEvent_Http_ResponseInit
+	rspEnd  *pb.TapEvent_Http_ResponseEnd
+
+	// directions holds the proxy directions that reported the request.
+	directions map[pb.TapEvent_ProxyDirection]bool
+	// open counts the directions whose response has not yet ended.
+	open int
+
+	started time.Time
+	ended   time.Time
+
+	parent   *traceSpan
+	children []*traceSpan
+}
+
+// traceStreamKey identifies the stream of a request as reported by one proxy.
+type traceStreamKey struct {
+	direction pb.TapEvent_ProxyDirection
+	id        topRequestID
+}
+
+// traceBuilder correlates tap events into trees of requests. Tap events do
+// not carry the trace headers that requests propagate, so a request is
+// assumed to be caused by the request its source was serving when it
+// started, if there was exactly one.
+type traceBuilder struct {
+	streams map[traceStreamKey]*traceSpan
+	spans   []*traceSpan
+}
+
+func newTraceBuilder() *traceBuilder {
+	return &traceBuilder{
+		streams: make(map[traceStreamKey]*traceSpan),
+	}
+}
+
+// add correlates an event, received at the given time.
+func (b *traceBuilder) add(event *pb.TapEvent, received time.Time) {
+	key := traceStreamKey{
+		direction: event.GetProxyDirection(),
+		id: topRequestID{
+			src: addr.PublicAddressToString(event.GetSource()),
+			dst: addr.PublicAddressToString(event.GetDestination()),
+		},
+	}
+
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *pb.TapEvent_Http_RequestInit_:
+		key.id.stream = ev.RequestInit.GetId().GetStream()
+		span := b.otherSide(event, ev.RequestInit)
+		if span == nil {
+			span = &traceSpan{
+				event:      event,
+				reqInit:    ev.RequestInit,
+				directions: make(map[pb.TapEvent_ProxyDirection]bool),
+				started:    received,
+			}
+			b.link(span)
+			b.spans = append(b.spans, span)
+		}
+		span.directions[event.GetProxyDirection()] = true
+		span.open++
+		b.streams[key] = span
+
+	case *pb.TapEvent_Http_ResponseInit_:
+		key.id.stream = ev.ResponseInit.GetId().GetStream()
+		if span, ok := b.streams[key]; ok {
+			// the latency seen by the source is the one its callers see
+			if span.rspInit == nil || event.GetProxyDirection() == pb.TapEvent_OUTBOUND {
+				span.rspInit = ev.ResponseInit
+			}
+		}
+
+	case *pb.TapEvent_Http_ResponseEnd_:
+		key.id.stream = ev.ResponseEnd.GetId().GetStream()
+		if span, ok := b.streams[key]; ok {
+			if span.rspEnd == nil || event.GetProxyDirection() == pb.TapEvent_OUTBOUND {
+				span.rspEnd = ev.ResponseEnd
+			}
+			delete(b.streams, key)
+			span.open--
+			if span.open == 0 {
+				span.ended = received
+			}
+		}
+	}
+}
+
+// otherSide returns the span of a request reported by the proxy at its other
+// end, if it has been reported. The source ports differ on either side, as
+// the source proxy opens its own connection to the destination.
+func (b *traceBuilder) otherSide(event *pb.TapEvent, req *pb.TapEvent_Http_RequestInit) *traceSpan {
+	for _, span := range b.spans {
+		if span.directions[event.GetProxyDirection()] || !span.ended.IsZero() {
+			continue
+		}
+		if stripPort(addr.PublicAddressToString(span.event.GetSource())) == stripPort(addr.PublicAddressToString(event.GetSource())) &&
+			addr.PublicAddressToString(span.event.GetDestination()) == addr.PublicAddressToString(event.GetDestination()) &&
+			span.reqInit.GetPath() == req.GetPath() &&
+			tap.MethodString(span.reqInit.GetMethod()) == tap.MethodString(req.GetMethod()) {
+			return span
+		}
+	}
+	return nil
+}
+
+// link attaches span to the request that caused it, if the source of span
+// was serving exactly one request when it started.
+func (b *traceBuilder) link(span *traceSpan) {
+	source := stripPort(addr.PublicAddressToString(span.event.GetSource()))
+	var candidates []*traceSpan
+	for _, candidate := range b.spans {
+		if stripPort(addr.PublicAddressToString(candidate.event.GetDestination())) == source && candidate.ended.IsZero() {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) == 1 {
+		span.parent = candidates[0]
+		candidates[0].children = append(candidates[0].children, span)
+	}
+}
+
+// flush returns, in the order in which they started, the trees of requests
+// that have ended at least traceLinger before now, or that started more than
+// traceMaxAge before now. If force is set, it returns every tree.
+func (b *traceBuilder) flush(now time.Time, force bool) []*traceSpan {
+	roots := []*traceSpan{}
+	for _, span := range b.spans {
+		if span.parent != nil {
+			continue
+		}
+		if force || now.Sub(span.started) >= traceMaxAge || span.settled(now) {
+			roots = append(roots, span)
+		}
+	}
+	if len(roots) == 0 {
+		return roots
+	}
+
+	flushed := make(map[*traceSpan]bool)
+	for _, root := range roots {
+		root.walk(func(span *traceSpan) { flushed[span] = true })
+	}
+	spans := []*traceSpan{}
+	for _, span := range b.spans {
+		if !flushed[span] {
+			spans = append(spans, span)
+		}
+	}
+	b.spans = spans
+	for key, span := range b.streams {
+		if flushed[span] {
+			delete(b.streams, key)
+		}
+	}
+
+	sort.SliceStable(roots, func(i, j int) bool { return roots[i].started.Before(roots[j].started) })
+	return roots
+}
+
+// settled returns whether the span and all of its descendants ended at least
+// traceLinger before now.
+func (s *traceSpan) settled(now time.Time) bool {
+	if s.ended.IsZero() || now.Sub(s.ended) < traceLinger {
+		return false
+	}
+	for _, child := range s.children {
+		if !child.settled(now) {
+			return false
+		}
+	}
+	return true
+}
+
+// walk calls fn for the span and its descendants, depth first.
+func (s *traceSpan) walk(fn func(*traceSpan)) {
+	fn(s)
+	for _, child := range s.children {
+		child.walk(fn)
+	}
+}
+
+// String describes the request on a single line, along with its response and
+// the latency observed by the proxy closest to its source.
+func (s *traceSpan) String() string {
+	source, destination := peerNames(s.event)
+	line := fmt.Sprintf("%s -> %s %s %s%s", source, destination, tap.MethodString(s.reqInit.GetMethod()), s.reqInit.GetAuthority(), s.reqInit.GetPath())
+
+	switch {
+	case s.rspEnd == nil:
+		return line + " (incomplete)"
+	case s.rspInit != nil:
+		line = fmt.Sprintf("%s :status=%d", line, s.rspInit.GetHttpStatus())
+	}
+	switch eos := s.rspEnd.GetEos().GetEnd().(type) {
+	case *pb.Eos_GrpcStatusCode:
+		if eos.GrpcStatusCode != 0 {
+			line = fmt.Sprintf("%s grpc-status=%s", line, codes.Code(eos.GrpcStatusCode))
+		}
+	case *pb.Eos_ResetErrorCode:
+		line = fmt.Sprintf("%s reset-error=%d", line, eos.ResetErrorCode)
+	}
+	if latency, err := ptypes.Duration(s.rspEnd.GetSinceRequestInit()); err == nil {
+		line = fmt.Sprintf("%s latency=%s", line, formatDuration(latency))
+	}
+	return line
+}
+
+// renderTrace writes the tree of requests under root to w.
+func renderTrace(w io.Writer, root *traceSpan) error {
+	count := 0
+	root.walk(func(*traceSpan) { count++ })
+	requests := "requests"
+	if count == 1 {
+		requests = "request"
+	}
+	if _, err := fmt.Fprintf(w, "trace (%d %s)\n", count, requests); err != nil {
+		return err
+	}
+
+	var render func(span *traceSpan, prefix, childPrefix string) error
+	render = func(span *traceSpan, prefix, childPrefix string) error {
+		if _, err := fmt.Fprintf(w, "%s%s\n", prefix, span); err != nil {
+			return err
+		}
+		for i, child := range span.children {
+			branch, indent := "├── ", "│   "
+			if i == len(span.children)-1 {
+				branch, indent = "└── ", "    "
+			}
+			if err := render(child, childPrefix+branch, childPrefix+indent); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := render(root, "", ""); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+// renderTapTraces correlates the events received from tapClient into trees of
+// requests, and writes each tree to w once its requests have ended. now
+// provides the time at which the last event was received, which, for
+// replayed events, is the time at which they were recorded.
+func renderTapTraces(w io.Writer, tapClient pb.Api_TapByResourceClient, now func() time.Time) error {
+	type receivedEvent struct {
+		event *pb.TapEvent
+		at    time.Time
+	}
+	events := make(chan receivedEvent)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			log.Debug("Waiting for data...")
+			event, err := tapClient.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			// read the time of the event before the next one is received
+			events <- receivedEvent{event: event, at: now()}
+		}
+	}()
+
+	builder := newTraceBuilder()
+	// sampling is reported as it is received, even when replaying
+	sampling := newSamplingReporter(os.Stderr, time.Now)
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	render := func(force bool) error {
+		for _, root := range builder.flush(now(), force) {
+			if err := renderTrace(w, root); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		select {
+		case received := <-events:
+			if received.event.GetSampling() != nil {
+				sampling.add(received.event.GetSampling())
+				continue
+			}
+			builder.add(received.event, received.at)
+
+		case <-ticker.C:
+			if err := render(false); err != nil {
+				return err
+			}
+
+		case err := <-errs:
+			if err != io.EOF {
+				fmt.Fprintln(os.Stderr, err)
+			}
+			return render(true)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
+)
+
+func TestRenderTapTraces(t *testing.T) {
+	type endpoint struct {
+		pod  string
+		ip   uint8
+		port uint32
+	}
+	voteBot := endpoint{"vote-bot-7b8cdf5c6d-2kqtn", 1, 0}
+	web := endpoint{"web-5b9d4b5f8f-x8h9k", 2, 8080}
+	voting := endpoint{"voting-6f8b97d5b4-9xl4v", 3, 8080}
+	emoji := endpoint{"emoji-78d6f5c8d7-qgmkw", 4, 8080}
+
+	event := func(direction pb.TapEvent_ProxyDirection, src endpoint, srcPort uint32, dst endpoint, http *pb.TapEvent_Http) pb.TapEvent {
+		return pb.TapEvent{
+			Source:          &pb.TcpAddress{Ip: addr.PublicIPV4(10, 0, 0, src.ip), Port: srcPort},
+			SourceMeta:      &pb.TapEvent_EndpointMeta{Labels: map[string]string{"pod": src.pod}},
+			Destination:     &pb.TcpAddress{Ip: addr.PublicIPV4(10, 0, 0, dst.ip), Port: dst.port},
+			DestinationMeta: &pb.TapEvent_EndpointMeta{Labels: map[string]string{"pod": dst.pod}},
+			ProxyDirection:  direction,
+			Event:           &pb.TapEvent_Http_{Http: http},
+		}
+	}
+	reqInit := func(stream uint64, method pb.HttpMethod_Registered, path string) *pb.TapEvent_Http {
+		return &pb.TapEvent_Http{Event: &pb.TapEvent_Http_RequestInit_{RequestInit: &pb.TapEvent_Http_RequestInit{
+			Id:        &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+			Method:    &pb.HttpMethod{Type: &pb.HttpMethod_Registered_{Registered: method}},
+			Authority: "svc:8080",
+			Path:      path,
+		}}}
+	}
+	rspInit := func(stream uint64, status uint32) *pb.TapEvent_Http {
+		return &pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseInit_{ResponseInit: &pb.TapEvent_Http_ResponseInit{
+			Id:         &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+			HttpStatus: status,
+		}}}
+	}
+	rspEnd := func(stream uint64, latencyMillis int32, grpcStatus uint32) *pb.TapEvent_Http {
+		return &pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseEnd_{ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+			Id:               &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+			SinceRequestInit: &duration.Duration{Nanos: latencyMillis * 1000000},
+			Eos:              &pb.Eos{End: &pb.Eos_GrpcStatusCode{GrpcStatusCode: grpcStatus}},
+		}}}
+	}
+
+	in, out := pb.TapEvent_INBOUND, pb.TapEvent_OUTBOUND
+	events := []pb.TapEvent{
+		// the call to voting is attributed to the only request web is
+		// serving; both of its proxies report it
+		event(in, voteBot, 4000, web, reqInit(1, pb.HttpMethod_GET, "/api/vote?choice=doughnut")),
+		event(out, web, 5000, voting, reqInit(2, pb.HttpMethod_POST, "/emojivoto.v1.VotingService/VoteDoughnut")),
+		event(in, web, 6000, voting, reqInit(3, pb.HttpMethod_POST, "/emojivoto.v1.VotingService/VoteDoughnut")),
+		event(in, web, 6000, voting, rspInit(3, 200)),
+		event(in, web, 6000, voting, rspEnd(3, 4, 0)),
+		event(out, web, 5000, voting, rspInit(2, 200)),
+		event(out, web, 5000, voting, rspEnd(2, 6, 0)),
+		event(in, voteBot, 4000, web, rspInit(1, 200)),
+		event(in, voteBot, 4000, web, rspEnd(1, 9, 0)),
+
+		// calls made while web serves concurrent requests cannot be
+		// attributed to either of them, as trace headers are not tapped
+		event(in, voteBot, 4001, web, reqInit(4, pb.HttpMethod_GET, "/api/list")),
+		event(in, voteBot, 4002, web, reqInit(5, pb.HttpMethod_GET, "/api/list")),
+		event(out, web, 5001, emoji, reqInit(6, pb.HttpMethod_POST, "/emojivoto.v1.EmojiService/ListAll")),
+		event(out, web, 5002, emoji, reqInit(7, pb.HttpMethod_POST, "/emojivoto.v1.EmojiService/ListAll")),
+		event(out, web, 5001, emoji, rspInit(6, 200)),
+		event(out, web, 5001, emoji, rspEnd(6, 2, 14)),
+		event(out, web, 5002, emoji, rspInit(7, 200)),
+		event(out, web, 5002, emoji, rspEnd(7, 3, 0)),
+		event(in, voteBot, 4001, web, rspInit(4, 200)),
+		event(in, voteBot, 4001, web, rspEnd(4, 5, 0)),
+		event(in, voteBot, 4002, web, rspInit(5, 500)),
+		event(in, voteBot, 4002, web, rspEnd(5, 4, 0)),
+
+		// requests that never end are still rendered
+		event(in, voteBot, 4003, web, reqInit(8, pb.HttpMethod_GET, "/api/stream")),
+	}
+
+	// now is called both as events are received and as traces are flushed
+	var mu sync.Mutex
+	start := time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+	now := func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		start = start.Add(time.Millisecond)
+		return start
+	}
+
+	writer := bytes.NewBufferString("")
+	tapClient := &public.MockAPITapByResourceClient{TapEventsToReturn: events}
+	if err := renderTapTraces(writer, tapClient, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	diffTestdata(t, "tap_trace_output.golden", writer.String())
+}
+
+func TestTraceBuilderFlush(t *testing.T) {
+	start := time.Date(2019, 4, 1, 12, 0, 0, 0, time.UTC)
+	builder := newTraceBuilder()
+
+	src := &pb.TcpAddress{Ip: addr.PublicIPV4(10, 0, 0, 1), Port: 4000}
+	dst := &pb.TcpAddress{Ip: addr.PublicIPV4(10, 0, 0, 2), Port: 8080}
+	id := &pb.TapEvent_Http_StreamId{Base: 1, Stream: 1}
+	builder.add(&pb.TapEvent{Source: src, Destination: dst, ProxyDirection: pb.TapEvent_INBOUND,
+		Event: &pb.TapEvent_Http_{Http: &pb.TapEvent_Http{Event: &pb.TapEvent_Http_RequestInit_{
+			RequestInit: &pb.TapEvent_Http_RequestInit{Id: id, Path: "/"},
+		}}}}, start)
+	builder.add(&pb.TapEvent{Source: src, Destination: dst, ProxyDirection: pb.TapEvent_INBOUND,
+		Event: &pb.TapEvent_Http_{Http: &pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseEnd_{
+			ResponseEnd: &pb.TapEvent_Http_ResponseEnd{Id: id},
+		}}}}, start.Add(time.Millisecond))
+
+	if roots := builder.flush(start.Add(traceLinger/2), false); len(roots) != 0 {
+		t.Fatalf("Expected the request to be held back, got %d traces", len(roots))
+	}
+	if roots := builder.flush(start.Add(2*traceLinger), false); len(roots) != 1 {
+		t.Fatalf("Expected 1 trace, got %d", len(roots))
+	}
+	if roots := builder.flush(start.Add(3*traceLinger), true); len(roots) != 0 {
+		t.Fatalf("Expected traces to be flushed once, got %d", len(roots))
+	}
+}
@@ -2,8 +2,11 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +17,7 @@ import (
 	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/addr"
+	"github.com/linkerd/linkerd2/pkg/tap"
 	runewidth "github.com/mattn/go-runewidth"
 	termbox "github.com/nsf/termbox-go"
 	log "github.com/sirupsen/logrus"
@@ -21,16 +25,20 @@ import (
 )
 
 type topOptions struct {
-	namespace   string
-	toResource  string
-	toNamespace string
-	maxRps      float32
-	scheme      string
-	method      string
-	authority   string
-	path        string
-	hideSources bool
-	routes      bool
+	namespace     string
+	labelSelector string
+	toResource    string
+	toNamespace   string
+	maxRps        float32
+	scheme        string
+	method        string
+	authority     string
+	path          string
+	hideSources   bool
+	routes        bool
+	sort          string
+	output        string
+	replay        string
 }
 
 type topRequest struct {
@@ -63,6 +71,14 @@ type tableColumn struct {
 	flexible   bool
 	rightAlign bool
 	value      func(tableRow) string
+	// Orders rows when sorting by this column. If nil, rows are ordered by
+	// value.
+	less func(a, b tableRow) bool
+}
+
+// name identifies the column in the --sort flag.
+func (c tableColumn) name() string {
+	return strings.ToLower(strings.Replace(c.header, " ", "-", -1))
 }
 
 type tableRow struct {
@@ -93,6 +109,10 @@ func (r tableRow) merge(other tableRow) tableRow {
 	return r
 }
 
+func (r tableRow) successRate() float64 {
+	return float64(r.successes) / float64(r.successes+r.failures)
+}
+
 type column int
 
 const (
@@ -113,10 +133,11 @@ const (
 type topTable struct {
 	columns [columnCount]tableColumn
 	rows    []tableRow
+	sortBy  column
 }
 
 func newTopTable() *topTable {
-	table := topTable{}
+	table := topTable{sortBy: countColumn}
 
 	table.columns[sourceColumn] =
 		tableColumn{
@@ -189,6 +210,9 @@ func newTopTable() *topTable {
 			value: func(r tableRow) string {
 				return strconv.Itoa(r.count)
 			},
+			less: func(a, b tableRow) bool {
+				return a.count > b.count
+			},
 		}
 
 	table.columns[bestColumn] =
@@ -202,6 +226,9 @@ func newTopTable() *topTable {
 			value: func(r tableRow) string {
 				return formatDuration(r.best)
 			},
+			less: func(a, b tableRow) bool {
+				return a.best > b.best
+			},
 		}
 
 	table.columns[worstColumn] =
@@ -215,6 +242,9 @@ func newTopTable() *topTable {
 			value: func(r tableRow) string {
 				return formatDuration(r.worst)
 			},
+			less: func(a, b tableRow) bool {
+				return a.worst > b.worst
+			},
 		}
 
 	table.columns[lastColumn] =
@@ -228,6 +258,9 @@ func newTopTable() *topTable {
 			value: func(r tableRow) string {
 				return formatDuration(r.last)
 			},
+			less: func(a, b tableRow) bool {
+				return a.last > b.last
+			},
 		}
 
 	table.columns[successRateColumn] =
@@ -239,13 +272,33 @@ func newTopTable() *topTable {
 			flexible:   false,
 			rightAlign: true,
 			value: func(r tableRow) string {
-				return fmt.Sprintf("%.2f%%", 100.0*float32(r.successes)/float32(r.successes+r.failures))
+				return fmt.Sprintf("%.2f%%", 100.0*r.successRate())
+			},
+			less: func(a, b tableRow) bool {
+				return a.successRate() < b.successRate()
 			},
 		}
 
 	return &table
 }
 
+// configure hides and keys the columns according to options.
+func (t *topTable) configure(options *topOptions) {
+	if options.hideSources {
+		t.columns[sourceColumn].key = false
+		t.columns[sourceColumn].display = false
+	}
+
+	if options.routes {
+		t.columns[methodColumn].key = false
+		t.columns[methodColumn].display = false
+		t.columns[pathColumn].key = false
+		t.columns[pathColumn].display = false
+		t.columns[routeColumn].key = true
+		t.columns[routeColumn].display = true
+	}
+}
+
 const (
 	headerHeight  = 3
 	columnSpacing = 2
@@ -263,6 +316,9 @@ func newTopOptions() *topOptions {
 		path:        "",
 		hideSources: false,
 		routes:      false,
+		sort:        "count",
+		output:      "",
+		replay:      "",
 	}
 }
 
@@ -302,34 +358,35 @@ func newCmdTop() *cobra.Command {
   linkerd top deploy/web
 
   # display traffic for the web-dlbvj pod in the default namespace
-  linkerd top pod/web-dlbvj`,
+  linkerd top pod/web-dlbvj
+
+  # display traffic for the web deployment per ServiceProfile route, slowest first
+  linkerd top deploy/web --routes --sort worst
+
+  # print the traffic of the web deployment as JSON once interrupted
+  linkerd top deploy/web --routes -o json
+
+  # display the traffic of the web deployment recorded with "linkerd tap --record"
+  linkerd top deploy/web --replay web.tap`,
 		Args:      cobra.RangeArgs(1, 2),
 		ValidArgs: util.ValidTargets,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			requestParams := util.TapRequestParams{
-				Resource:    strings.Join(args, "/"),
-				Namespace:   options.namespace,
-				ToResource:  options.toResource,
-				ToNamespace: options.toNamespace,
-				MaxRps:      options.maxRps,
-				Scheme:      options.scheme,
-				Method:      options.method,
-				Authority:   options.authority,
-				Path:        options.path,
+				Resource:      strings.Join(args, "/"),
+				Namespace:     options.namespace,
+				LabelSelector: options.labelSelector,
+				ToResource:    options.toResource,
+				ToNamespace:   options.toNamespace,
+				MaxRps:        options.maxRps,
+				Scheme:        options.scheme,
+				Method:        options.method,
+				Authority:     options.authority,
+				Path:          options.path,
 			}
 
-			if options.hideSources {
-				table.columns[sourceColumn].key = false
-				table.columns[sourceColumn].display = false
-			}
-
-			if options.routes {
-				table.columns[methodColumn].key = false
-				table.columns[methodColumn].display = false
-				table.columns[pathColumn].key = false
-				table.columns[pathColumn].display = false
-				table.columns[routeColumn].key = true
-				table.columns[routeColumn].display = true
+			table.configure(options)
+			if err := table.sortByName(options.sort); err != nil {
+				return err
 			}
 
 			req, err := util.BuildTapByResourceRequest(requestParams)
@@ -337,12 +394,27 @@ func newCmdTop() *cobra.Command {
 				return err
 			}
 
-			return getTrafficByResourceFromAPI(checkPublicAPIClientOrExit(), req, table)
+			var client pb.ApiClient
+			if options.replay != "" {
+				client = tap.NewReplayClient(options.replay)
+			} else {
+				client = checkPublicAPIClientOrExit()
+			}
+
+			switch options.output {
+			case "":
+				return getTrafficByResourceFromAPI(client, req, table, options.replay != "")
+			case jsonOutput:
+				return getTrafficSnapshotFromAPI(os.Stdout, client, req, table)
+			default:
+				return fmt.Errorf("output format \"%s\" not recognized", options.output)
+			}
 		},
 	}
 
 	cmd.PersistentFlags().StringVarP(&options.namespace, "namespace", "n", options.namespace,
 		"Namespace of the specified resource")
+	addLabelSelectorFlag(cmd.PersistentFlags(), &options.labelSelector)
 	cmd.PersistentFlags().StringVar(&options.toResource, "to", options.toResource,
 		"Display requests to this resource")
 	cmd.PersistentFlags().StringVar(&options.toNamespace, "to-namespace", options.toNamespace,
@@ -359,11 +431,20 @@ func newCmdTop() *cobra.Command {
 		"Display requests with paths that start with this prefix")
 	cmd.PersistentFlags().BoolVar(&options.hideSources, "hide-sources", options.hideSources, "Hide the source column")
 	cmd.PersistentFlags().BoolVar(&options.routes, "routes", options.routes, "Display data per route instead of per path")
+	cmd.PersistentFlags().StringVar(&options.sort, "sort", options.sort,
+		"Sort rows by this column, with the busiest, slowest or least successful rows first (for example: \"worst\" or \"success-rate\")")
+	cmd.PersistentFlags().StringVarP(&options.output, "output", "o", options.output,
+		fmt.Sprintf("Output format. One of: \"%s\", which prints the traffic seen until interrupted or the tap stream ends", jsonOutput))
+	cmd.PersistentFlags().StringVar(&options.replay, "replay", options.replay,
+		"Display traffic recorded with \"linkerd tap --record\" in this file instead of live traffic")
 
 	return cmd
 }
 
-func getTrafficByResourceFromAPI(client pb.ApiClient, req *pb.TapByResourceRequest, table *topTable) error {
+// getTrafficByResourceFromAPI renders the traffic tapped through client until
+// the user quits. Unless keepOnEOF is set, it also returns once the tap
+// stream ends.
+func getTrafficByResourceFromAPI(client pb.ApiClient, req *pb.TapByResourceRequest, table *topTable, keepOnEOF bool) error {
 	rsp, err := client.TapByResource(context.Background(), req)
 	if err != nil {
 		return err
@@ -377,26 +458,81 @@ func getTrafficByResourceFromAPI(client pb.ApiClient, req *pb.TapByResourceReque
 
 	requestCh := make(chan topRequest, 100)
 	done := make(chan struct{})
+	sortCh := make(chan struct{})
 
-	go recvEvents(rsp, requestCh, done)
-	go pollInput(done)
+	go recvEvents(os.Stdout, rsp, requestCh, done, keepOnEOF)
+	go pollInput(done, sortCh)
 
-	renderTable(table, requestCh, done)
+	renderTable(table, requestCh, done, sortCh)
 
 	return nil
 }
 
-func recvEvents(tapClient pb.Api_TapByResourceClient, requestCh chan<- topRequest, done chan<- struct{}) {
+// getTrafficSnapshotFromAPI aggregates the traffic tapped through client
+// until interrupted or the tap stream ends, and then writes the table to w
+// as JSON.
+func getTrafficSnapshotFromAPI(w io.Writer, client pb.ApiClient, req *pb.TapByResourceRequest, table *topTable) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rsp, err := client.TapByResource(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	return renderTopJSON(w, rsp, table, interrupt)
+}
+
+// renderTopJSON inserts the requests received from tapClient into table until
+// the stream ends or stop fires, and then writes the table's rows to w.
+func renderTopJSON(w io.Writer, tapClient pb.Api_TapByResourceClient, table *topTable, stop <-chan os.Signal) error {
+	requestCh := make(chan topRequest, 100)
+	done := make(chan struct{})
+	go recvEvents(os.Stderr, tapClient, requestCh, done, false)
+
+	for stopped := false; !stopped; {
+		select {
+		case req := <-requestCh:
+			table.insert(req)
+		case <-done:
+			// requests are sent before the stream is reported done
+			for len(requestCh) > 0 {
+				table.insert(<-requestCh)
+			}
+			stopped = true
+		case <-stop:
+			stopped = true
+		}
+	}
+
+	b, err := json.MarshalIndent(table.toJSON(), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", b)
+	return err
+}
+
+// recvEvents sends the requests of tapClient's stream to requestCh once they
+// end, writing why the stream ended to w.
+func recvEvents(w io.Writer, tapClient pb.Api_TapByResourceClient, requestCh chan<- topRequest, done chan<- struct{}, keepOnEOF bool) {
 	outstandingRequests := make(map[topRequestID]topRequest)
 	for {
 		event, err := tapClient.Recv()
 		if err == io.EOF {
-			fmt.Println("Tap stream terminated")
+			if keepOnEOF {
+				return
+			}
+			fmt.Fprintln(w, "Tap stream terminated")
 			close(done)
 			return
 		}
 		if err != nil {
-			fmt.Println(err.Error())
+			fmt.Fprintln(w, err.Error())
 			close(done)
 			return
 		}
@@ -433,7 +569,7 @@ func recvEvents(tapClient pb.Api_TapByResourceClient, requestCh chan<- topReques
 	}
 }
 
-func pollInput(done chan<- struct{}) {
+func pollInput(done chan<- struct{}, sortCh chan<- struct{}) {
 	for {
 		switch ev := termbox.PollEvent(); ev.Type {
 		case termbox.EventKey:
@@ -441,11 +577,14 @@ func pollInput(done chan<- struct{}) {
 				close(done)
 				return
 			}
+			if ev.Ch == 's' {
+				sortCh <- struct{}{}
+			}
 		}
 	}
 }
 
-func renderTable(table *topTable, requestCh <-chan topRequest, done <-chan struct{}) {
+func renderTable(table *topTable, requestCh <-chan topRequest, done <-chan struct{}, sortCh <-chan struct{}) {
 	ticker := time.NewTicker(100 * time.Millisecond)
 
 	for {
@@ -454,6 +593,8 @@ func renderTable(table *topTable, requestCh <-chan topRequest, done <-chan struc
 			return
 		case req := <-requestCh:
 			table.insert(req)
+		case <-sortCh:
+			table.sortByNext()
 		case <-ticker.C:
 			termbox.Clear(termbox.ColorDefault, termbox.ColorDefault)
 			table.adjustColumnWidths()
@@ -471,14 +612,7 @@ func newRow(req topRequest) (tableRow, error) {
 		route = public.DefaultRouteName
 	}
 	method := req.reqInit.GetMethod().GetRegistered().String()
-	source := stripPort(addr.PublicAddressToString(req.event.GetSource()))
-	if pod := req.event.SourceMeta.Labels["pod"]; pod != "" {
-		source = pod
-	}
-	destination := stripPort(addr.PublicAddressToString(req.event.GetDestination()))
-	if pod := req.event.DestinationMeta.Labels["pod"]; pod != "" {
-		destination = pod
-	}
+	source, destination := peerNames(req.event)
 
 	latency, err := ptypes.Duration(req.rspEnd.GetSinceRequestInit())
 	if err != nil {
@@ -520,6 +654,20 @@ func newRow(req topRequest) (tableRow, error) {
 	}, nil
 }
 
+// peerNames returns the names of the source and destination of a tap event,
+// which are their pods or, outside of the mesh, their IPs.
+func peerNames(event *pb.TapEvent) (string, string) {
+	source := stripPort(addr.PublicAddressToString(event.GetSource()))
+	if pod := event.GetSourceMeta().GetLabels()["pod"]; pod != "" {
+		source = pod
+	}
+	destination := stripPort(addr.PublicAddressToString(event.GetDestination()))
+	if pod := event.GetDestinationMeta().GetLabels()["pod"]; pod != "" {
+		destination = pod
+	}
+	return source, destination
+}
+
 func (t *topTable) insert(req topRequest) {
 	insert, err := newRow(req)
 	if err != nil {
@@ -556,9 +704,9 @@ func stripPort(address string) string {
 }
 
 func (t *topTable) renderHeaders() {
-	tbprint(0, 0, "(press q to quit)")
+	tbprint(0, 0, "(press q to quit, s to sort by the next column)")
 	x := 0
-	for _, col := range t.columns {
+	for i, col := range t.columns {
 		if !col.display {
 			continue
 		}
@@ -566,7 +714,11 @@ func (t *topTable) renderHeaders() {
 		if col.rightAlign {
 			padding = col.width - runewidth.StringWidth(col.header)
 		}
-		tbprintBold(x+padding, headerHeight-1, col.header)
+		attr := termbox.AttrBold
+		if column(i) == t.sortBy {
+			attr |= termbox.AttrUnderline
+		}
+		tbprintAttr(x+padding, headerHeight-1, col.header, attr)
 		x += col.width + columnSpacing
 	}
 }
@@ -586,10 +738,50 @@ func (t *topTable) adjustColumnWidths() {
 	}
 }
 
-func (t *topTable) renderBody() {
+// sort orders the rows by the column they are sorted by.
+func (t *topTable) sort() {
+	col := t.columns[t.sortBy]
+	less := col.less
+	if less == nil {
+		less = func(a, b tableRow) bool {
+			return col.value(a) < col.value(b)
+		}
+	}
 	sort.SliceStable(t.rows, func(i, j int) bool {
-		return t.rows[i].count > t.rows[j].count
+		return less(t.rows[i], t.rows[j])
 	})
+}
+
+// sortByName sorts the rows by the displayed column named name.
+func (t *topTable) sortByName(name string) error {
+	names := []string{}
+	for i, col := range t.columns {
+		if !col.display {
+			continue
+		}
+		if col.name() == strings.ToLower(name) {
+			t.sortBy = column(i)
+			return nil
+		}
+		names = append(names, col.name())
+	}
+	return fmt.Errorf("unknown sort column \"%s\"; must be one of: %s", name, strings.Join(names, ", "))
+}
+
+// sortByNext sorts the rows by the displayed column after the one they are
+// sorted by.
+func (t *topTable) sortByNext() {
+	for i := 1; i < int(columnCount); i++ {
+		next := (t.sortBy + column(i)) % columnCount
+		if t.columns[next].display {
+			t.sortBy = next
+			return
+		}
+	}
+}
+
+func (t *topTable) renderBody() {
+	t.sort()
 
 	for i, row := range t.rows {
 		x := 0
@@ -616,9 +808,9 @@ func tbprint(x, y int, msg string) {
 	}
 }
 
-func tbprintBold(x, y int, msg string) {
+func tbprintAttr(x, y int, msg string, attr termbox.Attribute) {
 	for _, c := range msg {
-		termbox.SetCell(x, y, c, termbox.AttrBold, termbox.ColorDefault)
+		termbox.SetCell(x, y, c, attr, termbox.ColorDefault)
 		x += runewidth.RuneWidth(c)
 	}
 }
@@ -632,3 +824,49 @@ func formatDuration(d time.Duration) string {
 	}
 	return d.Round(time.Second).String()
 }
+
+type topRowJSON struct {
+	Source      string  `json:"source,omitempty"`
+	Destination string  `json:"destination,omitempty"`
+	Method      string  `json:"method,omitempty"`
+	Path        string  `json:"path,omitempty"`
+	Route       string  `json:"route,omitempty"`
+	Count       int     `json:"count"`
+	BestMicros  int64   `json:"bestMicros"`
+	WorstMicros int64   `json:"worstMicros"`
+	LastMicros  int64   `json:"lastMicros"`
+	SuccessRate float64 `json:"successRate"`
+}
+
+// toJSON returns the sorted rows of the table, with the values of the key
+// columns that are displayed.
+func (t *topTable) toJSON() []topRowJSON {
+	t.sort()
+
+	rows := make([]topRowJSON, len(t.rows))
+	for i, row := range t.rows {
+		rows[i] = topRowJSON{
+			Count:       row.count,
+			BestMicros:  int64(row.best / time.Microsecond),
+			WorstMicros: int64(row.worst / time.Microsecond),
+			LastMicros:  int64(row.last / time.Microsecond),
+			SuccessRate: row.successRate(),
+		}
+		if t.columns[sourceColumn].display {
+			rows[i].Source = row.source
+		}
+		if t.columns[destinationColumn].display {
+			rows[i].Destination = row.destination
+		}
+		if t.columns[methodColumn].display {
+			rows[i].Method = row.method
+		}
+		if t.columns[pathColumn].display {
+			rows[i].Path = row.path
+		}
+		if t.columns[routeColumn].display {
+			rows[i].Route = row.route
+		}
+	}
+	return rows
+}
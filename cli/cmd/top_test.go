@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/linkerd/linkerd2/controller/api/public"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
+)
+
+func topEvents() []pb.TapEvent {
+	event := func(port uint32, route string, http *pb.TapEvent_Http) pb.TapEvent {
+		ev := pb.TapEvent{
+			Source:          &pb.TcpAddress{Ip: addr.PublicIPV4(10, 0, 0, 1), Port: port},
+			SourceMeta:      &pb.TapEvent_EndpointMeta{Labels: map[string]string{"pod": "web-5b9d4b5f8f-x8h9k"}},
+			Destination:     &pb.TcpAddress{Ip: addr.PublicIPV4(10, 0, 0, 2), Port: 8080},
+			DestinationMeta: &pb.TapEvent_EndpointMeta{Labels: map[string]string{"pod": "books-6f8b97d5b4-9xl4v"}},
+			ProxyDirection:  pb.TapEvent_OUTBOUND,
+			Event:           &pb.TapEvent_Http_{Http: http},
+		}
+		if route != "" {
+			ev.RouteMeta = &pb.TapEvent_RouteMeta{Labels: map[string]string{"route": route}}
+		}
+		return ev
+	}
+	request := func(port uint32, stream uint64, route, path string, status uint32, latencyMillis int32) []pb.TapEvent {
+		id := &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream}
+		return []pb.TapEvent{
+			event(port, route, &pb.TapEvent_Http{Event: &pb.TapEvent_Http_RequestInit_{RequestInit: &pb.TapEvent_Http_RequestInit{
+				Id:     id,
+				Method: &pb.HttpMethod{Type: &pb.HttpMethod_Registered_{Registered: pb.HttpMethod_GET}},
+				Path:   path,
+			}}}),
+			event(port, route, &pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseInit_{ResponseInit: &pb.TapEvent_Http_ResponseInit{
+				Id:         id,
+				HttpStatus: status,
+			}}}),
+			event(port, route, &pb.TapEvent_Http{Event: &pb.TapEvent_Http_ResponseEnd_{ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+				Id:               id,
+				SinceRequestInit: &duration.Duration{Nanos: latencyMillis * 1000000},
+			}}}),
+		}
+	}
+
+	events := []pb.TapEvent{}
+	events = append(events, request(4000, 1, "GET /books/{id}", "/books/1", 200, 3)...)
+	events = append(events, request(4001, 2, "GET /books/{id}", "/books/2", 500, 12)...)
+	events = append(events, request(4002, 3, "GET /books/{id}", "/books/3", 200, 5)...)
+	events = append(events, request(4003, 4, "GET /authors", "/authors", 200, 40)...)
+	events = append(events, request(4004, 5, "", "/healthz", 200, 1)...)
+	return events
+}
+
+func TestRenderTopJSON(t *testing.T) {
+	expectations := []struct {
+		routes  bool
+		sort    string
+		golden  string
+		invalid bool
+	}{
+		{sort: "count", golden: "top_output.golden"},
+		{routes: true, sort: "count", golden: "top_routes_output.golden"},
+		{routes: true, sort: "Worst", golden: "top_routes_worst_output.golden"},
+		{routes: true, sort: "path", invalid: true},
+	}
+
+	for _, exp := range expectations {
+		options := newTopOptions()
+		options.routes = exp.routes
+		options.sort = exp.sort
+		table := newTopTable()
+		table.configure(options)
+
+		err := table.sortByName(options.sort)
+		if exp.invalid {
+			if err == nil {
+				t.Fatalf("Expected sorting by %s to be rejected", exp.sort)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		writer := bytes.NewBufferString("")
+		tapClient := &public.MockAPITapByResourceClient{TapEventsToReturn: topEvents()}
+		if err := renderTopJSON(writer, tapClient, table, nil); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		diffTestdata(t, exp.golden, writer.String())
+	}
+}
+
+func TestTopTableSortByNext(t *testing.T) {
+	options := newTopOptions()
+	options.hideSources = true
+	table := newTopTable()
+	table.configure(options)
+
+	expected := []column{destinationColumn, methodColumn, pathColumn, countColumn}
+	table.sortBy = successRateColumn
+	for _, col := range expected {
+		table.sortByNext()
+		if table.sortBy != col {
+			t.Fatalf("Expected to sort by %s, got %s", table.columns[col].header, table.columns[table.sortBy].header)
+		}
+	}
+}
@@ -0,0 +1,410 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	discoveryPb "github.com/linkerd/linkerd2/controller/gen/controller/discovery"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tap"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	authnV1 "k8s.io/api/authentication/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+type callerKey struct{}
+
+// authenticate validates the Kubernetes bearer token on req with a
+// TokenReview. It returns a context carrying the caller's identity, which also
+// forwards the token to the gRPC services the public API calls, so that the
+// tap server can authorize the caller on its own.
+func authenticate(k8sClient kubernetes.Interface, req *http.Request) (context.Context, error) {
+	token := bearerToken(req.Header.Get(authorizationHeader))
+	if token == "" {
+		return nil, errors.New("a Kubernetes bearer token is required to use the public API")
+	}
+
+	user, err := pkgK8s.AuthenticateToken(k8sClient, token)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Authenticated public API caller %s %v", user.Username, user.Groups)
+
+	ctx := context.WithValue(req.Context(), callerKey{}, user)
+	return metadata.AppendToOutgoingContext(ctx, strings.ToLower(authorizationHeader), bearerPrefix+token), nil
+}
+
+func bearerToken(header string) string {
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+}
+
+// namespaceAccess answers whether a caller may perform one action in a
+// namespace, remembering each answer for the lifetime of a single request so
+// that filtering a response issues one SubjectAccessReview per namespace.
+type namespaceAccess struct {
+	k8sClient kubernetes.Interface
+	user      authnV1.UserInfo
+	verb      string
+	group     string
+	resource  string
+	decisions map[string]error
+}
+
+// check returns an error if the caller may not access namespace. The empty
+// namespace stands for access across all namespaces.
+func (a *namespaceAccess) check(namespace string) error {
+	if err, ok := a.decisions[namespace]; ok {
+		return err
+	}
+
+	err := pkgK8s.SubjectResourceAuthz(a.k8sClient, a.user, namespace, a.verb, a.group, "", a.resource, "")
+	if err != nil {
+		log.Debugf("Denied %s to %s %s in namespace [%s]: %s", a.user.Username, a.verb, a.resource, namespace, err)
+		if namespace != "" {
+			err = fmt.Errorf("%s in namespace %s", err, namespace)
+		} else {
+			err = fmt.Errorf("%s in all namespaces", err)
+		}
+	}
+	a.decisions[namespace] = err
+	return err
+}
+
+// visible reports whether a result from namespace may be returned to the
+// caller. Results that do not belong to any namespace, such as edges to
+// clients outside the mesh, are always visible.
+func (a *namespaceAccess) visible(namespace string) bool {
+	return namespace == "" || a.check(namespace) == nil
+}
+
+// authzServer wraps an APIServer, rejecting requests for namespaces the
+// caller may not read and filtering out any results from such namespaces.
+// Reading metrics and listing pods and services requires "list" on "pods" in
+// the namespace; tapping requires "watch" on "pods" in the pkgK8s.TapAuthzGroup
+// API group. Version, SelfCheck and Config expose nothing namespace-specific and
+// are open to any authenticated caller.
+type authzServer struct {
+	APIServer
+	k8sClient kubernetes.Interface
+}
+
+func newAuthzServer(server APIServer, k8sClient kubernetes.Interface) *authzServer {
+	return &authzServer{
+		APIServer: server,
+		k8sClient: k8sClient,
+	}
+}
+
+func (s *authzServer) access(ctx context.Context, verb, group, resource string) (*namespaceAccess, error) {
+	user, ok := ctx.Value(callerKey{}).(*authnV1.UserInfo)
+	if !ok {
+		return nil, errors.New("request is not authenticated")
+	}
+	return &namespaceAccess{
+		k8sClient: s.k8sClient,
+		user:      *user,
+		verb:      verb,
+		group:     group,
+		resource:  resource,
+		decisions: make(map[string]error),
+	}, nil
+}
+
+func (s *authzServer) metricsAccess(ctx context.Context) (*namespaceAccess, error) {
+	return s.access(ctx, "list", "", "pods")
+}
+
+// checkResources rejects the request if the caller may not read any of the
+// explicitly named namespaces that resources belong to.
+func checkResources(access *namespaceAccess, resources ...*pb.Resource) error {
+	for _, resource := range resources {
+		if resource == nil {
+			continue
+		}
+		if ns := resourceNamespace(resource); ns != "" {
+			if err := access.check(ns); err != nil {
+				return forbidden(err)
+			}
+		}
+	}
+	return nil
+}
+
+// resourceNamespace returns the namespace a resource lives in, which for a
+// namespace is the namespace itself.
+func resourceNamespace(resource *pb.Resource) string {
+	if resource.GetType() == pkgK8s.Namespace {
+		return resource.GetName()
+	}
+	return resource.GetNamespace()
+}
+
+func forbidden(err error) error {
+	return httpError{
+		Code:         http.StatusForbidden,
+		WrappedError: err,
+	}
+}
+
+func (s *authzServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest) (*pb.StatSummaryResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResources(access, req.GetSelector().GetResource(), req.GetToResource(), req.GetFromResource()); err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.APIServer.StatSummary(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	filterStatSummary(access, rsp)
+	return rsp, nil
+}
+
+func filterStatSummary(access *namespaceAccess, rsp *pb.StatSummaryResponse) {
+	for _, table := range rsp.GetOk().GetStatTables() {
+		podGroup := table.GetPodGroup()
+		if podGroup == nil {
+			continue
+		}
+		rows := podGroup.Rows[:0]
+		for _, row := range podGroup.Rows {
+			if access.visible(resourceNamespace(row.GetResource())) {
+				rows = append(rows, row)
+			}
+		}
+		podGroup.Rows = rows
+	}
+}
+
+// filteredStatSummaryStream filters every response sent to a watcher down to
+// the namespaces the watcher may read.
+type filteredStatSummaryStream struct {
+	pb.Api_WatchStatSummaryServer
+	access *namespaceAccess
+}
+
+func (s filteredStatSummaryStream) Send(rsp *pb.StatSummaryResponse) error {
+	// responses are shared between watchers, so filter a copy
+	rsp = proto.Clone(rsp).(*pb.StatSummaryResponse)
+	filterStatSummary(s.access, rsp)
+	return s.Api_WatchStatSummaryServer.Send(rsp)
+}
+
+func (s *authzServer) WatchStatSummary(req *pb.WatchStatSummaryRequest, stream pb.Api_WatchStatSummaryServer) error {
+	access, err := s.metricsAccess(stream.Context())
+	if err != nil {
+		return err
+	}
+	statReq := req.GetRequest()
+	if err := checkResources(access, statReq.GetSelector().GetResource(), statReq.GetToResource(), statReq.GetFromResource()); err != nil {
+		return err
+	}
+
+	return s.APIServer.WatchStatSummary(req, filteredStatSummaryStream{stream, access})
+}
+
+func (s *authzServer) StatTimeSeries(ctx context.Context, req *pb.StatTimeSeriesRequest) (*pb.StatTimeSeriesResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResources(access, req.GetSelector().GetResource(), req.GetToResource(), req.GetFromResource()); err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.APIServer.StatTimeSeries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok := rsp.GetOk(); ok != nil {
+		series := ok.Series[:0]
+		for _, ts := range ok.Series {
+			if access.visible(resourceNamespace(ts.GetResource())) {
+				series = append(series, ts)
+			}
+		}
+		ok.Series = series
+	}
+	return rsp, nil
+}
+
+func (s *authzServer) TopRoutes(ctx context.Context, req *pb.TopRoutesRequest) (*pb.TopRoutesResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target := req.GetSelector().GetResource()
+	if resourceNamespace(target) == "" {
+		// route rows carry no namespace to filter on, so a request spanning
+		// all namespaces needs access to all of them
+		if err := access.check(""); err != nil {
+			return nil, forbidden(err)
+		}
+	}
+	if err := checkResources(access, target, req.GetToResource()); err != nil {
+		return nil, err
+	}
+
+	return s.APIServer.TopRoutes(ctx, req)
+}
+
+func (s *authzServer) Edges(ctx context.Context, req *pb.EdgesRequest) (*pb.EdgesResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResources(access, req.GetSelector().GetResource()); err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.APIServer.Edges(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok := rsp.GetOk(); ok != nil {
+		edges := ok.Edges[:0]
+		for _, edge := range ok.Edges {
+			if access.visible(resourceNamespace(edge.GetSrc())) && access.visible(resourceNamespace(edge.GetDst())) {
+				edges = append(edges, edge)
+			}
+		}
+		ok.Edges = edges
+	}
+	return rsp, nil
+}
+
+func (s *authzServer) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest) (*pb.SLOStatusResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetNamespace() != "" {
+		if err := access.check(req.GetNamespace()); err != nil {
+			return nil, forbidden(err)
+		}
+	}
+
+	rsp, err := s.APIServer.SLOStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok := rsp.GetOk(); ok != nil {
+		slos := ok.Slos[:0]
+		for _, slo := range ok.Slos {
+			if access.visible(slo.GetNamespace()) {
+				slos = append(slos, slo)
+			}
+		}
+		ok.Slos = slos
+	}
+	return rsp, nil
+}
+
+func (s *authzServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb.ListPodsResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetNamespace() != "" {
+		if err := access.check(req.GetNamespace()); err != nil {
+			return nil, forbidden(err)
+		}
+	}
+	if err := checkResources(access, req.GetSelector().GetResource()); err != nil {
+		return nil, err
+	}
+
+	rsp, err := s.APIServer.ListPods(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	pods := rsp.Pods[:0]
+	for _, pod := range rsp.Pods {
+		// pod names are reported as namespace/name
+		if access.visible(strings.SplitN(pod.GetName(), "/", 2)[0]) {
+			pods = append(pods, pod)
+		}
+	}
+	rsp.Pods = pods
+	return rsp, nil
+}
+
+func (s *authzServer) ListServices(ctx context.Context, req *pb.ListServicesRequest) (*pb.ListServicesResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetNamespace() != "" {
+		if err := access.check(req.GetNamespace()); err != nil {
+			return nil, forbidden(err)
+		}
+	}
+
+	rsp, err := s.APIServer.ListServices(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	services := rsp.Services[:0]
+	for _, svc := range rsp.Services {
+		if access.visible(svc.GetNamespace()) {
+			services = append(services, svc)
+		}
+	}
+	rsp.Services = services
+	return rsp, nil
+}
+
+func (s *authzServer) TapByResource(req *pb.TapByResourceRequest, stream pb.Api_TapByResourceServer) error {
+	access, err := s.access(stream.Context(), "watch", pkgK8s.TapAuthzGroup, "pods")
+	if err != nil {
+		return err
+	}
+	for _, target := range tap.Targets(req) {
+		if resourceNamespace(target.GetResource()) == "" {
+			if err := access.check(""); err != nil {
+				return forbidden(err)
+			}
+		}
+		if err := checkResources(access, target.GetResource()); err != nil {
+			return err
+		}
+	}
+
+	return s.APIServer.TapByResource(req, stream)
+}
+
+func (s *authzServer) Endpoints(ctx context.Context, req *discoveryPb.EndpointsParams) (*discoveryPb.EndpointsResponse, error) {
+	access, err := s.metricsAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// endpoints span every namespace in the cluster
+	if err := access.check(""); err != nil {
+		return nil, forbidden(err)
+	}
+
+	return s.APIServer.Endpoints(ctx, req)
+}
@@ -0,0 +1,263 @@
+package public
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	authnV1 "k8s.io/api/authentication/v1"
+	authV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeAuthzClient returns a client whose TokenReviews accept
+// "tenant-token" as the user "tenant", and whose SubjectAccessReviews only
+// let "tenant" list and tap pods in the emojivoto namespace.
+func newFakeAuthzClient() *fake.Clientset {
+	k8sClient := fake.NewSimpleClientset()
+	k8sClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		tr := action.(k8stesting.CreateAction).GetObject().(*authnV1.TokenReview)
+		if tr.Spec.Token == "tenant-token" {
+			tr.Status.Authenticated = true
+			tr.Status.User = authnV1.UserInfo{Username: "tenant"}
+		}
+		return true, tr, nil
+	})
+	k8sClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authV1.SubjectAccessReview)
+		attrs := sar.Spec.ResourceAttributes
+		sar.Status.Allowed = sar.Spec.User == "tenant" &&
+			attrs.Namespace == "emojivoto" && attrs.Resource == "pods" &&
+			((attrs.Verb == "list" && attrs.Group == "") || (attrs.Verb == "watch" && attrs.Group == pkgK8s.TapAuthzGroup))
+		return true, sar, nil
+	})
+	return k8sClient
+}
+
+func newAuthzTestClient(t *testing.T, server APIServer, token string) (APIClient, func()) {
+	k8sClient := newFakeAuthzClient()
+	httpServer := httptest.NewServer(&handler{
+		grpcServer:  newAuthzServer(server, k8sClient),
+		authzClient: k8sClient,
+	})
+
+	httpClient := http.DefaultClient
+	if token != "" {
+		httpClient = &http.Client{
+			Transport: &headerRoundTripper{
+				headers: map[string]string{authorizationHeader: bearerPrefix + token},
+				next:    http.DefaultTransport,
+			},
+		}
+	}
+
+	apiURL, err := url.Parse(httpServer.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	client, err := newClient(apiURL, httpClient, "linkerd")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	return client, httpServer.Close
+}
+
+func TestAuthzServer(t *testing.T) {
+	t.Run("Rejects callers without a valid token", func(t *testing.T) {
+		for _, token := range []string{"", "forged-token"} {
+			mockGrpcServer := &mockGrpcServer{
+				mockServer: mockServer{ResponseToReturn: &pb.ListPodsResponse{}},
+			}
+			client, stop := newAuthzTestClient(t, mockGrpcServer, token)
+			defer stop()
+
+			_, err := client.ListPods(context.TODO(), &pb.ListPodsRequest{})
+			if err == nil {
+				t.Fatalf("Expected token [%s] to be rejected", token)
+			}
+			if mockGrpcServer.LastRequestReceived != nil {
+				t.Fatalf("Expected request to not reach the server, got %+v", mockGrpcServer.LastRequestReceived)
+			}
+		}
+	})
+
+	t.Run("Rejects requests for namespaces the caller may not read", func(t *testing.T) {
+		mockGrpcServer := &mockGrpcServer{
+			mockServer: mockServer{ResponseToReturn: &pb.StatSummaryResponse{}},
+		}
+		client, stop := newAuthzTestClient(t, mockGrpcServer, "tenant-token")
+		defer stop()
+
+		requests := []*pb.StatSummaryRequest{
+			{
+				Selector: &pb.ResourceSelection{
+					Resource: &pb.Resource{Namespace: "books", Type: pkgK8s.Deployment},
+				},
+			},
+			{
+				Selector: &pb.ResourceSelection{
+					Resource: &pb.Resource{Name: "books", Type: pkgK8s.Namespace},
+				},
+			},
+			{
+				Selector: &pb.ResourceSelection{
+					Resource: &pb.Resource{Namespace: "emojivoto", Type: pkgK8s.Deployment},
+				},
+				Outbound: &pb.StatSummaryRequest_ToResource{
+					ToResource: &pb.Resource{Namespace: "books", Type: pkgK8s.Deployment},
+				},
+			},
+		}
+
+		for _, req := range requests {
+			_, err := client.StatSummary(context.TODO(), req)
+			expectedErr := "not authorized to access pods in namespace books"
+			if err == nil || err.Error() != expectedErr {
+				t.Fatalf("Expected error [%s], got [%v]", expectedErr, err)
+			}
+		}
+	})
+
+	t.Run("Filters out results from namespaces the caller may not read", func(t *testing.T) {
+		mockGrpcServer := &mockGrpcServer{
+			mockServer: mockServer{
+				ResponseToReturn: &pb.StatSummaryResponse{
+					Response: &pb.StatSummaryResponse_Ok_{
+						Ok: &pb.StatSummaryResponse_Ok{
+							StatTables: []*pb.StatTable{
+								{
+									Table: &pb.StatTable_PodGroup_{
+										PodGroup: &pb.StatTable_PodGroup{
+											Rows: []*pb.StatTable_PodGroup_Row{
+												{Resource: &pb.Resource{Namespace: "books", Name: "webapp", Type: pkgK8s.Deployment}},
+												{Resource: &pb.Resource{Namespace: "emojivoto", Name: "web", Type: pkgK8s.Deployment}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		client, stop := newAuthzTestClient(t, mockGrpcServer, "tenant-token")
+		defer stop()
+
+		rsp, err := client.StatSummary(context.TODO(), &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Type: pkgK8s.Deployment},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		rows := rsp.GetOk().GetStatTables()[0].GetPodGroup().GetRows()
+		if len(rows) != 1 || rows[0].GetResource().GetName() != "web" {
+			t.Fatalf("Expected only the emojivoto row, got %+v", rows)
+		}
+	})
+
+	t.Run("Filters pods and edges by namespace", func(t *testing.T) {
+		podsServer := &mockGrpcServer{
+			mockServer: mockServer{
+				ResponseToReturn: &pb.ListPodsResponse{
+					Pods: []*pb.Pod{
+						{Name: "books/webapp-1"},
+						{Name: "emojivoto/web-1"},
+					},
+				},
+			},
+		}
+		client, stop := newAuthzTestClient(t, podsServer, "tenant-token")
+		defer stop()
+
+		pods, err := client.ListPods(context.TODO(), &pb.ListPodsRequest{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		expectedPods := []*pb.Pod{{Name: "emojivoto/web-1"}}
+		if !proto.Equal(pods, &pb.ListPodsResponse{Pods: expectedPods}) {
+			t.Fatalf("Expected pods %+v, got %+v", expectedPods, pods.GetPods())
+		}
+
+		edgesServer := &mockGrpcServer{
+			mockServer: mockServer{
+				ResponseToReturn: &pb.EdgesResponse{
+					Response: &pb.EdgesResponse_Ok_{
+						Ok: &pb.EdgesResponse_Ok{
+							Edges: []*pb.Edge{
+								{Src: &pb.Resource{Namespace: "emojivoto", Name: "web"}, Dst: &pb.Resource{Namespace: "emojivoto", Name: "emoji"}},
+								{Src: &pb.Resource{Namespace: "books", Name: "traffic"}, Dst: &pb.Resource{Namespace: "emojivoto", Name: "web"}},
+								{Src: &pb.Resource{}, Dst: &pb.Resource{Namespace: "emojivoto", Name: "web"}},
+							},
+						},
+					},
+				},
+			},
+		}
+		client, stop = newAuthzTestClient(t, edgesServer, "tenant-token")
+		defer stop()
+
+		edges, err := client.Edges(context.TODO(), &pb.EdgesRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Namespace: "emojivoto", Type: pkgK8s.Deployment},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		var srcs []string
+		for _, edge := range edges.GetOk().GetEdges() {
+			srcs = append(srcs, edge.GetSrc().GetName())
+		}
+		if !reflect.DeepEqual(srcs, []string{"web", ""}) {
+			t.Fatalf("Expected edges from web and an unmeshed client, got %v", srcs)
+		}
+	})
+
+	t.Run("Authorizes taps of every target", func(t *testing.T) {
+		expectations := []struct {
+			targets []string
+			allowed bool
+		}{
+			{[]string{"emojivoto"}, true},
+			{[]string{"emojivoto", "emojivoto"}, true},
+			{[]string{"emojivoto", "books"}, false},
+		}
+
+		for _, exp := range expectations {
+			mockGrpcServer := &mockGrpcServer{TapStreamsToReturn: []*pb.TapEvent{{}}}
+			client, stop := newAuthzTestClient(t, mockGrpcServer, "tenant-token")
+			defer stop()
+
+			// only set Targets, as clients that tap several resources may
+			req := &pb.TapByResourceRequest{}
+			for _, ns := range exp.targets {
+				req.Targets = append(req.Targets, &pb.ResourceSelection{
+					Resource: &pb.Resource{Namespace: ns, Type: pkgK8s.Deployment, Name: "web"},
+				})
+			}
+
+			tapClient, err := client.TapByResource(context.TODO(), req)
+			if err == nil {
+				_, err = tapClient.Recv()
+			}
+			if exp.allowed && err != nil {
+				t.Fatalf("Expected tap of %v to be allowed, got %v", exp.targets, err)
+			}
+			if !exp.allowed && err == nil {
+				t.Fatalf("Expected tap of %v to be rejected", exp.targets)
+			}
+		}
+	})
+}
@@ -5,8 +5,10 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
@@ -19,6 +21,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"k8s.io/client-go/rest"
 )
 
 const (
@@ -27,6 +30,8 @@ const (
 	apiPrefix     = "api/" + apiVersion + "/" // Must be relative (without a leading slash).
 	apiPort       = 8085
 	apiDeployment = "linkerd-controller"
+
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 )
 
 // APIClient wraps two gRPC client interfaces:
@@ -43,6 +48,21 @@ type grpcOverHTTPClient struct {
 	serverURL             *url.URL
 	httpClient            *http.Client
 	controlPlaneNamespace string
+	// bearerToken, when set, authenticates requests that do not carry a
+	// token of their own
+	bearerToken string
+	// unauthorizedHint, when set, explains why requests the public API
+	// rejects as unauthenticated cannot succeed
+	unauthorizedHint string
+}
+
+type bearerTokenKey struct{}
+
+// WithBearerToken returns a context whose requests authenticate to the public
+// API with token instead of the client's own credentials. Servers calling the
+// public API on behalf of their callers use it to forward their identity.
+func WithBearerToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, bearerTokenKey{}, token)
 }
 
 func (c *grpcOverHTTPClient) StatSummary(ctx context.Context, req *pb.StatSummaryRequest, _ ...grpc.CallOption) (*pb.StatSummaryResponse, error) {
@@ -51,6 +71,33 @@ func (c *grpcOverHTTPClient) StatSummary(ctx context.Context, req *pb.StatSummar
 	return &msg, err
 }
 
+func (c *grpcOverHTTPClient) WatchStatSummary(ctx context.Context, req *pb.WatchStatSummaryRequest, _ ...grpc.CallOption) (pb.Api_WatchStatSummaryClient, error) {
+	url := c.endpointNameToPublicAPIURL("WatchStatSummary")
+	httpRsp, err := c.post(ctx, url, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkIfResponseHasError(httpRsp); err != nil {
+		httpRsp.Body.Close()
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		log.Debug("Closing response body after context marked as done")
+		httpRsp.Body.Close()
+	}()
+
+	return &statSummaryWatchClient{ctx: ctx, reader: bufio.NewReader(httpRsp.Body)}, nil
+}
+
+func (c *grpcOverHTTPClient) StatTimeSeries(ctx context.Context, req *pb.StatTimeSeriesRequest, _ ...grpc.CallOption) (*pb.StatTimeSeriesResponse, error) {
+	var msg pb.StatTimeSeriesResponse
+	err := c.apiRequest(ctx, "StatTimeSeries", req, &msg)
+	return &msg, err
+}
+
 func (c *grpcOverHTTPClient) Edges(ctx context.Context, req *pb.EdgesRequest, _ ...grpc.CallOption) (*pb.EdgesResponse, error) {
 	var msg pb.EdgesResponse
 	err := c.apiRequest(ctx, "Edges", req, &msg)
@@ -63,6 +110,12 @@ func (c *grpcOverHTTPClient) TopRoutes(ctx context.Context, req *pb.TopRoutesReq
 	return &msg, err
 }
 
+func (c *grpcOverHTTPClient) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest, _ ...grpc.CallOption) (*pb.SLOStatusResponse, error) {
+	var msg pb.SLOStatusResponse
+	err := c.apiRequest(ctx, "SLOStatus", req, &msg)
+	return &msg, err
+}
+
 func (c *grpcOverHTTPClient) Version(ctx context.Context, req *pb.Empty, _ ...grpc.CallOption) (*pb.VersionInfo, error) {
 	var msg pb.VersionInfo
 	err := c.apiRequest(ctx, "Version", req, &msg)
@@ -158,14 +211,27 @@ func (c *grpcOverHTTPClient) post(ctx context.Context, url *url.URL, req proto.M
 		return nil, err
 	}
 
+	token, ok := ctx.Value(bearerTokenKey{}).(string)
+	if !ok {
+		token = c.bearerToken
+	}
+	if token != "" {
+		httpReq.Header.Set(authorizationHeader, bearerPrefix+token)
+	}
+
 	rsp, err := c.httpClient.Do(httpReq.WithContext(ctx))
 	if err != nil {
 		log.Debugf("Error invoking [%s]: %v", url.String(), err)
-	} else {
-		log.Debugf("Response from [%s] had headers: %v", url.String(), rsp.Header)
+		return nil, err
+	}
+	log.Debugf("Response from [%s] had headers: %v", url.String(), rsp.Header)
+
+	if rsp.StatusCode == http.StatusUnauthorized && c.unauthorizedHint != "" {
+		defer rsp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", checkIfResponseHasError(rsp), c.unauthorizedHint)
 	}
 
-	return rsp, err
+	return rsp, nil
 }
 
 func (c *grpcOverHTTPClient) endpointNameToPublicAPIURL(endpoint string) *url.URL {
@@ -191,6 +257,25 @@ func (c tapClient) Context() context.Context     { return c.ctx }
 func (c tapClient) SendMsg(interface{}) error    { return nil }
 func (c tapClient) RecvMsg(interface{}) error    { return nil }
 
+type statSummaryWatchClient struct {
+	ctx    context.Context
+	reader *bufio.Reader
+}
+
+func (c statSummaryWatchClient) Recv() (*pb.StatSummaryResponse, error) {
+	var msg pb.StatSummaryResponse
+	err := fromByteStreamToProtocolBuffers(c.reader, &msg)
+	return &msg, err
+}
+
+// satisfy the pb.Api_WatchStatSummaryClient interface
+func (c statSummaryWatchClient) Header() (metadata.MD, error) { return nil, nil }
+func (c statSummaryWatchClient) Trailer() metadata.MD         { return nil }
+func (c statSummaryWatchClient) CloseSend() error             { return nil }
+func (c statSummaryWatchClient) Context() context.Context     { return c.ctx }
+func (c statSummaryWatchClient) SendMsg(interface{}) error    { return nil }
+func (c statSummaryWatchClient) RecvMsg(interface{}) error    { return nil }
+
 func fromByteStreamToProtocolBuffers(byteStreamContainingMessage *bufio.Reader, out proto.Message) error {
 	messageAsBytes, err := deserializePayloadFromReader(byteStreamContainingMessage)
 	if err != nil {
@@ -229,7 +314,18 @@ func NewInternalClient(controlPlaneNamespace string, kubeAPIHost string) (APICli
 		return nil, err
 	}
 
-	return newClient(apiURL, http.DefaultClient, controlPlaneNamespace)
+	client, err := newClient(apiURL, http.DefaultClient, controlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// authenticate as the pod's service account, for public APIs that
+	// require it
+	if token, err := ioutil.ReadFile(serviceAccountTokenPath); err == nil {
+		client.(*grpcOverHTTPClient).bearerToken = strings.TrimSpace(string(token))
+	}
+
+	return client, nil
 }
 
 // NewExternalClient creates a new Public API client intended to run from
@@ -281,5 +377,20 @@ func NewExternalClient(controlPlaneNamespace string, kubeAPI *k8s.KubernetesAPI)
 		return nil, err
 	}
 
-	return newClient(apiURL, httpClientToUse, controlPlaneNamespace)
+	client, err := newClient(apiURL, httpClientToUse, controlPlaneNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// the public API is reached over a port-forward, so client certificates
+	// never make it to the server; only bearer tokens authenticate the user
+	if !providesBearerToken(kubeAPI.Config) {
+		client.(*grpcOverHTTPClient).unauthorizedHint = "the current kubeconfig context does not authenticate with a bearer token, which the Linkerd public API requires when authorization is enabled; use a context that authenticates with a token, an auth provider or an exec credential plugin"
+	}
+
+	return client, nil
+}
+
+func providesBearerToken(config *rest.Config) bool {
+	return config.BearerToken != "" || config.BearerTokenFile != "" || config.AuthProvider != nil || config.ExecProvider != nil
 }
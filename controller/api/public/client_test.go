@@ -58,6 +58,63 @@ func TestNewInternalClient(t *testing.T) {
 			t.Fatalf("Expected request to URL [%v], but got [%v]", expectedURLRequested, actualURLRequested)
 		}
 	})
+
+	t.Run("Authenticates with a forwarded bearer token in place of its own", func(t *testing.T) {
+		expectations := []struct {
+			ctx      context.Context
+			expected string
+		}{
+			{context.Background(), "Bearer service-account-token"},
+			{WithBearerToken(context.Background(), "user-token"), "Bearer user-token"},
+		}
+
+		for _, exp := range expectations {
+			mockTransport := &mockTransport{}
+			mockTransport.responseToReturn = &http.Response{
+				StatusCode: 200,
+				Body:       ioutil.NopCloser(bufferedReader(t, &pb.Empty{})),
+			}
+
+			apiURL := &url.URL{Scheme: "http", Host: "some-hostname", Path: "/"}
+			client, err := newClient(apiURL, &http.Client{Transport: mockTransport}, "linkerd")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			client.(*grpcOverHTTPClient).bearerToken = "service-account-token"
+
+			if _, err := client.Version(exp.ctx, &pb.Empty{}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			actual := mockTransport.requestSent.Header.Get(authorizationHeader)
+			if actual != exp.expected {
+				t.Fatalf("Expected Authorization header [%s], but got [%s]", exp.expected, actual)
+			}
+		}
+	})
+
+	t.Run("Explains why unauthenticated requests cannot succeed", func(t *testing.T) {
+		mockTransport := &mockTransport{}
+		mockTransport.responseToReturn = &http.Response{
+			StatusCode: http.StatusUnauthorized,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bufferedReader(t, &pb.ApiError{Error: "a Kubernetes bearer token is required to use the public API"})),
+		}
+		mockTransport.responseToReturn.Header.Set(errorHeader, "true")
+
+		apiURL := &url.URL{Scheme: "http", Host: "some-hostname", Path: "/"}
+		client, err := newClient(apiURL, &http.Client{Transport: mockTransport}, "linkerd")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		client.(*grpcOverHTTPClient).unauthorizedHint = "use a token"
+
+		_, err = client.Version(context.Background(), &pb.Empty{})
+		expected := "a Kubernetes bearer token is required to use the public API: use a token"
+		if err == nil || err.Error() != expected {
+			t.Fatalf("Expected error [%s], but got [%v]", expected, err)
+		}
+	})
 }
 
 func TestFromByteStreamToProtocolBuffers(t *testing.T) {
@@ -5,16 +5,31 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 const (
-	inboundIdentityQuery  = "count(response_total%s) by (%s, client_id)"
-	outboundIdentityQuery = "count(response_total%s) by (%s, dst_%s, server_id, no_tls_reason)"
+	inboundIdentityQuery     = "count(response_total%s) by (%s, client_id)"
+	outboundIdentityQuery    = "count(response_total%s) by (%s, server_id, no_tls_reason)"
+	tcpInboundIdentityQuery  = "count(tcp_open_total%s) by (%s, client_id)"
+	tcpOutboundIdentityQuery = "count(tcp_open_total%s) by (%s, server_id, no_tls_reason)"
+
+	httpProtocol = "http"
+	tcpProtocol  = "tcp"
 )
 
+// edgeKey identifies the edge between two resources of the same type.
+type edgeKey struct {
+	srcNamespace string
+	src          string
+	dstNamespace string
+	dst          string
+}
+
 var formatMsg = map[string]string{
 	"disabled":                          "Disabled",
 	"loopback":                          "Loopback",
@@ -30,11 +45,23 @@ func (s *grpcServer) Edges(ctx context.Context, req *pb.EdgesRequest) (*pb.Edges
 		return edgesError(req, "Edges request missing Selector Resource"), nil
 	}
 
+	selector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return edgesError(req, err.Error()), nil
+	}
+
 	edges, err := s.getEdges(ctx, req)
 	if err != nil {
 		return edgesError(req, err.Error()), nil
 	}
 
+	if !selector.Empty() {
+		edges, err = s.filterEdges(req.GetSelector().GetResource(), selector, edges)
+		if err != nil {
+			return nil, util.GRPCError(err)
+		}
+	}
+
 	return &pb.EdgesResponse{
 		Response: &pb.EdgesResponse_Ok_{
 			Ok: &pb.EdgesResponse_Ok{
@@ -69,9 +96,100 @@ func (s *grpcServer) getEdges(ctx context.Context, req *pb.EdgesRequest) ([]*pb.
 	labelsOutboundStr := generateLabelStringWithExclusion(labelsOutbound, resourceType)
 	labelsInboundStr := generateLabelStringWithExclusion(labelsInbound, resourceType)
 
-	outboundQuery := fmt.Sprintf(outboundIdentityQuery, labelsOutboundStr, resourceType, resourceType)
-	inboundQuery := fmt.Sprintf(inboundIdentityQuery, labelsInboundStr, resourceType)
+	// ASSUMPTION: processEdgeStats relies on this ordering
+	outboundGroupBy := model.LabelNames{namespaceLabel, labelNames[1], dstNamespaceLabel, model.LabelName("dst_" + resourceType)}
+
+	httpEdges, err := s.queryEdges(ctx,
+		fmt.Sprintf(inboundIdentityQuery, labelsInboundStr, labelNames.String()),
+		fmt.Sprintf(outboundIdentityQuery, labelsOutboundStr, outboundGroupBy.String()),
+		resourceType, httpProtocol)
+	if err != nil {
+		return nil, err
+	}
 
+	tcpEdges, err := s.queryEdges(ctx,
+		fmt.Sprintf(tcpInboundIdentityQuery, labelsInboundStr, labelNames.String()),
+		fmt.Sprintf(tcpOutboundIdentityQuery, labelsOutboundStr, outboundGroupBy.String()),
+		resourceType, tcpProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	// every HTTP edge also shows up in the TCP metrics of the connections
+	// carrying its requests, so only keep the edges that are TCP-only
+	httpKeys := map[edgeKey]struct{}{}
+	for _, edge := range httpEdges {
+		httpKeys[edgeToKey(edge)] = struct{}{}
+	}
+	edges := httpEdges
+	for _, edge := range tcpEdges {
+		if _, ok := httpKeys[edgeToKey(edge)]; !ok {
+			edges = append(edges, edge)
+		}
+	}
+
+	if req.GetTimeWindow() == "" {
+		return edges, nil
+	}
+
+	httpResults, err := s.getPrometheusMetrics(ctx, map[promType]string{promRequests: reqQuery}, latencyQuantileQuery, labelsOutboundStr, req.TimeWindow, outboundGroupBy.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tcpQueries := map[promType]string{
+		promTCPConnections: tcpConnectionsQuery,
+		promTCPReadBytes:   tcpReadBytesQuery,
+		promTCPWriteBytes:  tcpWriteBytesQuery,
+	}
+	tcpResults, err := s.getPrometheusMetrics(ctx, tcpQueries, "", labelsOutboundStr, req.TimeWindow, outboundGroupBy.String())
+	if err != nil {
+		return nil, err
+	}
+
+	basicStats, tcpStats := processEdgeStats(append(httpResults, tcpResults...), outboundGroupBy)
+	for _, edge := range edges {
+		key := edgeToKey(edge)
+		switch edge.Protocol {
+		case httpProtocol:
+			edge.Stats = basicStats[key]
+			if edge.Stats == nil {
+				edge.Stats = &pb.BasicStats{}
+			}
+		case tcpProtocol:
+			edge.TcpStats = tcpStats[key]
+			if edge.TcpStats == nil {
+				edge.TcpStats = &pb.TcpStats{}
+			}
+		}
+		edge.TimeWindow = req.TimeWindow
+	}
+
+	return edges, nil
+}
+
+// filterEdges returns the edges whose source or destination is one of the
+// selected resources whose labels match selector.
+func (s *grpcServer) filterEdges(resource *pb.Resource, selector labels.Selector, edges []*pb.Edge) ([]*pb.Edge, error) {
+	selected, err := s.getMatchingResources(resource, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := []*pb.Edge{}
+	for _, edge := range edges {
+		_, srcOk := selected[resourceKey(edge.GetSrc())]
+		_, dstOk := selected[resourceKey(edge.GetDst())]
+		if srcOk || dstOk {
+			filtered = append(filtered, edge)
+		}
+	}
+	return filtered, nil
+}
+
+// queryEdges returns the edges between the resources found in the results of
+// a pair of inbound and outbound identity queries, marked with protocol.
+func (s *grpcServer) queryEdges(ctx context.Context, inboundQuery, outboundQuery, resourceType, protocol string) ([]*pb.Edge, error) {
 	inboundResult, err := s.queryProm(ctx, inboundQuery)
 	if err != nil {
 		return nil, err
@@ -82,16 +200,28 @@ func (s *grpcServer) getEdges(ctx context.Context, req *pb.EdgesRequest) ([]*pb.
 		return nil, err
 	}
 
-	edge := processEdgeMetrics(inboundResult, outboundResult, resourceType)
-	return edge, nil
+	edges := processEdgeMetrics(inboundResult, outboundResult, resourceType)
+	for _, edge := range edges {
+		edge.Protocol = protocol
+	}
+	return edges, nil
+}
+
+func edgeToKey(edge *pb.Edge) edgeKey {
+	return edgeKey{
+		srcNamespace: edge.GetSrc().GetNamespace(),
+		src:          edge.GetSrc().GetName(),
+		dstNamespace: edge.GetDst().GetNamespace(),
+		dst:          edge.GetDst().GetName(),
+	}
 }
 
 func processEdgeMetrics(inbound, outbound model.Vector, resourceType string) []*pb.Edge {
 	edges := []*pb.Edge{}
-	dstIndex := map[model.LabelValue]model.Metric{}
-	srcIndex := map[model.LabelValue][]model.Metric{}
-	resourceReplacementInbound := resourceType
-	resourceReplacementOutbound := "dst_" + resourceType
+	dstIndex := map[rKey]model.Metric{}
+	srcIndex := map[rKey][]model.Metric{}
+	resourceReplacementInbound := model.LabelName(resourceType)
+	resourceReplacementOutbound := model.LabelName("dst_" + resourceType)
 
 	for _, sample := range inbound {
 		// skip any inbound results that do not have a client_id, because this means
@@ -99,7 +229,10 @@ func processEdgeMetrics(inbound, outbound model.Vector, resourceType string) []*
 		// the src/dst are not both known) in future the edges command will support
 		// one-sided edges
 		if _, ok := sample.Metric[model.LabelName("client_id")]; ok {
-			key := sample.Metric[model.LabelName(resourceReplacementInbound)]
+			key := rKey{
+				Namespace: string(sample.Metric[namespaceLabel]),
+				Name:      string(sample.Metric[resourceReplacementInbound]),
+			}
 			dstIndex[key] = sample.Metric
 		}
 	}
@@ -108,9 +241,9 @@ func processEdgeMetrics(inbound, outbound model.Vector, resourceType string) []*
 		// skip any outbound results that do not have a server_id for same reason as
 		// above section
 		if _, ok := sample.Metric[model.LabelName("server_id")]; ok {
-			key := sample.Metric[model.LabelName(resourceReplacementOutbound)]
-			if _, ok := srcIndex[key]; !ok {
-				srcIndex[key] = []model.Metric{}
+			key := rKey{
+				Namespace: string(sample.Metric[dstNamespaceLabel]),
+				Name:      string(sample.Metric[resourceReplacementOutbound]),
 			}
 			srcIndex[key] = append(srcIndex[key], sample.Metric)
 		}
@@ -120,7 +253,7 @@ func processEdgeMetrics(inbound, outbound model.Vector, resourceType string) []*
 		for _, src := range sources {
 			dst, ok := dstIndex[key]
 			if !ok {
-				log.Errorf("missing resource in destination metrics: %s", key)
+				log.Errorf("missing resource in destination metrics: %s/%s", key.Namespace, key.Name)
 				continue
 			}
 			msg := ""
@@ -129,12 +262,14 @@ func processEdgeMetrics(inbound, outbound model.Vector, resourceType string) []*
 			}
 			edge := &pb.Edge{
 				Src: &pb.Resource{
-					Name: string(src[model.LabelName(resourceType)]),
-					Type: resourceType,
+					Namespace: string(src[namespaceLabel]),
+					Name:      string(src[model.LabelName(resourceType)]),
+					Type:      resourceType,
 				},
 				Dst: &pb.Resource{
-					Name: string(dst[model.LabelName(resourceType)]),
-					Type: resourceType,
+					Namespace: string(dst[namespaceLabel]),
+					Name:      string(dst[model.LabelName(resourceType)]),
+					Type:      resourceType,
 				},
 				ClientId:      string(dst[model.LabelName("client_id")]),
 				ServerId:      string(src[model.LabelName("server_id")]),
@@ -146,3 +281,64 @@ func processEdgeMetrics(inbound, outbound model.Vector, resourceType string) []*
 
 	return edges
 }
+
+// processEdgeStats aggregates the outbound request, latency and TCP metrics
+// of each edge, as grouped by (namespace, resource, dst_namespace,
+// dst_resource).
+func processEdgeStats(results []promResult, groupBy model.LabelNames) (map[edgeKey]*pb.BasicStats, map[edgeKey]*pb.TcpStats) {
+	stats := make(map[edgeKey]*pb.BasicStats)
+	tcpStats := make(map[edgeKey]*pb.TcpStats)
+
+	for _, result := range results {
+		for _, sample := range result.vec {
+			key := edgeKey{
+				srcNamespace: string(sample.Metric[groupBy[0]]),
+				src:          string(sample.Metric[groupBy[1]]),
+				dstNamespace: string(sample.Metric[groupBy[2]]),
+				dst:          string(sample.Metric[groupBy[3]]),
+			}
+			addBasicStats := func() {
+				if stats[key] == nil {
+					stats[key] = &pb.BasicStats{}
+				}
+			}
+			addTCPStats := func() {
+				if tcpStats[key] == nil {
+					tcpStats[key] = &pb.TcpStats{}
+				}
+			}
+
+			value := extractSampleValue(sample)
+			switch result.prom {
+			case promRequests:
+				addBasicStats()
+				switch string(sample.Metric[model.LabelName("classification")]) {
+				case success:
+					stats[key].SuccessCount += value
+				case failure:
+					stats[key].FailureCount += value
+				}
+			case promLatencyP50:
+				addBasicStats()
+				stats[key].LatencyMsP50 = value
+			case promLatencyP95:
+				addBasicStats()
+				stats[key].LatencyMsP95 = value
+			case promLatencyP99:
+				addBasicStats()
+				stats[key].LatencyMsP99 = value
+			case promTCPConnections:
+				addTCPStats()
+				tcpStats[key].OpenConnections = value
+			case promTCPReadBytes:
+				addTCPStats()
+				tcpStats[key].ReadBytesTotal = value
+			case promTCPWriteBytes:
+				addTCPStats()
+				tcpStats[key].WriteBytesTotal = value
+			}
+		}
+	}
+
+	return stats, tcpStats
+}
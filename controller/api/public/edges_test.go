@@ -0,0 +1,229 @@
+package public
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
+)
+
+const (
+	edgesInboundQuery  = `count(response_total{deployment!="", direction="inbound"}) by (namespace, deployment, client_id)`
+	edgesOutboundQuery = `count(response_total{deployment!="", direction="outbound"}) by (namespace, deployment, dst_namespace, dst_deployment, server_id, no_tls_reason)`
+	edgesRequestsQuery = `sum(increase(response_total{deployment!="", direction="outbound"}[1m])) by (namespace, deployment, dst_namespace, dst_deployment, classification, tls)`
+	edgesP99Query      = `histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{deployment!="", direction="outbound"}[1m])) by (le, namespace, deployment, dst_namespace, dst_deployment))`
+
+	tcpEdgesInboundQuery     = `count(tcp_open_total{deployment!="", direction="inbound"}) by (namespace, deployment, client_id)`
+	tcpEdgesOutboundQuery    = `count(tcp_open_total{deployment!="", direction="outbound"}) by (namespace, deployment, dst_namespace, dst_deployment, server_id, no_tls_reason)`
+	tcpEdgesConnectionsQuery = `sum(tcp_open_connections{deployment!="", direction="outbound"}) by (namespace, deployment, dst_namespace, dst_deployment)`
+	tcpEdgesReadBytesQuery   = `sum(increase(tcp_read_bytes_total{deployment!="", direction="outbound"}[1m])) by (namespace, deployment, dst_namespace, dst_deployment)`
+)
+
+func edgeSample(srcNamespace, src, dstNamespace, dst string, labels model.Metric, value model.SampleValue) *model.Sample {
+	metric := model.Metric{
+		"namespace":      model.LabelValue(srcNamespace),
+		"deployment":     model.LabelValue(src),
+		"dst_namespace":  model.LabelValue(dstNamespace),
+		"dst_deployment": model.LabelValue(dst),
+	}
+	for k, v := range labels {
+		metric[k] = v
+	}
+	return &model.Sample{Metric: metric, Value: value}
+}
+
+func TestEdges(t *testing.T) {
+	t.Run("Returns edges across the mesh with their traffic stats", func(t *testing.T) {
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		mockProm.Res = model.Vector{}
+		mockProm.Responses = map[string]model.Value{
+			edgesInboundQuery: model.Vector{
+				&model.Sample{
+					Metric: model.Metric{
+						"namespace":  "emojivoto",
+						"deployment": "emoji",
+						"client_id":  "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+					},
+				},
+			},
+			edgesOutboundQuery: model.Vector{
+				&model.Sample{
+					Metric: model.Metric{
+						"namespace":      "books",
+						"deployment":     "traffic",
+						"dst_namespace":  "emojivoto",
+						"dst_deployment": "emoji",
+						"server_id":      "emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+					},
+				},
+			},
+			edgesRequestsQuery: model.Vector{
+				&model.Sample{
+					Metric: model.Metric{
+						"namespace":      "books",
+						"deployment":     "traffic",
+						"dst_namespace":  "emojivoto",
+						"dst_deployment": "emoji",
+						"classification": "success",
+					},
+					Value: 90,
+				},
+				&model.Sample{
+					Metric: model.Metric{
+						"namespace":      "books",
+						"deployment":     "traffic",
+						"dst_namespace":  "emojivoto",
+						"dst_deployment": "emoji",
+						"classification": "failure",
+					},
+					Value: 10,
+				},
+			},
+			edgesP99Query: model.Vector{
+				&model.Sample{
+					Metric: model.Metric{
+						"namespace":      "books",
+						"deployment":     "traffic",
+						"dst_namespace":  "emojivoto",
+						"dst_deployment": "emoji",
+					},
+					Value: 250,
+				},
+			},
+		}
+
+		rsp, err := fakeGrpcServer.Edges(context.TODO(), &pb.EdgesRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Type: pkgK8s.Deployment},
+			},
+			TimeWindow: "1m",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expectedEdges := []*pb.Edge{
+			{
+				Src:      &pb.Resource{Namespace: "books", Name: "traffic", Type: "deployment"},
+				Dst:      &pb.Resource{Namespace: "emojivoto", Name: "emoji", Type: "deployment"},
+				ClientId: "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+				ServerId: "emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+				Stats: &pb.BasicStats{
+					SuccessCount: 90,
+					FailureCount: 10,
+					LatencyMsP99: 250,
+				},
+				TimeWindow: "1m",
+				Protocol:   "http",
+			},
+		}
+		if !proto.Equal(rsp, &pb.EdgesResponse{
+			Response: &pb.EdgesResponse_Ok_{Ok: &pb.EdgesResponse_Ok{Edges: expectedEdges}},
+		}) {
+			t.Fatalf("Expected edges %+v, got %+v", expectedEdges, rsp)
+		}
+	})
+
+	t.Run("Only queries edge stats when a time window is given", func(t *testing.T) {
+		exp := expectedStatRPC{
+			mockPromResponse: model.Vector{},
+			expectedPrometheusQueries: []string{
+				`count(response_total{direction="inbound", namespace="emojivoto", pod!=""}) by (namespace, pod, client_id)`,
+				`count(response_total{direction="outbound", namespace="emojivoto", pod!=""}) by (namespace, pod, dst_namespace, dst_pod, server_id, no_tls_reason)`,
+				`count(tcp_open_total{direction="inbound", namespace="emojivoto", pod!=""}) by (namespace, pod, client_id)`,
+				`count(tcp_open_total{direction="outbound", namespace="emojivoto", pod!=""}) by (namespace, pod, dst_namespace, dst_pod, server_id, no_tls_reason)`,
+			},
+		}
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		_, err = fakeGrpcServer.Edges(context.TODO(), &pb.EdgesRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Namespace: "emojivoto", Type: pkgK8s.Pod},
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		err = exp.verifyPromQueries(mockProm)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Returns TCP-only edges with their connection stats", func(t *testing.T) {
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(expectedStatRPC{})
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		webID := model.LabelValue("web.emojivoto.serviceaccount.identity.linkerd.cluster.local")
+		mockProm.Res = model.Vector{}
+		mockProm.Responses = map[string]model.Value{
+			edgesInboundQuery: model.Vector{
+				&model.Sample{Metric: model.Metric{"namespace": "emojivoto", "deployment": "emoji", "client_id": webID}},
+			},
+			edgesOutboundQuery: model.Vector{
+				edgeSample("emojivoto", "web", "emojivoto", "emoji", model.Metric{"server_id": "emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local"}, 1),
+			},
+			tcpEdgesInboundQuery: model.Vector{
+				&model.Sample{Metric: model.Metric{"namespace": "emojivoto", "deployment": "emoji", "client_id": webID}},
+				&model.Sample{Metric: model.Metric{"namespace": "emojivoto", "deployment": "redis", "client_id": webID}},
+			},
+			tcpEdgesOutboundQuery: model.Vector{
+				edgeSample("emojivoto", "web", "emojivoto", "emoji", model.Metric{"server_id": "emoji.emojivoto.serviceaccount.identity.linkerd.cluster.local"}, 1),
+				edgeSample("emojivoto", "web", "emojivoto", "redis", model.Metric{"server_id": "redis.emojivoto.serviceaccount.identity.linkerd.cluster.local"}, 1),
+			},
+			tcpEdgesConnectionsQuery: model.Vector{
+				edgeSample("emojivoto", "web", "emojivoto", "redis", nil, 3),
+			},
+			tcpEdgesReadBytesQuery: model.Vector{
+				edgeSample("emojivoto", "web", "emojivoto", "redis", nil, 6000),
+			},
+		}
+
+		rsp, err := fakeGrpcServer.Edges(context.TODO(), &pb.EdgesRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Type: pkgK8s.Deployment},
+			},
+			TimeWindow: "1m",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		edges := rsp.GetOk().GetEdges()
+		if len(edges) != 2 {
+			t.Fatalf("Expected 2 edges, got %+v", edges)
+		}
+		if edges[0].GetProtocol() != "http" || edges[0].GetDst().GetName() != "emoji" {
+			t.Fatalf("Expected an http edge to emoji, got %+v", edges[0])
+		}
+
+		expectedTCPEdge := &pb.Edge{
+			Src:        &pb.Resource{Namespace: "emojivoto", Name: "web", Type: "deployment"},
+			Dst:        &pb.Resource{Namespace: "emojivoto", Name: "redis", Type: "deployment"},
+			ClientId:   "web.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			ServerId:   "redis.emojivoto.serviceaccount.identity.linkerd.cluster.local",
+			TimeWindow: "1m",
+			Protocol:   "tcp",
+			TcpStats: &pb.TcpStats{
+				OpenConnections: 3,
+				ReadBytesTotal:  6000,
+			},
+		}
+		if !proto.Equal(edges[1], expectedTCPEdge) {
+			t.Fatalf("Expected TCP edge %+v, got %+v", expectedTCPEdge, edges[1])
+		}
+	})
+}
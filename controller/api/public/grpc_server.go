@@ -2,9 +2,11 @@ package public
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
@@ -19,7 +21,6 @@ import (
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
 	"github.com/linkerd/linkerd2/pkg/version"
-	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -34,7 +35,7 @@ type APIServer interface {
 }
 
 type grpcServer struct {
-	prometheusAPI         promv1.API
+	metricsBackend        MetricsBackend
 	tapClient             tapPb.TapClient
 	discoveryClient       discoveryPb.DiscoveryClient
 	k8sAPI                *k8s.API
@@ -42,6 +43,7 @@ type grpcServer struct {
 	ignoredNamespaces     []string
 	mountPathGlobalConfig string
 	mountPathProxyConfig  string
+	statWatches           *statSummaryWatches
 }
 
 type podReport struct {
@@ -58,7 +60,7 @@ const (
 )
 
 func newGrpcServer(
-	promAPI promv1.API,
+	metricsBackend MetricsBackend,
 	tapClient tapPb.TapClient,
 	discoveryClient discoveryPb.DiscoveryClient,
 	k8sAPI *k8s.API,
@@ -67,7 +69,7 @@ func newGrpcServer(
 ) *grpcServer {
 
 	grpcServer := &grpcServer{
-		prometheusAPI:         promAPI,
+		metricsBackend:        metricsBackend,
 		tapClient:             tapClient,
 		discoveryClient:       discoveryClient,
 		k8sAPI:                k8sAPI,
@@ -75,6 +77,7 @@ func newGrpcServer(
 		ignoredNamespaces:     ignoredNamespaces,
 		mountPathGlobalConfig: pkgK8s.MountPathGlobalConfig,
 		mountPathProxyConfig:  pkgK8s.MountPathProxyConfig,
+		statWatches:           newStatSummaryWatches(),
 	}
 
 	pb.RegisterApiServer(prometheus.NewGrpcServer(), grpcServer)
@@ -99,13 +102,9 @@ func (s *grpcServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb
 		return nil, errors.New("cannot set both namespace and resource in the request. These are mutually exclusive")
 	}
 
-	labelSelector := labels.Everything()
-	if s := req.GetSelector().GetLabelSelector(); s != "" {
-		var err error
-		labelSelector, err = labels.Parse(s)
-		if err != nil {
-			return nil, fmt.Errorf("invalid label selector \"%s\": %s", s, err)
-		}
+	labelSelector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	nsQuery := ""
@@ -147,10 +146,27 @@ func (s *grpcServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb
 	if err != nil {
 		return nil, err
 	}
+
+	// pages are ordered by pod name, and each page token is the name of the
+	// last pod of the previous page, so that pages stay consistent as pods
+	// come and go
+	lastPod := ""
+	if req.GetPageToken() != "" {
+		token, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token \"%s\"", req.GetPageToken())
+		}
+		lastPod = string(token)
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return podName(pods[i]) < podName(pods[j])
+	})
+
 	podList := make([]*pb.Pod, 0)
+	nextPageToken := ""
 
 	for _, pod := range pods {
-		if s.shouldIgnore(pod) {
+		if s.shouldIgnore(pod) || podName(pod) <= lastPod {
 			continue
 		}
 
@@ -171,6 +187,15 @@ func (s *grpcServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb
 		item := util.K8sPodToPublicPod(*pod, ownerKind, ownerName)
 		item.Added = added
 
+		if !matchesPodFilters(req, &item) {
+			continue
+		}
+		if req.GetLimit() != 0 && uint32(len(podList)) == req.GetLimit() {
+			// there is at least one more pod to list
+			nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(podList[len(podList)-1].GetName()))
+			break
+		}
+
 		if added {
 			since := time.Since(updated.lastReport)
 			item.SinceLastReport = &duration.Duration{
@@ -187,13 +212,44 @@ func (s *grpcServer) ListPods(ctx context.Context, req *pb.ListPodsRequest) (*pb
 		podList = append(podList, &item)
 	}
 
-	rsp := pb.ListPodsResponse{Pods: podList}
+	rsp := pb.ListPodsResponse{Pods: podList, NextPageToken: nextPageToken}
 
 	log.Debugf("ListPods response: %+v", rsp)
 
 	return &rsp, nil
 }
 
+func podName(pod *corev1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+func matchesCondition(condition pb.ListPodsRequest_Condition, value bool) bool {
+	switch condition {
+	case pb.ListPodsRequest_TRUE:
+		return value
+	case pb.ListPodsRequest_FALSE:
+		return !value
+	default:
+		return true
+	}
+}
+
+// matchesPodFilters returns whether a pod matches the meshed, proxy version
+// and readiness filters of a ListPods request. Pods are meshed if they were
+// injected with a proxy that reports to a control plane.
+func matchesPodFilters(req *pb.ListPodsRequest, pod *pb.Pod) bool {
+	if !matchesCondition(req.GetMeshed(), pod.GetControllerNamespace() != "") {
+		return false
+	}
+	if !matchesCondition(req.GetProxyReady(), pod.GetProxyReady()) {
+		return false
+	}
+	if v := req.GetProxyVersion(); v != "" && v != pod.GetProxyVersion() {
+		return false
+	}
+	return true
+}
+
 func (s *grpcServer) SelfCheck(ctx context.Context, in *healthcheckPb.SelfCheckRequest) (*healthcheckPb.SelfCheckResponse, error) {
 	k8sClientCheck := &healthcheckPb.CheckResult{
 		SubsystemName:    k8sClientSubsystemName,
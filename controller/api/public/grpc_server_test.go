@@ -16,6 +16,8 @@ import (
 	"github.com/linkerd/linkerd2/controller/k8s"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/prometheus/common/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type listPodsExpected struct {
@@ -52,7 +54,7 @@ func listPodResponsesEqual(a *pb.ListPodsResponse, b *pb.ListPodsResponse) bool
 		return a == b
 	}
 
-	if len(a.Pods) != len(b.Pods) {
+	if len(a.Pods) != len(b.Pods) || a.NextPageToken != b.NextPageToken {
 		return false
 	}
 
@@ -397,7 +399,7 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			mProm := mockProm{Res: exp.promRes}
+			mProm := InMemoryMetricsBackend{Res: exp.promRes}
 
 			fakeGrpcServer := newGrpcServer(
 				&mProm,
@@ -427,10 +429,99 @@ status:
 			}
 		}
 	})
+
+	t.Run("Paginates and filters pods", func(t *testing.T) {
+		meshedPod := `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: %s
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+spec:
+  containers:
+  - name: linkerd-proxy
+    image: gcr.io/linkerd-io/proxy:%s
+status:
+  phase: Running
+  containerStatuses:
+  - name: linkerd-proxy
+    ready: %t
+`
+		k8sAPI, err := k8s.NewFakeAPI(
+			fmt.Sprintf(meshedPod, "emoji", "stable-2.3.0", true),
+			fmt.Sprintf(meshedPod, "voting", "stable-2.3.0", true),
+			fmt.Sprintf(meshedPod, "web", "stable-2.2.0", false),
+			`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: vote-bot
+  namespace: emojivoto
+status:
+  phase: Running
+`,
+		)
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		fakeGrpcServer := newGrpcServer(
+			&InMemoryMetricsBackend{Res: model.Vector{}},
+			nil,
+			nil,
+			k8sAPI,
+			"linkerd",
+			[]string{},
+		)
+		k8sAPI.Sync()
+
+		podNames := func(req *pb.ListPodsRequest) ([]string, string) {
+			rsp, err := fakeGrpcServer.ListPods(context.TODO(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			names := []string{}
+			for _, pod := range rsp.GetPods() {
+				names = append(names, pod.GetName())
+			}
+			return names, rsp.GetNextPageToken()
+		}
+
+		req := &pb.ListPodsRequest{Limit: 2, Meshed: pb.ListPodsRequest_TRUE}
+		names, token := podNames(req)
+		if !reflect.DeepEqual(names, []string{"emojivoto/emoji", "emojivoto/voting"}) || token == "" {
+			t.Fatalf("Unexpected first page %v (next page token %q)", names, token)
+		}
+
+		req.PageToken = token
+		names, token = podNames(req)
+		if !reflect.DeepEqual(names, []string{"emojivoto/web"}) || token != "" {
+			t.Fatalf("Unexpected last page %v (next page token %q)", names, token)
+		}
+
+		filters := map[*pb.ListPodsRequest][]string{
+			{Meshed: pb.ListPodsRequest_FALSE}:     {"emojivoto/vote-bot"},
+			{ProxyVersion: "stable-2.2.0"}:         {"emojivoto/web"},
+			{ProxyReady: pb.ListPodsRequest_TRUE}:  {"emojivoto/emoji", "emojivoto/voting"},
+			{ProxyReady: pb.ListPodsRequest_FALSE}: {"emojivoto/vote-bot", "emojivoto/web"},
+		}
+		for req, expected := range filters {
+			names, _ := podNames(req)
+			if !reflect.DeepEqual(names, expected) {
+				t.Fatalf("Expected pods %v for %+v, got %v", expected, req, names)
+			}
+		}
+
+		_, err = fakeGrpcServer.ListPods(context.TODO(), &pb.ListPodsRequest{PageToken: "not base64!"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("Expected an invalid page token error, got %v", err)
+		}
+	})
 }
 
 // TODO: consider refactoring with expectedStatRPC.verifyPromQueries
-func verifyPromQueries(mProm *mockProm, namespace string) error {
+func verifyPromQueries(mProm *InMemoryMetricsBackend, namespace string) error {
 	namespaceSelector := fmt.Sprintf("namespace=\"%s\"", namespace)
 	for _, element := range mProm.QueriesExecuted {
 		if strings.Contains(element, namespaceSelector) {
@@ -502,7 +593,7 @@ metadata:
 			}
 
 			fakeGrpcServer := newGrpcServer(
-				&mockProm{},
+				&InMemoryMetricsBackend{},
 				nil,
 				nil,
 				k8sAPI,
@@ -552,7 +643,7 @@ func TestEndpoints(t *testing.T) {
 			}
 
 			fakeGrpcServer := newGrpcServer(
-				&mockProm{},
+				&InMemoryMetricsBackend{},
 				nil,
 				discoveryClient,
 				k8sAPI,
@@ -580,7 +671,7 @@ func TestConfig(t *testing.T) {
 		}
 
 		fakeGrpcServer := newGrpcServer(
-			&mockProm{},
+			&InMemoryMetricsBackend{},
 			nil,
 			nil,
 			k8sAPI,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 	discoveryPb "github.com/linkerd/linkerd2/controller/gen/controller/discovery"
@@ -11,27 +12,32 @@ import (
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
-	promApi "github.com/prometheus/client_golang/api"
-	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
+	"k8s.io/client-go/kubernetes"
 )
 
 var (
-	statSummaryPath   = fullURLPathFor("StatSummary")
-	topRoutesPath     = fullURLPathFor("TopRoutes")
-	versionPath       = fullURLPathFor("Version")
-	listPodsPath      = fullURLPathFor("ListPods")
-	listServicesPath  = fullURLPathFor("ListServices")
-	tapByResourcePath = fullURLPathFor("TapByResource")
-	selfCheckPath     = fullURLPathFor("SelfCheck")
-	endpointsPath     = fullURLPathFor("Endpoints")
-	edgesPath         = fullURLPathFor("Edges")
-	configPath        = fullURLPathFor("Config")
+	statSummaryPath      = fullURLPathFor("StatSummary")
+	watchStatSummaryPath = fullURLPathFor("WatchStatSummary")
+	statTimeSeriesPath   = fullURLPathFor("StatTimeSeries")
+	topRoutesPath        = fullURLPathFor("TopRoutes")
+	sloStatusPath        = fullURLPathFor("SLOStatus")
+	versionPath          = fullURLPathFor("Version")
+	listPodsPath         = fullURLPathFor("ListPods")
+	listServicesPath     = fullURLPathFor("ListServices")
+	tapByResourcePath    = fullURLPathFor("TapByResource")
+	selfCheckPath        = fullURLPathFor("SelfCheck")
+	endpointsPath        = fullURLPathFor("Endpoints")
+	edgesPath            = fullURLPathFor("Edges")
+	configPath           = fullURLPathFor("Config")
 )
 
 type handler struct {
 	grpcServer APIServer
+	// authzClient is set when callers must authenticate with a Kubernetes
+	// bearer token
+	authzClient kubernetes.Interface
 }
 
 func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
@@ -44,12 +50,35 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	if h.authzClient != nil {
+		ctx, err := authenticate(h.authzClient, req)
+		if err != nil {
+			writeErrorToHTTPResponse(w, httpError{
+				Code:         http.StatusUnauthorized,
+				WrappedError: err,
+			})
+			return
+		}
+		req = req.WithContext(ctx)
+	} else if token := bearerToken(req.Header.Get(authorizationHeader)); token != "" {
+		// forward the caller's token even when it is not checked here, so that
+		// the tap server can name the caller in its audit log
+		ctx := metadata.AppendToOutgoingContext(req.Context(), strings.ToLower(authorizationHeader), bearerPrefix+token)
+		req = req.WithContext(ctx)
+	}
+
 	// Serve request
 	switch req.URL.Path {
 	case statSummaryPath:
 		h.handleStatSummary(w, req)
+	case watchStatSummaryPath:
+		h.handleWatchStatSummary(w, req)
+	case statTimeSeriesPath:
+		h.handleStatTimeSeries(w, req)
 	case topRoutesPath:
 		h.handleTopRoutes(w, req)
+	case sloStatusPath:
+		h.handleSLOStatus(w, req)
 	case versionPath:
 		h.handleVersion(w, req)
 	case listPodsPath:
@@ -93,6 +122,70 @@ func (h *handler) handleStatSummary(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (h *handler) handleWatchStatSummary(w http.ResponseWriter, req *http.Request) {
+	flushableWriter, err := newStreamingWriter(w)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+
+	var protoRequest pb.WatchStatSummaryRequest
+	err = httpRequestToProto(req, &protoRequest)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+
+	server := statSummaryWatchServer{w: flushableWriter, req: req}
+	err = h.grpcServer.WatchStatSummary(&protoRequest, server)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
+func (h *handler) handleStatTimeSeries(w http.ResponseWriter, req *http.Request) {
+	var protoRequest pb.StatTimeSeriesRequest
+
+	err := httpRequestToProto(req, &protoRequest)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+
+	rsp, err := h.grpcServer.StatTimeSeries(req.Context(), &protoRequest)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+	err = writeProtoToHTTPResponse(w, rsp)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
+func (h *handler) handleSLOStatus(w http.ResponseWriter, req *http.Request) {
+	var protoRequest pb.SLOStatusRequest
+
+	err := httpRequestToProto(req, &protoRequest)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+
+	rsp, err := h.grpcServer.SLOStatus(req.Context(), &protoRequest)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+	err = writeProtoToHTTPResponse(w, rsp)
+	if err != nil {
+		writeErrorToHTTPResponse(w, err)
+		return
+	}
+}
+
 func (h *handler) handleEdges(w http.ResponseWriter, req *http.Request) {
 	var protoRequest pb.EdgesRequest
 
@@ -304,25 +397,55 @@ func (h *handler) handleEndpoints(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-// NewServer creates a Public API HTTP server.
+type statSummaryWatchServer struct {
+	w   flushableResponseWriter
+	req *http.Request
+}
+
+func (s statSummaryWatchServer) Send(msg *pb.StatSummaryResponse) error {
+	err := writeProtoToHTTPResponse(s.w, msg)
+	if err != nil {
+		writeErrorToHTTPResponse(s.w, err)
+		return err
+	}
+
+	s.w.Flush()
+	return nil
+}
+
+// satisfy the pb.Api_WatchStatSummaryServer interface
+func (s statSummaryWatchServer) SetHeader(metadata.MD) error  { return nil }
+func (s statSummaryWatchServer) SendHeader(metadata.MD) error { return nil }
+func (s statSummaryWatchServer) SetTrailer(metadata.MD)       {}
+func (s statSummaryWatchServer) Context() context.Context     { return s.req.Context() }
+func (s statSummaryWatchServer) SendMsg(interface{}) error    { return nil }
+func (s statSummaryWatchServer) RecvMsg(interface{}) error    { return nil }
+
+// NewServer creates a Public API HTTP server. When enableAuthz is set, callers
+// must present a Kubernetes bearer token and only see the namespaces they are
+// authorized for.
 func NewServer(
 	addr string,
-	prometheusClient promApi.Client,
+	metricsBackend MetricsBackend,
 	tapClient tapPb.TapClient,
 	discoveryClient discoveryPb.DiscoveryClient,
 	k8sAPI *k8s.API,
 	controllerNamespace string,
 	ignoredNamespaces []string,
+	enableAuthz bool,
 ) *http.Server {
-	baseHandler := &handler{
-		grpcServer: newGrpcServer(
-			promv1.NewAPI(prometheusClient),
-			tapClient,
-			discoveryClient,
-			k8sAPI,
-			controllerNamespace,
-			ignoredNamespaces,
-		),
+	var server APIServer = newGrpcServer(
+		metricsBackend,
+		tapClient,
+		discoveryClient,
+		k8sAPI,
+		controllerNamespace,
+		ignoredNamespaces,
+	)
+	baseHandler := &handler{grpcServer: server}
+	if enableAuthz {
+		baseHandler.grpcServer = newAuthzServer(server, k8sAPI.Client)
+		baseHandler.authzClient = k8sAPI.Client
 	}
 
 	instrumentedHandler := prometheus.WithTelemetry(baseHandler)
@@ -23,7 +23,8 @@ type mockServer struct {
 
 type mockGrpcServer struct {
 	mockServer
-	TapStreamsToReturn []*pb.TapEvent
+	TapStreamsToReturn         []*pb.TapEvent
+	StatSummaryStreamsToReturn []*pb.StatSummaryResponse
 }
 
 func (m *mockGrpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest) (*pb.StatSummaryResponse, error) {
@@ -31,11 +32,32 @@ func (m *mockGrpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryReq
 	return m.ResponseToReturn.(*pb.StatSummaryResponse), m.ErrorToReturn
 }
 
+func (m *mockGrpcServer) WatchStatSummary(req *pb.WatchStatSummaryRequest, stream pb.Api_WatchStatSummaryServer) error {
+	m.LastRequestReceived = req
+	if m.ErrorToReturn == nil {
+		for _, msg := range m.StatSummaryStreamsToReturn {
+			stream.Send(msg)
+		}
+	}
+
+	return m.ErrorToReturn
+}
+
+func (m *mockGrpcServer) StatTimeSeries(ctx context.Context, req *pb.StatTimeSeriesRequest) (*pb.StatTimeSeriesResponse, error) {
+	m.LastRequestReceived = req
+	return m.ResponseToReturn.(*pb.StatTimeSeriesResponse), m.ErrorToReturn
+}
+
 func (m *mockGrpcServer) TopRoutes(ctx context.Context, req *pb.TopRoutesRequest) (*pb.TopRoutesResponse, error) {
 	m.LastRequestReceived = req
 	return m.ResponseToReturn.(*pb.TopRoutesResponse), m.ErrorToReturn
 }
 
+func (m *mockGrpcServer) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest) (*pb.SLOStatusResponse, error) {
+	m.LastRequestReceived = req
+	return m.ResponseToReturn.(*pb.SLOStatusResponse), m.ErrorToReturn
+}
+
 func (m *mockGrpcServer) Edges(ctx context.Context, req *pb.EdgesRequest) (*pb.EdgesResponse, error) {
 	m.LastRequestReceived = req
 	return m.ResponseToReturn.(*pb.EdgesResponse), m.ErrorToReturn
@@ -141,6 +163,20 @@ func TestServer(t *testing.T) {
 			functionCall:     func() (proto.Message, error) { return client.StatSummary(context.TODO(), statSummaryReq) },
 		}
 
+		statTimeSeriesReq := &pb.StatTimeSeriesRequest{}
+		testStatTimeSeries := grpcCallTestCase{
+			expectedRequest:  statTimeSeriesReq,
+			expectedResponse: &pb.StatTimeSeriesResponse{},
+			functionCall:     func() (proto.Message, error) { return client.StatTimeSeries(context.TODO(), statTimeSeriesReq) },
+		}
+
+		sloStatusReq := &pb.SLOStatusRequest{Namespace: "emojivoto"}
+		testSLOStatus := grpcCallTestCase{
+			expectedRequest:  sloStatusReq,
+			expectedResponse: &pb.SLOStatusResponse{},
+			functionCall:     func() (proto.Message, error) { return client.SLOStatus(context.TODO(), sloStatusReq) },
+		}
+
 		versionReq := &pb.Empty{}
 		testVersion := grpcCallTestCase{
 			expectedRequest: versionReq,
@@ -157,7 +193,7 @@ func TestServer(t *testing.T) {
 			functionCall:     func() (proto.Message, error) { return client.Endpoints(context.TODO(), endpointsReq) },
 		}
 
-		for _, testCase := range []grpcCallTestCase{testListPods, testStatSummary, testVersion} {
+		for _, testCase := range []grpcCallTestCase{testListPods, testStatSummary, testStatTimeSeries, testSLOStatus, testVersion} {
 			assertCallWasForwarded(t, &mockGrpcServer.mockServer, testCase.expectedRequest, testCase.expectedResponse, testCase.functionCall)
 		}
 		for _, testCase := range []grpcCallTestCase{testEndpoints} {
@@ -225,6 +261,65 @@ func TestServer(t *testing.T) {
 		}
 	})
 
+	t.Run("Delegates all streaming stat RPC messages to the underlying grpc server", func(t *testing.T) {
+		mockGrpcServer := &mockGrpcServer{}
+
+		listener, err := net.Listen("tcp", "localhost:0")
+		if err != nil {
+			t.Fatalf("Could not start listener: %v", err)
+		}
+
+		go func() {
+			handler := &handler{
+				grpcServer: mockGrpcServer,
+			}
+			err := http.Serve(listener, handler)
+			if err != nil {
+				t.Fatalf("Could not start server: %v", err)
+			}
+		}()
+
+		client, err := NewInternalClient("linkerd", listener.Addr().String())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		expectedStatResponses := []*pb.StatSummaryResponse{
+			{
+				Response: &pb.StatSummaryResponse_Error{
+					Error: &pb.ResourceError{Error: "first"},
+				},
+			}, {
+				Response: &pb.StatSummaryResponse_Error{
+					Error: &pb.ResourceError{Error: "second"},
+				},
+			},
+		}
+		mockGrpcServer.StatSummaryStreamsToReturn = expectedStatResponses
+		mockGrpcServer.ErrorToReturn = nil
+
+		watchReq := &pb.WatchStatSummaryRequest{Interval: "5s"}
+		watchClient, err := client.WatchStatSummary(context.TODO(), watchReq)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		for _, expectedRsp := range expectedStatResponses {
+			actualRsp, err := watchClient.Recv()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !proto.Equal(actualRsp, expectedRsp) {
+				t.Fatalf("Expecting stat response to be [%v], but was [%v]", expectedRsp, actualRsp)
+			}
+		}
+
+		if !proto.Equal(mockGrpcServer.LastRequestReceived, watchReq) {
+			t.Fatalf("Expecting server to receive [%v], but got [%v]", watchReq, mockGrpcServer.LastRequestReceived)
+		}
+	})
+
 	t.Run("Handles errors before opening keep-alive response", func(t *testing.T) {
 		mockGrpcServer := &mockGrpcServer{}
 
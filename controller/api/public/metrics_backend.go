@@ -0,0 +1,184 @@
+package public
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	promApi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// MetricsBackend is the store the public API reads metrics from. Every query
+// the public API issues is PromQL, so any Prometheus-compatible store can
+// back it.
+type MetricsBackend interface {
+	Query(ctx context.Context, query string, ts time.Time) (model.Value, error)
+	QueryRange(ctx context.Context, query string, r promv1.Range) (model.Value, error)
+}
+
+// PrometheusBackendConfig configures a MetricsBackend that talks to a
+// Prometheus-compatible HTTP API. Only URL is required; the remaining fields
+// are for external endpoints that sit behind authentication or TLS.
+type PrometheusBackendConfig struct {
+	URL string
+
+	// Headers are added to every request, e.g. "Authorization" or a tenant ID
+	// header for multi-tenant stores.
+	Headers map[string]string
+
+	// CAFile is a PEM bundle used to verify the endpoint's certificate instead
+	// of the system roots.
+	CAFile string
+
+	// CertFile and KeyFile hold a client certificate presented to the
+	// endpoint. Both or neither must be set.
+	CertFile string
+	KeyFile  string
+
+	InsecureSkipVerify bool
+}
+
+// NewPrometheusBackend returns a MetricsBackend that queries the
+// Prometheus-compatible HTTP API described by config.
+func NewPrometheusBackend(config PrometheusBackendConfig) (MetricsBackend, error) {
+	client, err := newPrometheusClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return promv1.NewAPI(client), nil
+}
+
+func newPrometheusClient(config PrometheusBackendConfig) (promApi.Client, error) {
+	if config.URL == "" {
+		return nil, fmt.Errorf("metrics backend URL must be set")
+	}
+
+	roundTripper := promApi.DefaultRoundTripper
+	if config.CAFile != "" || config.CertFile != "" || config.KeyFile != "" || config.InsecureSkipVerify {
+		tlsConfig, err := config.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		roundTripper = &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: (&net.Dialer{
+				Timeout:   30 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			TLSClientConfig:     tlsConfig,
+		}
+	}
+
+	if len(config.Headers) > 0 {
+		roundTripper = &headerRoundTripper{
+			headers: config.Headers,
+			next:    roundTripper,
+		}
+	}
+
+	return promApi.NewClient(promApi.Config{
+		Address:      config.URL,
+		RoundTripper: roundTripper,
+	})
+}
+
+func (config PrometheusBackendConfig) tlsConfig() (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+
+	if config.CAFile != "" {
+		pem, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metrics backend CA file: %s", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in metrics backend CA file %s", config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (config.CertFile == "") != (config.KeyFile == "") {
+		return nil, fmt.Errorf("metrics backend client certificate and key must be set together")
+	}
+	if config.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load metrics backend client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// headerRoundTripper sets a fixed set of headers on every request before
+// handing it to the next RoundTripper.
+type headerRoundTripper struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (h *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the caller's request
+	req = req.WithContext(req.Context())
+	req.Header = cloneHeader(req.Header)
+	for name, value := range h.headers {
+		req.Header.Set(name, value)
+	}
+	return h.next.RoundTrip(req)
+}
+
+func cloneHeader(h http.Header) http.Header {
+	clone := make(http.Header, len(h))
+	for name, values := range h {
+		clone[name] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+// InMemoryMetricsBackend is a MetricsBackend that answers queries from canned
+// values instead of a metrics store. It records the queries it receives, which
+// makes it useful for testing query generation.
+type InMemoryMetricsBackend struct {
+	// Responses holds the value returned for specific queries.
+	Responses map[string]model.Value
+	// Res is returned for any query without an entry in Responses.
+	Res model.Value
+	// Err, if set, is returned for every query.
+	Err error
+
+	QueriesExecuted []string // expose the queries received, to test query generation
+	rwLock          sync.Mutex
+}
+
+// Query implements MetricsBackend.
+func (m *InMemoryMetricsBackend) Query(ctx context.Context, query string, ts time.Time) (model.Value, error) {
+	return m.respond(query)
+}
+
+// QueryRange implements MetricsBackend.
+func (m *InMemoryMetricsBackend) QueryRange(ctx context.Context, query string, r promv1.Range) (model.Value, error) {
+	return m.respond(query)
+}
+
+func (m *InMemoryMetricsBackend) respond(query string) (model.Value, error) {
+	m.rwLock.Lock()
+	defer m.rwLock.Unlock()
+	m.QueriesExecuted = append(m.QueriesExecuted, query)
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	if res, ok := m.Responses[query]; ok {
+		return res, nil
+	}
+	return m.Res, nil
+}
@@ -0,0 +1,151 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+const promVectorResponse = `{
+  "status": "success",
+  "data": {
+    "resultType": "vector",
+    "result": [{"metric": {"pod": "emoji"}, "value": [1556582400, "42"]}]
+  }
+}`
+
+func TestPrometheusBackend(t *testing.T) {
+	t.Run("Sends configured headers with every query", func(t *testing.T) {
+		var gotHeaders http.Header
+		var gotQuery string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotHeaders = r.Header
+			r.ParseForm()
+			gotQuery = r.Form.Get("query")
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, promVectorResponse)
+		}))
+		defer server.Close()
+
+		backend, err := NewPrometheusBackend(PrometheusBackendConfig{
+			URL: server.URL,
+			Headers: map[string]string{
+				"Authorization": "Bearer s3cr3t",
+				"X-Scope-OrgID": "linkerd",
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		res, err := backend.Query(context.Background(), "up", time.Time{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if gotQuery != "up" {
+			t.Fatalf("Expected query \"up\", got %q", gotQuery)
+		}
+		if gotHeaders.Get("Authorization") != "Bearer s3cr3t" {
+			t.Fatalf("Expected Authorization header to be set, got %q", gotHeaders.Get("Authorization"))
+		}
+		if gotHeaders.Get("X-Scope-OrgID") != "linkerd" {
+			t.Fatalf("Expected X-Scope-OrgID header to be set, got %q", gotHeaders.Get("X-Scope-OrgID"))
+		}
+
+		vec, ok := res.(model.Vector)
+		if !ok || len(vec) != 1 || vec[0].Value != 42 {
+			t.Fatalf("Unexpected query result: %+v", res)
+		}
+	})
+
+	t.Run("Queries a TLS endpoint", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, promVectorResponse)
+		}))
+		defer server.Close()
+
+		backend, err := NewPrometheusBackend(PrometheusBackendConfig{
+			URL:                server.URL,
+			InsecureSkipVerify: true,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if _, err := backend.Query(context.Background(), "up", time.Time{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Rejects invalid configs", func(t *testing.T) {
+		configs := map[string]PrometheusBackendConfig{
+			"metrics backend URL must be set": {},
+			"metrics backend client certificate and key must be set together": {
+				URL:      "https://prometheus.example.com",
+				CertFile: "client.crt",
+			},
+			"failed to read metrics backend CA file: open missing-ca.pem: no such file or directory": {
+				URL:    "https://prometheus.example.com",
+				CAFile: "missing-ca.pem",
+			},
+		}
+
+		for expectedErr, config := range configs {
+			_, err := NewPrometheusBackend(config)
+			if err == nil || err.Error() != expectedErr {
+				t.Fatalf("Expected error [%s], got [%v]", expectedErr, err)
+			}
+		}
+	})
+}
+
+func TestInMemoryMetricsBackend(t *testing.T) {
+	t.Run("Answers queries from canned responses", func(t *testing.T) {
+		upResponse := model.Vector{&model.Sample{Value: 1}}
+		backend := &InMemoryMetricsBackend{
+			Responses: map[string]model.Value{"up": upResponse},
+			Res:       model.Vector{},
+		}
+
+		res, err := backend.Query(context.Background(), "up", time.Time{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(res, upResponse) {
+			t.Fatalf("Expected %+v, got %+v", upResponse, res)
+		}
+
+		res, err = backend.QueryRange(context.Background(), "down", promv1.Range{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !reflect.DeepEqual(res, model.Vector{}) {
+			t.Fatalf("Expected the default response, got %+v", res)
+		}
+
+		expectedQueries := []string{"up", "down"}
+		if !reflect.DeepEqual(backend.QueriesExecuted, expectedQueries) {
+			t.Fatalf("Expected queries %v, got %v", expectedQueries, backend.QueriesExecuted)
+		}
+	})
+
+	t.Run("Surfaces backend errors through the public API", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Err: errors.New("backend unavailable")}
+		fakeGrpcServer := newGrpcServer(backend, nil, nil, nil, "linkerd", []string{})
+
+		_, err := fakeGrpcServer.queryProm(context.Background(), "up")
+		if err == nil || err.Error() != "backend unavailable" {
+			t.Fatalf("Expected backend error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,166 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	cacheHit       = "hit"
+	cacheMiss      = "miss"
+	cacheCoalesced = "coalesced"
+
+	// cachedQueryTimeout bounds backend queries made on behalf of the cache,
+	// which run detached from the contexts of the callers waiting on them
+	cachedQueryTimeout = 30 * time.Second
+)
+
+var metricsCacheRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "metrics_backend_cache_requests_total",
+		Help: "A counter for metrics backend queries, by whether they were served from the cache, coalesced with an in-flight query, or sent to the backend.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(metricsCacheRequests)
+}
+
+type cacheEntry struct {
+	value   model.Value
+	expires time.Time
+}
+
+// cachingMetricsBackend wraps a MetricsBackend, holding on to query results
+// for a short TTL and coalescing concurrent identical queries into a single
+// backend request. Query strings embed the time window, so caching by query
+// keeps different windows apart.
+type cachingMetricsBackend struct {
+	backend MetricsBackend
+	ttl     time.Duration
+	now     func() time.Time
+
+	inFlight singleflight.Group
+
+	sync.Mutex
+	entries   map[string]cacheEntry
+	lastSweep time.Time
+}
+
+// NewCachingMetricsBackend returns a MetricsBackend that caches the results of
+// backend for ttl and coalesces concurrent identical queries. A ttl of zero
+// disables caching, leaving only the coalescing.
+func NewCachingMetricsBackend(backend MetricsBackend, ttl time.Duration) MetricsBackend {
+	return &cachingMetricsBackend{
+		backend: backend,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *cachingMetricsBackend) Query(ctx context.Context, query string, ts time.Time) (model.Value, error) {
+	key := query
+	if !ts.IsZero() {
+		key = fmt.Sprintf("%s@%d", query, ts.UnixNano())
+	}
+	return c.get(ctx, key, func(ctx context.Context) (model.Value, error) {
+		return c.backend.Query(ctx, query, ts)
+	})
+}
+
+// QueryRange rounds the range to its step before querying, so that range
+// queries built from the current time share results within a step.
+func (c *cachingMetricsBackend) QueryRange(ctx context.Context, query string, r promv1.Range) (model.Value, error) {
+	if r.Step > 0 {
+		r.Start = r.Start.Truncate(r.Step)
+		r.End = r.End.Truncate(r.Step)
+	}
+	key := fmt.Sprintf("%s@%d:%d:%d", query, r.Start.UnixNano(), r.End.UnixNano(), r.Step)
+	return c.get(ctx, key, func(ctx context.Context) (model.Value, error) {
+		return c.backend.QueryRange(ctx, query, r)
+	})
+}
+
+// get serves key from the cache, or fetches it from the backend. The fetch is
+// shared by every caller waiting on the same key, so it runs with a context of
+// its own rather than the one of whichever caller started it; a caller that
+// goes away stops waiting without failing the others.
+func (c *cachingMetricsBackend) get(ctx context.Context, key string, fetch func(context.Context) (model.Value, error)) (model.Value, error) {
+	if value, ok := c.lookup(key); ok {
+		metricsCacheRequests.WithLabelValues(cacheHit).Inc()
+		return value, nil
+	}
+
+	fetched := false
+	results := c.inFlight.DoChan(key, func() (interface{}, error) {
+		fetched = true
+		fetchCtx, cancel := context.WithTimeout(context.Background(), cachedQueryTimeout)
+		defer cancel()
+
+		value, err := fetch(fetchCtx)
+		if err != nil {
+			// errors are not cached, so the next request retries the backend
+			return nil, err
+		}
+		c.store(key, value)
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-results:
+		if fetched {
+			metricsCacheRequests.WithLabelValues(cacheMiss).Inc()
+		} else {
+			metricsCacheRequests.WithLabelValues(cacheCoalesced).Inc()
+		}
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(model.Value), nil
+	}
+}
+
+func (c *cachingMetricsBackend) lookup(key string) (model.Value, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expires) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *cachingMetricsBackend) store(key string, value model.Value) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.now()
+	c.entries[key] = cacheEntry{value: value, expires: now.Add(c.ttl)}
+
+	// drop expired entries at most once per TTL, so the cache does not grow
+	// with every distinct query it has ever seen
+	if now.Sub(c.lastSweep) >= c.ttl {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+}
@@ -0,0 +1,269 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+)
+
+// blockingMetricsBackend holds every query until release is closed, signaling
+// entered, if set, when a query arrives.
+type blockingMetricsBackend struct {
+	*InMemoryMetricsBackend
+	entered chan struct{}
+	release chan struct{}
+
+	sync.Mutex
+	ctxErrs []error
+}
+
+func (b *blockingMetricsBackend) Query(ctx context.Context, query string, ts time.Time) (model.Value, error) {
+	if b.entered != nil {
+		b.entered <- struct{}{}
+	}
+	<-b.release
+
+	b.Lock()
+	b.ctxErrs = append(b.ctxErrs, ctx.Err())
+	b.Unlock()
+
+	return b.InMemoryMetricsBackend.Query(ctx, query, ts)
+}
+
+// cacheRequests returns the current value of the cache requests counter for
+// result.
+func cacheRequests(t *testing.T, result string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "metrics_backend_cache_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "result" && label.GetValue() == result {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
+
+// cacheRequestCounts returns the current values of the cache requests counter
+// by result.
+func cacheRequestCounts(t *testing.T) map[string]float64 {
+	return map[string]float64{
+		cacheHit:       cacheRequests(t, cacheHit),
+		cacheMiss:      cacheRequests(t, cacheMiss),
+		cacheCoalesced: cacheRequests(t, cacheCoalesced),
+	}
+}
+
+// cacheRequestDeltas returns how much each cache requests counter grew since
+// before was taken.
+func cacheRequestDeltas(t *testing.T, before map[string]float64) map[string]float64 {
+	deltas := map[string]float64{}
+	for result, count := range cacheRequestCounts(t) {
+		deltas[result] = count - before[result]
+	}
+	return deltas
+}
+
+func TestCachingMetricsBackend(t *testing.T) {
+	t.Run("Serves repeated queries from the cache until the TTL expires", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Res: model.Vector{}}
+		cache := NewCachingMetricsBackend(backend, 5*time.Second).(*cachingMetricsBackend)
+		now := time.Unix(1556582400, 0)
+		cache.now = func() time.Time { return now }
+
+		queries := []string{
+			"sum(increase(response_total[1m]))",
+			"sum(increase(response_total[1m]))",
+			"sum(increase(response_total[10m]))",
+		}
+		for _, query := range queries {
+			if _, err := cache.Query(context.Background(), query, time.Time{}); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		now = now.Add(5 * time.Second)
+		if _, err := cache.Query(context.Background(), queries[0], time.Time{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expectedQueries := []string{
+			"sum(increase(response_total[1m]))",
+			"sum(increase(response_total[10m]))",
+			"sum(increase(response_total[1m]))",
+		}
+		if !reflect.DeepEqual(backend.QueriesExecuted, expectedQueries) {
+			t.Fatalf("Expected backend queries %v, got %v", expectedQueries, backend.QueriesExecuted)
+		}
+	})
+
+	t.Run("Keys range queries by their range", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Res: model.Matrix{}}
+		cache := NewCachingMetricsBackend(backend, time.Minute)
+
+		start := time.Unix(1556582400, 0)
+		ranges := []promv1.Range{
+			{Start: start, End: start.Add(time.Hour), Step: time.Minute},
+			{Start: start, End: start.Add(time.Hour), Step: time.Minute},
+			{Start: start, End: start.Add(time.Hour), Step: 5 * time.Minute},
+		}
+		for _, r := range ranges {
+			if _, err := cache.QueryRange(context.Background(), "up", r); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		if len(backend.QueriesExecuted) != 2 {
+			t.Fatalf("Expected 2 backend queries, got %v", backend.QueriesExecuted)
+		}
+	})
+
+	t.Run("Shares range queries that fall within the same step", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Res: model.Matrix{}}
+		cache := NewCachingMetricsBackend(backend, time.Minute)
+
+		end := time.Unix(1556582400, 0)
+		for _, offset := range []time.Duration{0, 10 * time.Second, 59 * time.Second} {
+			r := promv1.Range{Start: end.Add(offset - time.Hour), End: end.Add(offset), Step: time.Minute}
+			if _, err := cache.QueryRange(context.Background(), "up", r); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		if len(backend.QueriesExecuted) != 1 {
+			t.Fatalf("Expected 1 backend query, got %v", backend.QueriesExecuted)
+		}
+	})
+
+	t.Run("Counts cache hits and misses", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Res: model.Vector{}}
+		cache := NewCachingMetricsBackend(backend, time.Minute)
+
+		before := cacheRequestCounts(t)
+		for _, query := range []string{"up", "up", "up", "down"} {
+			if _, err := cache.Query(context.Background(), query, time.Time{}); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		expected := map[string]float64{cacheHit: 2, cacheMiss: 2, cacheCoalesced: 0}
+		if deltas := cacheRequestDeltas(t, before); !reflect.DeepEqual(deltas, expected) {
+			t.Fatalf("Expected cache requests %v, got %v", expected, deltas)
+		}
+	})
+
+	t.Run("Does not cache errors", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Err: errors.New("backend unavailable")}
+		cache := NewCachingMetricsBackend(backend, time.Minute)
+
+		for i := 0; i < 2; i++ {
+			if _, err := cache.Query(context.Background(), "up", time.Time{}); err == nil {
+				t.Fatalf("Expected backend error, got none")
+			}
+		}
+
+		if len(backend.QueriesExecuted) != 2 {
+			t.Fatalf("Expected 2 backend queries, got %v", backend.QueriesExecuted)
+		}
+	})
+
+	t.Run("Sends concurrent identical queries to the backend once", func(t *testing.T) {
+		backend := &blockingMetricsBackend{
+			InMemoryMetricsBackend: &InMemoryMetricsBackend{Res: model.Vector{}},
+			release:                make(chan struct{}),
+		}
+		cache := NewCachingMetricsBackend(backend, time.Minute)
+
+		before := cacheRequestCounts(t)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := cache.Query(context.Background(), "up", time.Time{}); err != nil {
+					t.Errorf("Unexpected error: %s", err)
+				}
+			}()
+		}
+		close(backend.release)
+		wg.Wait()
+
+		if len(backend.QueriesExecuted) != 1 {
+			t.Fatalf("Expected 1 backend query, got %v", backend.QueriesExecuted)
+		}
+
+		// callers that arrive after the query completes are served from the
+		// cache instead of joining it
+		deltas := cacheRequestDeltas(t, before)
+		if deltas[cacheMiss] != 1 || deltas[cacheHit]+deltas[cacheCoalesced] != 9 {
+			t.Fatalf("Expected 1 cache miss and 9 cache hits or coalesced requests, got %v", deltas)
+		}
+	})
+
+	t.Run("Completes queries whose caller went away", func(t *testing.T) {
+		backend := &blockingMetricsBackend{
+			InMemoryMetricsBackend: &InMemoryMetricsBackend{Res: model.Vector{}},
+			entered:                make(chan struct{}, 1),
+			release:                make(chan struct{}),
+		}
+		cache := NewCachingMetricsBackend(backend, time.Minute)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errs := make(chan error)
+		go func() {
+			_, err := cache.Query(ctx, "up", time.Time{})
+			errs <- err
+		}()
+		<-backend.entered
+		cancel()
+		if err := <-errs; err != context.Canceled {
+			t.Fatalf("Expected %s, got %v", context.Canceled, err)
+		}
+
+		close(backend.release)
+		before := cacheRequestCounts(t)
+		if _, err := cache.Query(context.Background(), "up", time.Time{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if len(backend.QueriesExecuted) != 1 {
+			t.Fatalf("Expected 1 backend query, got %v", backend.QueriesExecuted)
+		}
+		if backend.ctxErrs[0] != nil {
+			t.Fatalf("Expected the backend query to outlive its caller, got %s", backend.ctxErrs[0])
+		}
+		if deltas := cacheRequestDeltas(t, before); deltas[cacheHit]+deltas[cacheCoalesced] != 1 {
+			t.Fatalf("Expected the query to be served by the canceled query, got %v", deltas)
+		}
+	})
+
+	t.Run("Only coalesces when the TTL is zero", func(t *testing.T) {
+		backend := &InMemoryMetricsBackend{Res: model.Vector{}}
+		cache := NewCachingMetricsBackend(backend, 0)
+
+		for i := 0; i < 2; i++ {
+			if _, err := cache.Query(context.Background(), "up", time.Time{}); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		if len(backend.QueriesExecuted) != 2 {
+			t.Fatalf("Expected 2 backend queries, got %v", backend.QueriesExecuted)
+		}
+	})
+}
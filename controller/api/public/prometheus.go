@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
 )
@@ -20,6 +22,11 @@ type promResult struct {
 	vec  model.Vector
 	err  error
 }
+type promRangeResult struct {
+	prom   promType
+	matrix model.Matrix
+	err    error
+}
 
 const (
 	promRequests       = promType("QUERY_REQUESTS")
@@ -27,18 +34,28 @@ const (
 	promTCPConnections = promType("QUERY_TCP_CONNECTIONS")
 	promTCPReadBytes   = promType("QUERY_TCP_READ_BYTES")
 	promTCPWriteBytes  = promType("QUERY_TCP_WRITE_BYTES")
+	promLatencyBuckets = promType("QUERY_LATENCY_BUCKETS")
+	promStatusCodes    = promType("QUERY_STATUS_CODES")
 	promLatencyP50     = promType("0.5")
 	promLatencyP95     = promType("0.95")
 	promLatencyP99     = promType("0.99")
 
 	namespaceLabel    = model.LabelName("namespace")
 	dstNamespaceLabel = model.LabelName("dst_namespace")
+	dstServiceLabel   = model.LabelName("dst_service")
+	leLabel           = model.LabelName("le")
+	statusCodeLabel   = model.LabelName("status_code")
+	grpcStatusLabel   = model.LabelName("grpc_status")
 )
 
 func extractSampleValue(sample *model.Sample) uint64 {
+	return extractValue(sample.Value)
+}
+
+func extractValue(sampleValue model.SampleValue) uint64 {
 	value := uint64(0)
-	if !math.IsNaN(float64(sample.Value)) {
-		value = uint64(math.Round(float64(sample.Value)))
+	if !math.IsNaN(float64(sampleValue)) {
+		value = uint64(math.Round(float64(sampleValue)))
 	}
 	return value
 }
@@ -47,7 +64,7 @@ func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector,
 	log.Debugf("Query request:\n\t%+v", query)
 
 	// single data point (aka summary) query
-	res, err := s.prometheusAPI.Query(ctx, query, time.Time{})
+	res, err := s.metricsBackend.Query(ctx, query, time.Time{})
 	if err != nil {
 		log.Errorf("Query(%+v) failed with: %+v", query, err)
 		return nil, err
@@ -63,6 +80,100 @@ func (s *grpcServer) queryProm(ctx context.Context, query string) (model.Vector,
 	return res.(model.Vector), nil
 }
 
+func (s *grpcServer) queryPromRange(ctx context.Context, query string, r promv1.Range) (model.Matrix, error) {
+	log.Debugf("QueryRange request:\n\t%+v (%+v)", query, r)
+
+	// multiple data points (aka time series) query
+	res, err := s.metricsBackend.QueryRange(ctx, query, r)
+	if err != nil {
+		log.Errorf("QueryRange(%+v) failed with: %+v", query, err)
+		return nil, err
+	}
+	log.Debugf("QueryRange response:\n\t%+v", res)
+
+	if res.Type() != model.ValMatrix {
+		err = fmt.Errorf("Unexpected query result type (expected Matrix): %s", res.Type())
+		log.Error(err)
+		return nil, err
+	}
+
+	return res.(model.Matrix), nil
+}
+
+// addLatencyBucket adds the count of a `le` labelled histogram sample to the
+// stats' latency histogram, keeping buckets ordered by their upper bound.
+// Samples that share a bound, e.g. because they only differ by labels that
+// are not part of the result key, are summed.
+func addLatencyBucket(stats *pb.BasicStats, sample *model.Sample) {
+	le, err := strconv.ParseFloat(string(sample.Metric[leLabel]), 64)
+	if err != nil {
+		log.Errorf("Invalid histogram bucket bound %s: %s", sample.Metric[leLabel], err)
+		return
+	}
+
+	if stats.LatencyHistogram == nil {
+		stats.LatencyHistogram = &pb.LatencyHistogram{}
+	}
+	buckets := stats.LatencyHistogram.Buckets
+
+	i := sort.Search(len(buckets), func(i int) bool { return buckets[i].LeMs >= le })
+	if i < len(buckets) && buckets[i].LeMs == le {
+		buckets[i].Count += extractSampleValue(sample)
+		return
+	}
+
+	bucket := &pb.LatencyHistogram_Bucket{
+		LeMs:  le,
+		Count: extractSampleValue(sample),
+	}
+	buckets = append(buckets, nil)
+	copy(buckets[i+1:], buckets[i:])
+	buckets[i] = bucket
+	stats.LatencyHistogram.Buckets = buckets
+}
+
+// grpcStatusCodeNames maps gRPC status codes to their canonical names, as
+// they appear in the `grpc_status` label of response metrics.
+var grpcStatusCodeNames = []string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+// addStatusCounts adds the count of a response sample to the breakdown, both
+// by HTTP status class (e.g. "2xx") and, for gRPC responses, by gRPC status
+// code name.
+func addStatusCounts(breakdown *pb.StatusBreakdown, metric model.Metric, value uint64) {
+	if code := string(metric[statusCodeLabel]); code != "" {
+		breakdown.HttpStatusClasses[code[:1]+"xx"] += value
+	}
+
+	grpcStatus := string(metric[grpcStatusLabel])
+	if grpcStatus == "" {
+		return
+	}
+	code, err := strconv.Atoi(grpcStatus)
+	if err != nil || code < 0 || code >= len(grpcStatusCodeNames) {
+		log.Errorf("Invalid gRPC status code %s", grpcStatus)
+		return
+	}
+	breakdown.GrpcStatusCodes[grpcStatusCodeNames[code]] += value
+}
+
 // add filtering by resource type
 // note that metricToKey assumes the label ordering (namespace, name)
 func promGroupByLabelNames(resource *pb.Resource) model.LabelNames {
@@ -132,6 +243,22 @@ func generateLabelStringWithExclusion(l model.LabelSet, labelName string) string
 	return fmt.Sprintf("{%s}", strings.Join(lstrs, ", "))
 }
 
+// insert regex matches into a LabelSet, to select the values of each label
+// that match its regex. as with generateLabelStringWithExclusion, this must be
+// inserted as a string.
+func generateLabelStringWithRegex(l model.LabelSet, regexes map[model.LabelName]string) string {
+	lstrs := make([]string, 0, len(l)+len(regexes))
+	for l, v := range l {
+		lstrs = append(lstrs, fmt.Sprintf("%s=%q", l, v))
+	}
+	for l, regex := range regexes {
+		lstrs = append(lstrs, fmt.Sprintf("%s=~%q", l, regex))
+	}
+
+	sort.Strings(lstrs)
+	return fmt.Sprintf("{%s}", strings.Join(lstrs, ", "))
+}
+
 // determine if we should add "namespace=<namespace>" to a named query
 func shouldAddNamespaceLabel(resource *pb.Resource) bool {
 	return resource.Type != k8s.Namespace && resource.Namespace != ""
@@ -172,6 +299,10 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTempl
 	}
 
 	quantiles := []promType{promLatencyP50, promLatencyP95, promLatencyP99}
+	if latencyQueryTemplate == "" {
+		// e.g. TCP metrics, which have no latencies
+		quantiles = nil
+	}
 
 	for _, quantile := range quantiles {
 		go func(quantile promType) {
@@ -204,3 +335,51 @@ func (s *grpcServer) getPrometheusMetrics(ctx context.Context, requestQueryTempl
 
 	return results, nil
 }
+
+func (s *grpcServer) getPrometheusRangeMetrics(ctx context.Context, r promv1.Range, requestQueryTemplate, latencyQueryTemplate, labels, timeWindow, groupBy string) ([]promRangeResult, error) {
+	resultChan := make(chan promRangeResult)
+
+	// kick off asynchronous range queries: 1 request count query + 3 latency queries
+	go func() {
+		query := fmt.Sprintf(requestQueryTemplate, labels, timeWindow, groupBy)
+		resultMatrix, err := s.queryPromRange(ctx, query, r)
+		resultChan <- promRangeResult{
+			prom:   promRequests,
+			matrix: resultMatrix,
+			err:    err,
+		}
+	}()
+
+	quantiles := []promType{promLatencyP50, promLatencyP95, promLatencyP99}
+
+	for _, quantile := range quantiles {
+		go func(quantile promType) {
+			latencyQuery := fmt.Sprintf(latencyQueryTemplate, quantile, labels, timeWindow, groupBy)
+			latencyResult, err := s.queryPromRange(ctx, latencyQuery, r)
+
+			resultChan <- promRangeResult{
+				prom:   quantile,
+				matrix: latencyResult,
+				err:    err,
+			}
+		}(quantile)
+	}
+
+	// process results, receive one message per prometheus query type
+	var err error
+	results := []promRangeResult{}
+	for i := 0; i < len(quantiles)+1; i++ {
+		result := <-resultChan
+		if result.err != nil {
+			log.Errorf("queryPromRange failed with: %s", result.err)
+			err = result.err
+		} else {
+			results = append(results, result)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,372 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/api/util"
+	slo "github.com/linkerd/linkerd2/controller/gen/apis/slo/v1alpha1"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
+	log "github.com/sirupsen/logrus"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	sloReqQuery     = "sum(increase(%s%s[%s])) by (classification)"
+	sloLatencyQuery = "sum(increase(%s%s[%s])) by (le)"
+)
+
+// DefaultSLOBurnRateWindows are the windows burn rates are reported over for
+// objectives that don't list their own. Pairing a short and a long window
+// lets alerts fire quickly on fast burns without flapping on slow ones.
+var DefaultSLOBurnRateWindows = []string{"5m", "1h", "6h"}
+
+// SLOLatencyThresholdsMs are the bounds of the proxy's latency histogram
+// buckets. Latency objectives count the requests that fall in the buckets up
+// to their threshold, so the threshold must be one of these bounds.
+var SLOLatencyThresholdsMs = []uint32{
+	1, 2, 3, 4, 5,
+	10, 20, 30, 40, 50,
+	100, 200, 300, 400, 500,
+	1000, 2000, 3000, 4000, 5000,
+	10000, 20000, 30000, 40000, 50000,
+}
+
+// ValidateSLOLatencyThreshold returns an error listing the valid thresholds if
+// thresholdMs is not one of them.
+func ValidateSLOLatencyThreshold(thresholdMs uint32) error {
+	bounds := make([]string, len(SLOLatencyThresholdsMs))
+	for i, bound := range SLOLatencyThresholdsMs {
+		if bound == thresholdMs {
+			return nil
+		}
+		bounds[i] = strconv.FormatUint(uint64(bound), 10)
+	}
+	return fmt.Errorf("latency thresholdMs [%d] is not a latency histogram bucket bound; must be one of: %s", thresholdMs, strings.Join(bounds, ", "))
+}
+
+// sloCounts holds the good and total request counts of an objective over one
+// window.
+type sloCounts struct {
+	window string
+	good   uint64
+	total  uint64
+	err    error
+}
+
+func (s *grpcServer) SLOStatus(ctx context.Context, req *pb.SLOStatusRequest) (*pb.SLOStatusResponse, error) {
+	objectives, errRsp, err := s.getServiceLevelObjectives(req)
+	if errRsp != nil || err != nil {
+		return errRsp, err
+	}
+
+	statuses := make([]*pb.SLOStatus, len(objectives))
+	for i, objective := range objectives {
+		statuses[i], err = s.sloStatusFor(ctx, objective)
+		if err != nil {
+			return nil, util.GRPCError(err)
+		}
+	}
+
+	return &pb.SLOStatusResponse{
+		Response: &pb.SLOStatusResponse_Ok_{
+			Ok: &pb.SLOStatusResponse_Ok{
+				Slos: statuses,
+			},
+		},
+	}, nil
+}
+
+func (s *grpcServer) getServiceLevelObjectives(req *pb.SLOStatusRequest) ([]*slo.ServiceLevelObjective, *pb.SLOStatusResponse, error) {
+	lister := s.k8sAPI.SLO().Lister()
+
+	if req.GetName() != "" {
+		if req.GetNamespace() == "" {
+			return nil, sloStatusError(req, "SLOStatus request for a named objective missing Namespace"), nil
+		}
+		objective, err := lister.ServiceLevelObjectives(req.GetNamespace()).Get(req.GetName())
+		if kerrors.IsNotFound(err) {
+			return nil, sloStatusError(req, fmt.Sprintf("ServiceLevelObjective %s/%s not found", req.GetNamespace(), req.GetName())), nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		return []*slo.ServiceLevelObjective{objective}, nil, nil
+	}
+
+	var objectives []*slo.ServiceLevelObjective
+	var err error
+	if req.GetNamespace() == "" {
+		objectives, err = lister.List(labels.Everything())
+	} else {
+		objectives, err = lister.ServiceLevelObjectives(req.GetNamespace()).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(objectives, func(i, j int) bool {
+		if objectives[i].Namespace != objectives[j].Namespace {
+			return objectives[i].Namespace < objectives[j].Namespace
+		}
+		return objectives[i].Name < objectives[j].Name
+	})
+
+	return objectives, nil, nil
+}
+
+func sloStatusError(req *pb.SLOStatusRequest, message string) *pb.SLOStatusResponse {
+	return &pb.SLOStatusResponse{
+		Response: &pb.SLOStatusResponse_Error{
+			Error: &pb.ResourceError{
+				Resource: &pb.Resource{
+					Namespace: req.GetNamespace(),
+					Name:      req.GetName(),
+				},
+				Error: message,
+			},
+		},
+	}
+}
+
+// sloStatusFor evaluates an objective's error budget over its window and its
+// burn rates over each of its burn rate windows. Objectives that are invalid
+// are reported with an error rather than failing the whole request, so that
+// one bad object doesn't hide the others.
+func (s *grpcServer) sloStatusFor(ctx context.Context, objective *slo.ServiceLevelObjective) (*pb.SLOStatus, error) {
+	spec := objective.Spec
+	status := &pb.SLOStatus{
+		Name:      objective.Name,
+		Namespace: objective.Namespace,
+		Route:     spec.Target.Route,
+		Window:    spec.Window,
+	}
+
+	target, err := sloTarget(objective)
+	if err != nil {
+		status.Error = err.Error()
+		return status, nil
+	}
+	status.Target = target
+
+	ratio, err := sloObjectiveRatio(spec)
+	if err != nil {
+		status.Error = err.Error()
+		return status, nil
+	}
+	status.Objective = ratio
+	if spec.Latency != nil {
+		status.LatencyThresholdMs = spec.Latency.ThresholdMs
+	}
+
+	burnRateWindows := spec.BurnRateWindows
+	if len(burnRateWindows) == 0 {
+		burnRateWindows = DefaultSLOBurnRateWindows
+	}
+	windows := append([]string{spec.Window}, burnRateWindows...)
+	for _, window := range windows {
+		if _, err := model.ParseDuration(window); err != nil {
+			status.Error = fmt.Sprintf("invalid window [%s]: %s", window, err)
+			return status, nil
+		}
+	}
+
+	counts, err := s.getSLOCounts(ctx, spec, target, windows)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range counts {
+		if c.err != nil {
+			status.Error = c.err.Error()
+			return status, nil
+		}
+	}
+
+	budget := 1 - ratio
+	status.GoodCount = counts[0].good
+	status.TotalCount = counts[0].total
+	status.ErrorBudgetRemaining = 1 - burnRate(counts[0], budget)
+	for _, c := range counts[1:] {
+		status.BurnRates = append(status.BurnRates, &pb.SLOStatus_BurnRate{
+			Window: c.window,
+			Rate:   burnRate(c, budget),
+		})
+	}
+
+	return status, nil
+}
+
+// burnRate is the ratio of bad requests in c relative to the error budget.
+// Windows without traffic don't burn any budget.
+func burnRate(c sloCounts, budget float64) float64 {
+	if c.total == 0 || c.good >= c.total {
+		return 0
+	}
+	return float64(c.total-c.good) / float64(c.total) / budget
+}
+
+func sloTarget(objective *slo.ServiceLevelObjective) (*pb.Resource, error) {
+	kind, err := k8s.CanonicalResourceNameFromFriendlyName(objective.Spec.Target.Kind)
+	if err != nil {
+		return nil, err
+	}
+	if kind == k8s.All || kind == k8s.ServiceProfile || kind == k8s.TrafficSplit {
+		return nil, fmt.Errorf("target kind [%s] is not supported", objective.Spec.Target.Kind)
+	}
+	if objective.Spec.Target.Name == "" {
+		return nil, errors.New("target name is required")
+	}
+	if kind == k8s.Service && objective.Spec.Target.Route != "" {
+		return nil, errors.New("route objectives are not supported for service targets")
+	}
+
+	return &pb.Resource{
+		Type:      kind,
+		Name:      objective.Spec.Target.Name,
+		Namespace: objective.Namespace,
+	}, nil
+}
+
+func sloObjectiveRatio(spec slo.ServiceLevelObjectiveSpec) (float64, error) {
+	var ratio float64
+	switch {
+	case spec.SuccessRate != nil && spec.Latency != nil:
+		return 0, errors.New("only one of successRate and latency may be set")
+	case spec.SuccessRate != nil:
+		ratio = spec.SuccessRate.Objective
+	case spec.Latency != nil:
+		if err := ValidateSLOLatencyThreshold(spec.Latency.ThresholdMs); err != nil {
+			return 0, err
+		}
+		ratio = spec.Latency.Objective
+	default:
+		return 0, errors.New("one of successRate and latency must be set")
+	}
+
+	if ratio <= 0 || ratio >= 1 {
+		return 0, fmt.Errorf("objective [%v] must be between 0 and 1, exclusive", ratio)
+	}
+	return ratio, nil
+}
+
+// buildSLOLabels selects the same inbound traffic StatSummary reports for the
+// target. Services only see outbound traffic, so their objectives are
+// evaluated from the clients' point of view.
+func buildSLOLabels(target *pb.Resource, route string) string {
+	var labels model.LabelSet
+
+	if target.Type == k8s.Service {
+		labels = labels.Merge(promDirectionLabels("outbound"))
+		labels = labels.Merge(promDstQueryLabels(target))
+	} else {
+		labels = labels.Merge(promDirectionLabels("inbound"))
+		labels = labels.Merge(promQueryLabels(target))
+	}
+	if route != "" {
+		labels = labels.Merge(model.LabelSet{"rt_route": model.LabelValue(route)})
+	}
+
+	return labels.String()
+}
+
+func (s *grpcServer) getSLOCounts(ctx context.Context, spec slo.ServiceLevelObjectiveSpec, target *pb.Resource, windows []string) ([]sloCounts, error) {
+	reqLabels := buildSLOLabels(target, spec.Target.Route)
+
+	metric := "response_total"
+	queryTemplate := sloReqQuery
+	if spec.Latency != nil {
+		metric = "response_latency_ms_bucket"
+		queryTemplate = sloLatencyQuery
+	}
+	if spec.Target.Route != "" {
+		metric = "route_" + metric
+	}
+
+	type indexedResult struct {
+		i   int
+		vec model.Vector
+		err error
+	}
+	resultChan := make(chan indexedResult)
+
+	for i, window := range windows {
+		go func(i int, query string) {
+			vec, err := s.queryProm(ctx, query)
+			resultChan <- indexedResult{i, vec, err}
+		}(i, fmt.Sprintf(queryTemplate, metric, reqLabels, window))
+	}
+
+	var err error
+	counts := make([]sloCounts, len(windows))
+	for range windows {
+		result := <-resultChan
+		if result.err != nil {
+			log.Errorf("queryProm failed with: %s", result.err)
+			err = result.err
+			continue
+		}
+
+		c := sloCounts{window: windows[result.i]}
+		if spec.Latency != nil {
+			c.good, c.total, c.err = latencyCounts(result.vec, spec.Latency.ThresholdMs)
+		} else {
+			c.good, c.total = successCounts(result.vec)
+		}
+		counts[result.i] = c
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func successCounts(vec model.Vector) (good, total uint64) {
+	for _, sample := range vec {
+		value := extractSampleValue(sample)
+		switch string(sample.Metric[model.LabelName("classification")]) {
+		case success:
+			good += value
+			total += value
+		case failure:
+			total += value
+		}
+	}
+	return
+}
+
+// latencyCounts reads the requests faster than thresholdMs and all requests
+// from the matching and +Inf buckets of a latency histogram.
+func latencyCounts(vec model.Vector, thresholdMs uint32) (good, total uint64, err error) {
+	if len(vec) == 0 {
+		return 0, 0, nil
+	}
+
+	foundThreshold := false
+	for _, sample := range vec {
+		le, parseErr := strconv.ParseFloat(string(sample.Metric[leLabel]), 64)
+		if parseErr != nil {
+			continue
+		}
+		switch {
+		case math.IsInf(le, 1):
+			total += extractSampleValue(sample)
+		case le == float64(thresholdMs):
+			foundThreshold = true
+			good += extractSampleValue(sample)
+		}
+	}
+
+	if !foundThreshold {
+		return 0, 0, fmt.Errorf("latency threshold [%dms] is not a latency histogram bucket bound", thresholdMs)
+	}
+	return good, total, nil
+}
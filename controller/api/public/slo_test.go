@@ -0,0 +1,291 @@
+package public
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
+)
+
+type sloStatusExpected struct {
+	expectedStatRPC
+	req              pb.SLOStatusRequest  // the request we would like to test
+	expectedResponse pb.SLOStatusResponse // the SLO status response we expect
+}
+
+func testSLOStatus(t *testing.T, expectations []sloStatusExpected) {
+	for _, exp := range expectations {
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.SLOStatus(context.TODO(), &exp.req)
+		if err != exp.err {
+			t.Fatalf("Expected error: %s, Got: %s", exp.err, err)
+		}
+
+		err = exp.verifyPromQueries(mockProm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !proto.Equal(&exp.expectedResponse, rsp) {
+			t.Fatalf("Expected: %+v\n Got: %+v", &exp.expectedResponse, rsp)
+		}
+	}
+}
+
+func sloStatusOk(statuses ...*pb.SLOStatus) pb.SLOStatusResponse {
+	return pb.SLOStatusResponse{
+		Response: &pb.SLOStatusResponse_Ok_{
+			Ok: &pb.SLOStatusResponse_Ok{
+				Slos: statuses,
+			},
+		},
+	}
+}
+
+func TestSLOStatus(t *testing.T) {
+	webDeploy := &pb.Resource{
+		Namespace: "emojivoto",
+		Type:      pkgK8s.Deployment,
+		Name:      "web",
+	}
+
+	t.Run("Successfully evaluates a success rate objective", func(t *testing.T) {
+		expectations := []sloStatusExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: slo.linkerd.io/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: web-availability
+  namespace: emojivoto
+spec:
+  target:
+    kind: deploy
+    name: web
+  successRate:
+    objective: 0.75
+  window: 30d
+  burnRateWindows:
+  - 1h
+`,
+					},
+					mockPromResponse: model.Vector{
+						&model.Sample{
+							Metric: model.Metric{"classification": "success"},
+							Value:  875,
+						},
+						&model.Sample{
+							Metric: model.Metric{"classification": "failure"},
+							Value:  125,
+						},
+					},
+					expectedPrometheusQueries: []string{
+						`sum(increase(response_total{deployment="web", direction="inbound", namespace="emojivoto"}[1h])) by (classification)`,
+						`sum(increase(response_total{deployment="web", direction="inbound", namespace="emojivoto"}[30d])) by (classification)`,
+					},
+				},
+				req: pb.SLOStatusRequest{Namespace: "emojivoto"},
+				expectedResponse: sloStatusOk(&pb.SLOStatus{
+					Name:                 "web-availability",
+					Namespace:            "emojivoto",
+					Target:               webDeploy,
+					Objective:            0.75,
+					Window:               "30d",
+					GoodCount:            875,
+					TotalCount:           1000,
+					ErrorBudgetRemaining: 0.5,
+					BurnRates:            []*pb.SLOStatus_BurnRate{{Window: "1h", Rate: 0.5}},
+				}),
+			},
+		}
+
+		testSLOStatus(t, expectations)
+	})
+
+	t.Run("Successfully evaluates a route latency objective", func(t *testing.T) {
+		expectations := []sloStatusExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: slo.linkerd.io/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: web-list-latency
+  namespace: emojivoto
+spec:
+  target:
+    kind: deployment
+    name: web
+    route: GET /api/list
+  latency:
+    thresholdMs: 100
+    objective: 0.5
+  window: 7d
+`,
+					},
+					mockPromResponse: model.Vector{
+						&model.Sample{Metric: model.Metric{"le": "50"}, Value: 500},
+						&model.Sample{Metric: model.Metric{"le": "100"}, Value: 750},
+						&model.Sample{Metric: model.Metric{"le": "+Inf"}, Value: 1000},
+					},
+					expectedPrometheusQueries: []string{
+						`sum(increase(route_response_latency_ms_bucket{deployment="web", direction="inbound", namespace="emojivoto", rt_route="GET /api/list"}[1h])) by (le)`,
+						`sum(increase(route_response_latency_ms_bucket{deployment="web", direction="inbound", namespace="emojivoto", rt_route="GET /api/list"}[5m])) by (le)`,
+						`sum(increase(route_response_latency_ms_bucket{deployment="web", direction="inbound", namespace="emojivoto", rt_route="GET /api/list"}[6h])) by (le)`,
+						`sum(increase(route_response_latency_ms_bucket{deployment="web", direction="inbound", namespace="emojivoto", rt_route="GET /api/list"}[7d])) by (le)`,
+					},
+				},
+				req: pb.SLOStatusRequest{Namespace: "emojivoto", Name: "web-list-latency"},
+				expectedResponse: sloStatusOk(&pb.SLOStatus{
+					Name:                 "web-list-latency",
+					Namespace:            "emojivoto",
+					Target:               webDeploy,
+					Route:                "GET /api/list",
+					Objective:            0.5,
+					LatencyThresholdMs:   100,
+					Window:               "7d",
+					GoodCount:            750,
+					TotalCount:           1000,
+					ErrorBudgetRemaining: 0.5,
+					BurnRates: []*pb.SLOStatus_BurnRate{
+						{Window: "5m", Rate: 0.5},
+						{Window: "1h", Rate: 0.5},
+						{Window: "6h", Rate: 0.5},
+					},
+				}),
+			},
+		}
+
+		testSLOStatus(t, expectations)
+	})
+
+	t.Run("Reports invalid objectives without querying Prometheus", func(t *testing.T) {
+		expectations := []sloStatusExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: slo.linkerd.io/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: no-objective
+  namespace: emojivoto
+spec:
+  target:
+    kind: deployment
+    name: web
+  window: 30d
+`, `
+apiVersion: slo.linkerd.io/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: bad-window
+  namespace: emojivoto
+spec:
+  target:
+    kind: deployment
+    name: web
+  successRate:
+    objective: 0.99
+  window: 30d
+  burnRateWindows:
+  - soon
+`, `
+apiVersion: slo.linkerd.io/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: service-route
+  namespace: emojivoto
+spec:
+  target:
+    kind: svc
+    name: web-svc
+    route: GET /api/list
+  successRate:
+    objective: 0.99
+  window: 30d
+`, `
+apiVersion: slo.linkerd.io/v1alpha1
+kind: ServiceLevelObjective
+metadata:
+  name: between-buckets
+  namespace: emojivoto
+spec:
+  target:
+    kind: deployment
+    name: web
+  latency:
+    thresholdMs: 250
+    objective: 0.99
+  window: 30d
+`,
+					},
+					expectedPrometheusQueries: []string{},
+				},
+				req: pb.SLOStatusRequest{},
+				expectedResponse: sloStatusOk(
+					&pb.SLOStatus{
+						Name:      "bad-window",
+						Namespace: "emojivoto",
+						Target:    webDeploy,
+						Objective: 0.99,
+						Window:    "30d",
+						Error:     `invalid window [soon]: not a valid duration string: "soon"`,
+					},
+					&pb.SLOStatus{
+						Name:      "between-buckets",
+						Namespace: "emojivoto",
+						Target:    webDeploy,
+						Window:    "30d",
+						Error:     "latency thresholdMs [250] is not a latency histogram bucket bound; must be one of: 1, 2, 3, 4, 5, 10, 20, 30, 40, 50, 100, 200, 300, 400, 500, 1000, 2000, 3000, 4000, 5000, 10000, 20000, 30000, 40000, 50000",
+					},
+					&pb.SLOStatus{
+						Name:      "no-objective",
+						Namespace: "emojivoto",
+						Target:    webDeploy,
+						Window:    "30d",
+						Error:     "one of successRate and latency must be set",
+					},
+					&pb.SLOStatus{
+						Name:      "service-route",
+						Namespace: "emojivoto",
+						Route:     "GET /api/list",
+						Window:    "30d",
+						Error:     "route objectives are not supported for service targets",
+					},
+				),
+			},
+		}
+
+		testSLOStatus(t, expectations)
+	})
+
+	t.Run("Returns an error response for unknown objectives", func(t *testing.T) {
+		expectations := []sloStatusExpected{
+			{
+				expectedStatRPC: expectedStatRPC{expectedPrometheusQueries: []string{}},
+				req:             pb.SLOStatusRequest{Namespace: "emojivoto", Name: "missing"},
+				expectedResponse: pb.SLOStatusResponse{
+					Response: &pb.SLOStatusResponse_Error{
+						Error: &pb.ResourceError{
+							Resource: &pb.Resource{Namespace: "emojivoto", Name: "missing"},
+							Error:    "ServiceLevelObjective emojivoto/missing not found",
+						},
+					},
+				},
+			},
+		}
+
+		testSLOStatus(t, expectations)
+	})
+}
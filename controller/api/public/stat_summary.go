@@ -2,8 +2,12 @@ package public
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"regexp"
+	"strings"
 
+	tsv1alpha1 "github.com/deislabs/smi-sdk-go/pkg/apis/split/v1alpha1"
 	proto "github.com/golang/protobuf/proto"
 	"github.com/linkerd/linkerd2/controller/api/util"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
@@ -12,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -37,6 +42,8 @@ const (
 
 	reqQuery             = "sum(increase(response_total%s[%s])) by (%s, classification, tls)"
 	latencyQuantileQuery = "histogram_quantile(%s, sum(irate(response_latency_ms_bucket%s[%s])) by (le, %s))"
+	latencyBucketsQuery  = "sum(increase(response_latency_ms_bucket%s[%s])) by (le, %s)"
+	statusCodesQuery     = "sum(increase(response_total%s[%s])) by (%s, status_code, grpc_status)"
 	tcpConnectionsQuery  = "sum(tcp_open_connections%s) by (%s)"
 	tcpReadBytesQuery    = "sum(increase(tcp_read_bytes_total%s[%s])) by (%s)"
 	tcpWriteBytesQuery   = "sum(increase(tcp_write_bytes_total%s[%s])) by (%s)"
@@ -56,6 +63,14 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		return statSummaryError(req, "StatSummary request missing Selector Resource"), nil
 	}
 
+	selector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return statSummaryError(req, err.Error()), nil
+	}
+	if !selector.Empty() && isNonK8sResourceQuery(req.GetSelector().GetResource().GetType()) {
+		return statSummaryError(req, fmt.Sprintf("label selectors are not supported for resource type '%s'", req.GetSelector().GetResource().GetType())), nil
+	}
+
 	// special case to check for services as outbound only
 	if isInvalidServiceRequest(req.Selector, req.GetFromResource()) {
 		return statSummaryError(req, "service only supported as a target on 'from' queries, or as a destination on 'to' queries"), nil
@@ -66,17 +81,32 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		if req.Outbound.(*pb.StatSummaryRequest_ToResource).ToResource.Type == k8s.All {
 			return statSummaryError(req, "resource type 'all' is not supported as a filter"), nil
 		}
+		if req.Outbound.(*pb.StatSummaryRequest_ToResource).ToResource.Type == k8s.TrafficSplit {
+			return statSummaryError(req, "resource type 'trafficsplit' is not supported as a filter"), nil
+		}
+		if req.Selector.Resource.Type == k8s.TrafficSplit {
+			return statSummaryError(req, "trafficsplit is not supported as a target on 'to' queries"), nil
+		}
 	case *pb.StatSummaryRequest_FromResource:
 		if req.Outbound.(*pb.StatSummaryRequest_FromResource).FromResource.Type == k8s.All {
 			return statSummaryError(req, "resource type 'all' is not supported as a filter"), nil
 		}
+		if req.Outbound.(*pb.StatSummaryRequest_FromResource).FromResource.Type == k8s.TrafficSplit {
+			return statSummaryError(req, "resource type 'trafficsplit' is not supported as a filter"), nil
+		}
 	}
 
 	statTables := make([]*pb.StatTable, 0)
 
 	var resourcesToQuery []string
 	if req.Selector.Resource.Type == k8s.All {
-		resourcesToQuery = k8s.StatAllResourceTypes
+		for _, resource := range k8s.StatAllResourceTypes {
+			// resources without labels cannot match a label selector
+			if !selector.Empty() && isNonK8sResourceQuery(resource) {
+				continue
+			}
+			resourcesToQuery = append(resourcesToQuery, resource)
+		}
 	} else {
 		resourcesToQuery = []string{req.Selector.Resource.Type}
 	}
@@ -89,9 +119,12 @@ func (s *grpcServer) StatSummary(ctx context.Context, req *pb.StatSummaryRequest
 		statReq.Selector.Resource.Type = resource
 
 		go func() {
-			if isNonK8sResourceQuery(statReq.GetSelector().GetResource().GetType()) {
+			switch {
+			case isNonK8sResourceQuery(statReq.GetSelector().GetResource().GetType()):
 				resultChan <- s.nonK8sResourceQuery(ctx, statReq)
-			} else {
+			case statReq.GetSelector().GetResource().GetType() == k8s.TrafficSplit:
+				resultChan <- s.trafficSplitResourceQuery(ctx, statReq)
+			default:
 				resultChan <- s.k8sResourceQuery(ctx, statReq)
 			}
 		}()
@@ -137,7 +170,11 @@ func statSummaryError(req *pb.StatSummaryRequest, message string) *pb.StatSummar
 
 func (s *grpcServer) getKubernetesObjectStats(req *pb.StatSummaryRequest) (map[rKey]k8sStat, error) {
 	requestedResource := req.GetSelector().GetResource()
-	objects, err := s.k8sAPI.GetObjects(requestedResource.Namespace, requestedResource.Type, requestedResource.Name)
+	selector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	objects, err := s.k8sAPI.GetObjectsMatching(requestedResource.Namespace, requestedResource.Type, requestedResource.Name, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +206,29 @@ func (s *grpcServer) getKubernetesObjectStats(req *pb.StatSummaryRequest) (map[r
 	return objectMap, nil
 }
 
+// getMatchingResources returns the namespace/name keys of the objects of the
+// given resource's type, in its namespace, whose labels match selector.
+func (s *grpcServer) getMatchingResources(resource *pb.Resource, selector labels.Selector) (map[string]struct{}, error) {
+	objects, err := s.k8sAPI.GetObjectsMatching(resource.GetNamespace(), resource.GetType(), resource.GetName(), selector)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := map[string]struct{}{}
+	for _, obj := range objects {
+		metaObj, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		matching[metaObj.GetNamespace()+"/"+metaObj.GetName()] = struct{}{}
+	}
+	return matching, nil
+}
+
+func resourceKey(resource *pb.Resource) string {
+	return resource.GetNamespace() + "/" + resource.GetName()
+}
+
 func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
 	k8sObjects, err := s.getKubernetesObjectStats(req)
 	if err != nil {
@@ -177,8 +237,9 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 
 	var requestMetrics map[rKey]*pb.BasicStats
 	var tcpMetrics map[rKey]*pb.TcpStats
+	var statusMetrics map[rKey]*pb.StatusBreakdown
 	if !req.SkipStats {
-		requestMetrics, tcpMetrics, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		requestMetrics, tcpMetrics, statusMetrics, err = s.getStatMetrics(ctx, req, req.TimeWindow)
 		if err != nil {
 			return resourceResult{res: nil, err: err}
 		}
@@ -210,9 +271,10 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 				Namespace: k8sResource.GetNamespace(),
 				Type:      req.GetSelector().GetResource().GetType(),
 			},
-			TimeWindow: req.TimeWindow,
-			Stats:      basicStats,
-			TcpStats:   tcpStats,
+			TimeWindow:      req.TimeWindow,
+			Stats:           basicStats,
+			TcpStats:        tcpStats,
+			StatusBreakdown: statusMetrics[key],
 		}
 
 		podStat := objInfo.podStats
@@ -237,9 +299,10 @@ func (s *grpcServer) k8sResourceQuery(ctx context.Context, req *pb.StatSummaryRe
 
 func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
 	var requestMetrics map[rKey]*pb.BasicStats
+	var statusMetrics map[rKey]*pb.StatusBreakdown
 	if !req.SkipStats {
 		var err error
-		requestMetrics, _, err = s.getStatMetrics(ctx, req, req.TimeWindow)
+		requestMetrics, _, statusMetrics, err = s.getStatMetrics(ctx, req, req.TimeWindow)
 		if err != nil {
 			return resourceResult{res: nil, err: err}
 		}
@@ -255,8 +318,9 @@ func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummar
 				Namespace: rkey.Namespace,
 				Name:      rkey.Name,
 			},
-			TimeWindow: req.TimeWindow,
-			Stats:      metrics,
+			TimeWindow:      req.TimeWindow,
+			Stats:           metrics,
+			StatusBreakdown: statusMetrics[rkey],
 		}
 		rows = append(rows, &row)
 	}
@@ -271,6 +335,122 @@ func (s *grpcServer) nonK8sResourceQuery(ctx context.Context, req *pb.StatSummar
 	return resourceResult{res: &rsp, err: nil}
 }
 
+// trafficSplitResourceQuery returns one row for each leaf of each TrafficSplit,
+// in the order the leaves are listed on the TrafficSplit. The stats of a leaf
+// are those of the requests sent to the split's apex service that were routed
+// to the leaf.
+func (s *grpcServer) trafficSplitResourceQuery(ctx context.Context, req *pb.StatSummaryRequest) resourceResult {
+	requestedResource := req.GetSelector().GetResource()
+	selector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return resourceResult{res: nil, err: err}
+	}
+	objects, err := s.k8sAPI.GetObjectsMatching(requestedResource.Namespace, requestedResource.Type, requestedResource.Name, selector)
+	if err != nil {
+		return resourceResult{res: nil, err: err}
+	}
+
+	rows := make([]*pb.StatTable_PodGroup_Row, 0)
+	for _, object := range objects {
+		split, ok := object.(*tsv1alpha1.TrafficSplit)
+		if !ok {
+			return resourceResult{res: nil, err: fmt.Errorf("unexpected object type %T", object)}
+		}
+
+		var requestMetrics map[rKey]*pb.BasicStats
+		var statusMetrics map[rKey]*pb.StatusBreakdown
+		if !req.SkipStats {
+			requestMetrics, statusMetrics, err = s.getTrafficSplitMetrics(ctx, req, split)
+			if err != nil {
+				return resourceResult{res: nil, err: err}
+			}
+		}
+
+		for _, backend := range split.Spec.Backends {
+			key := rKey{
+				Namespace: split.Namespace,
+				Type:      k8s.TrafficSplit,
+				Name:      backend.Service,
+			}
+
+			row := pb.StatTable_PodGroup_Row{
+				Resource: &pb.Resource{
+					Name:      split.Name,
+					Namespace: split.Namespace,
+					Type:      k8s.TrafficSplit,
+				},
+				TimeWindow:      req.TimeWindow,
+				Stats:           requestMetrics[key],
+				StatusBreakdown: statusMetrics[key],
+				TsStats: &pb.TrafficSplitStats{
+					Apex:   split.Spec.Service,
+					Leaf:   backend.Service,
+					Weight: backend.Weight.String(),
+				},
+			}
+			rows = append(rows, &row)
+		}
+	}
+
+	rsp := pb.StatTable{
+		Table: &pb.StatTable_PodGroup_{
+			PodGroup: &pb.StatTable_PodGroup{
+				Rows: rows,
+			},
+		},
+	}
+	return resourceResult{res: &rsp, err: nil}
+}
+
+// getTrafficSplitMetrics queries the outbound requests to the apex service of
+// the split, grouped by the leaf service they were routed to.
+func (s *grpcServer) getTrafficSplitMetrics(ctx context.Context, req *pb.StatSummaryRequest, split *tsv1alpha1.TrafficSplit) (map[rKey]*pb.BasicStats, map[rKey]*pb.StatusBreakdown, error) {
+	labels := promDirectionLabels("outbound")
+	if req.GetFromResource() != nil {
+		labels = labels.Merge(promQueryLabels(req.GetFromResource()))
+	}
+	labels = labels.Merge(model.LabelSet{dstNamespaceLabel: model.LabelValue(split.Namespace)})
+	reqLabels := generateLabelStringWithRegex(labels, map[model.LabelName]string{
+		"authority":     apexAuthorityRegex(split),
+		dstServiceLabel: leafServicesRegex(split),
+	})
+	groupBy := model.LabelNames{dstNamespaceLabel, dstServiceLabel}
+
+	promQueries := map[promType]string{
+		promRequests: reqQuery,
+	}
+	if req.LatencyHistogram {
+		promQueries[promLatencyBuckets] = latencyBucketsQuery
+	}
+	if req.StatusBreakdown {
+		promQueries[promStatusCodes] = statusCodesQuery
+	}
+	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels, req.TimeWindow, groupBy.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	basicStats, _, statusBreakdowns := processPrometheusMetrics(req, results, groupBy)
+	return basicStats, statusBreakdowns, nil
+}
+
+// apexAuthorityRegex matches the authorities of requests to the apex service
+// of a split: its name, alone or qualified up to the cluster domain, with an
+// optional port.
+func apexAuthorityRegex(split *tsv1alpha1.TrafficSplit) string {
+	return fmt.Sprintf(`^%s(\.%s(\.svc(\.[^:]+)?)?)?(:\d+)?$`,
+		regexp.QuoteMeta(split.Spec.Service), regexp.QuoteMeta(split.Namespace))
+}
+
+// leafServicesRegex matches the names of the leaf services of a split.
+func leafServicesRegex(split *tsv1alpha1.TrafficSplit) string {
+	leaves := make([]string, len(split.Spec.Backends))
+	for i, backend := range split.Spec.Backends {
+		leaves[i] = regexp.QuoteMeta(backend.Service)
+	}
+	return fmt.Sprintf("^(%s)$", strings.Join(leaves, "|"))
+}
+
 func isNonK8sResourceQuery(resourceType string) bool {
 	return resourceType == k8s.Authority
 }
@@ -327,7 +507,7 @@ func buildRequestLabels(req *pb.StatSummaryRequest) (labels model.LabelSet, labe
 	return
 }
 
-func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequest, timeWindow string) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats, error) {
+func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequest, timeWindow string) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats, map[rKey]*pb.StatusBreakdown, error) {
 	reqLabels, groupBy := buildRequestLabels(req)
 	promQueries := map[promType]string{
 		promRequests: reqQuery,
@@ -338,19 +518,26 @@ func (s *grpcServer) getStatMetrics(ctx context.Context, req *pb.StatSummaryRequ
 		promQueries[promTCPReadBytes] = tcpReadBytesQuery
 		promQueries[promTCPWriteBytes] = tcpWriteBytesQuery
 	}
+	if req.LatencyHistogram {
+		promQueries[promLatencyBuckets] = latencyBucketsQuery
+	}
+	if req.StatusBreakdown {
+		promQueries[promStatusCodes] = statusCodesQuery
+	}
 	results, err := s.getPrometheusMetrics(ctx, promQueries, latencyQuantileQuery, reqLabels.String(), timeWindow, groupBy.String())
 
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
-	basicStats, tcpStats := processPrometheusMetrics(req, results, groupBy)
-	return basicStats, tcpStats, nil
+	basicStats, tcpStats, statusBreakdowns := processPrometheusMetrics(req, results, groupBy)
+	return basicStats, tcpStats, statusBreakdowns, nil
 }
 
-func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats) {
+func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult, groupBy model.LabelNames) (map[rKey]*pb.BasicStats, map[rKey]*pb.TcpStats, map[rKey]*pb.StatusBreakdown) {
 	basicStats := make(map[rKey]*pb.BasicStats)
 	tcpStats := make(map[rKey]*pb.TcpStats)
+	statusBreakdowns := make(map[rKey]*pb.StatusBreakdown)
 
 	for _, result := range results {
 		for _, sample := range result.vec {
@@ -366,6 +553,14 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 					tcpStats[resource] = &pb.TcpStats{}
 				}
 			}
+			addStatusBreakdown := func() {
+				if statusBreakdowns[resource] == nil {
+					statusBreakdowns[resource] = &pb.StatusBreakdown{
+						HttpStatusClasses: make(map[string]uint64),
+						GrpcStatusCodes:   make(map[string]uint64),
+					}
+				}
+			}
 
 			value := extractSampleValue(sample)
 
@@ -387,6 +582,9 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 			case promLatencyP99:
 				addBasicStats()
 				basicStats[resource].LatencyMsP99 = value
+			case promLatencyBuckets:
+				addBasicStats()
+				addLatencyBucket(basicStats[resource], sample)
 			case promTCPConnections:
 				addTCPStats()
 				tcpStats[resource].OpenConnections = value
@@ -396,12 +594,15 @@ func processPrometheusMetrics(req *pb.StatSummaryRequest, results []promResult,
 			case promTCPWriteBytes:
 				addTCPStats()
 				tcpStats[resource].WriteBytesTotal = value
+			case promStatusCodes:
+				addStatusBreakdown()
+				addStatusCounts(statusBreakdowns[resource], sample.Metric, value)
 			}
 
 		}
 	}
 
-	return basicStats, tcpStats
+	return basicStats, tcpStats, statusBreakdowns
 }
 
 func metricToKey(req *pb.StatSummaryRequest, metric model.Metric, groupBy model.LabelNames) rKey {
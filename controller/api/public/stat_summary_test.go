@@ -3,9 +3,12 @@ package public
 import (
 	"context"
 	"errors"
+	"math"
+	"regexp"
 	"sort"
 	"testing"
 
+	tsv1alpha1 "github.com/deislabs/smi-sdk-go/pkg/apis/split/v1alpha1"
 	"github.com/golang/protobuf/proto"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
@@ -207,6 +210,134 @@ status:
 		testStatSummary(t, expectations)
 	})
 
+	t.Run("Only returns resources whose labels match the label selector", func(t *testing.T) {
+		expectations := []statSumExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: apps/v1beta2
+kind: Deployment
+metadata:
+  name: emoji
+  namespace: emojivoto
+  labels:
+    tier: backend
+spec:
+  selector:
+    matchLabels:
+      app: emoji-svc
+  strategy: {}
+  template:
+    spec:
+      containers:
+      - image: buoyantio/emojivoto-emoji-svc:v3
+`, `
+apiVersion: apps/v1beta2
+kind: Deployment
+metadata:
+  name: web
+  namespace: emojivoto
+  labels:
+    tier: frontend
+spec:
+  selector:
+    matchLabels:
+      app: web-svc
+  strategy: {}
+  template:
+    spec:
+      containers:
+      - image: buoyantio/emojivoto-web:v3
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+					},
+					mockPromResponse: prometheusMetric("emoji", "deployment"),
+				},
+				req: pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Deployment,
+						},
+						Labels: &pb.LabelSelector{
+							MatchExpressions: []*pb.LabelSelectorRequirement{
+								{Key: "tier", Operator: pb.LabelSelectorRequirement_IN, Values: []string{"backend", "data"}},
+							},
+						},
+					},
+					TimeWindow: "1m",
+				},
+				expectedResponse: GenStatSummaryResponse("emoji", pkgK8s.Deployment, []string{"emojivoto"}, &PodCounts{
+					MeshedPods:  1,
+					RunningPods: 1,
+					FailedPods:  0,
+				}, true, false),
+			},
+		}
+
+		testStatSummary(t, expectations)
+	})
+
+	t.Run("Validates label selectors", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI()
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		fakeGrpcServer := newGrpcServer(
+			&InMemoryMetricsBackend{Res: model.Vector{}},
+			nil,
+			nil,
+			k8sAPI,
+			"linkerd",
+			[]string{},
+		)
+
+		invalidRequests := []*pb.StatSummaryRequest{
+			{
+				Selector: &pb.ResourceSelection{
+					Resource: &pb.Resource{Type: pkgK8s.Deployment},
+					Labels: &pb.LabelSelector{
+						MatchExpressions: []*pb.LabelSelectorRequirement{
+							{Key: "tier", Operator: pb.LabelSelectorRequirement_EXISTS, Values: []string{"backend"}},
+						},
+					},
+				},
+			},
+			{
+				Selector: &pb.ResourceSelection{
+					Resource:      &pb.Resource{Type: pkgK8s.Deployment},
+					LabelSelector: "tier=-backend",
+				},
+			},
+			{
+				Selector: &pb.ResourceSelection{
+					Resource: &pb.Resource{Type: pkgK8s.Authority},
+					Labels: &pb.LabelSelector{
+						MatchLabels: map[string]string{"tier": "backend"},
+					},
+				},
+			},
+		}
+
+		for _, req := range invalidRequests {
+			rsp, err := fakeGrpcServer.StatSummary(context.TODO(), req)
+			if err != nil || rsp.GetError() == nil {
+				t.Fatalf("Expected validation error on StatSummaryResponse, got %v, %v", rsp, err)
+			}
+		}
+	})
+
 	t.Run("Successfully performs a query based on resource type DaemonSet", func(t *testing.T) {
 		expectations := []statSumExpected{
 			{
@@ -506,6 +637,219 @@ status:
 		testStatSummary(t, expectations)
 	})
 
+	t.Run("Queries prometheus for the latency histogram when requested", func(t *testing.T) {
+		exp := expectedStatRPC{
+			err: nil,
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+			},
+			mockPromResponse: model.Vector{},
+			expectedPrometheusQueries: []string{
+				`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`sum(increase(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod)`,
+				`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, tls)`,
+			},
+		}
+
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		_, err = fakeGrpcServer.StatSummary(context.TODO(), &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{
+					Name:      "emojivoto-1",
+					Namespace: "emojivoto",
+					Type:      pkgK8s.Pod,
+				},
+			},
+			TimeWindow:       "1m",
+			LatencyHistogram: true,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		err = exp.verifyPromQueries(mockProm)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Assembles latency histograms from bucket samples", func(t *testing.T) {
+		bucket := func(pod, le string, value model.SampleValue) *model.Sample {
+			return &model.Sample{
+				Metric: model.Metric{
+					"namespace": "emojivoto",
+					"pod":       model.LabelValue(pod),
+					"le":        model.LabelValue(le),
+				},
+				Value: value,
+			}
+		}
+
+		req := &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Namespace: "emojivoto", Type: pkgK8s.Pod},
+			},
+		}
+		results := []promResult{
+			{
+				prom: promLatencyBuckets,
+				vec: model.Vector{
+					bucket("emojivoto-1", "+Inf", 10),
+					bucket("emojivoto-1", "10", 4),
+					bucket("emojivoto-1", "100", 9),
+					bucket("emojivoto-1", "10", 2),
+					bucket("emojivoto-2", "1", 1),
+					bucket("emojivoto-2", "+Inf", 1),
+					bucket("emojivoto-2", "garbage", 1),
+				},
+			},
+		}
+
+		basicStats, _, _ := processPrometheusMetrics(req, results, model.LabelNames{"namespace", "pod"})
+
+		expected := map[string]*pb.LatencyHistogram{
+			"emojivoto-1": {
+				Buckets: []*pb.LatencyHistogram_Bucket{
+					{LeMs: 10, Count: 6},
+					{LeMs: 100, Count: 9},
+					{LeMs: math.Inf(1), Count: 10},
+				},
+			},
+			"emojivoto-2": {
+				Buckets: []*pb.LatencyHistogram_Bucket{
+					{LeMs: 1, Count: 1},
+					{LeMs: math.Inf(1), Count: 1},
+				},
+			},
+		}
+		for name, histogram := range expected {
+			key := rKey{Namespace: "emojivoto", Type: pkgK8s.Pod, Name: name}
+			if !proto.Equal(basicStats[key].GetLatencyHistogram(), histogram) {
+				t.Fatalf("Expected histogram for %s: %+v\nGot: %+v", name, histogram, basicStats[key].GetLatencyHistogram())
+			}
+		}
+	})
+
+	t.Run("Queries prometheus for the status breakdown when requested", func(t *testing.T) {
+		exp := expectedStatRPC{
+			err: nil,
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-1
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+			},
+			mockPromResponse: model.Vector{},
+			expectedPrometheusQueries: []string{
+				`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (le, namespace, pod))`,
+				`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, classification, tls)`,
+				`sum(increase(response_total{direction="inbound", namespace="emojivoto", pod="emojivoto-1"}[1m])) by (namespace, pod, status_code, grpc_status)`,
+			},
+		}
+
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		_, err = fakeGrpcServer.StatSummary(context.TODO(), &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{
+					Name:      "emojivoto-1",
+					Namespace: "emojivoto",
+					Type:      pkgK8s.Pod,
+				},
+			},
+			TimeWindow:      "1m",
+			StatusBreakdown: true,
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		err = exp.verifyPromQueries(mockProm)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Assembles status breakdowns from response samples", func(t *testing.T) {
+		response := func(pod, statusCode, grpcStatus string, value model.SampleValue) *model.Sample {
+			metric := model.Metric{
+				"namespace":   "emojivoto",
+				"pod":         model.LabelValue(pod),
+				"status_code": model.LabelValue(statusCode),
+			}
+			if grpcStatus != "" {
+				metric["grpc_status"] = model.LabelValue(grpcStatus)
+			}
+			return &model.Sample{Metric: metric, Value: value}
+		}
+
+		req := &pb.StatSummaryRequest{
+			Selector: &pb.ResourceSelection{
+				Resource: &pb.Resource{Namespace: "emojivoto", Type: pkgK8s.Pod},
+			},
+		}
+		results := []promResult{
+			{
+				prom: promStatusCodes,
+				vec: model.Vector{
+					response("emojivoto-1", "200", "", 10),
+					response("emojivoto-1", "204", "", 2),
+					response("emojivoto-1", "503", "", 3),
+					response("emojivoto-2", "200", "0", 7),
+					response("emojivoto-2", "200", "14", 4),
+					response("emojivoto-2", "200", "99", 1),
+				},
+			},
+		}
+
+		_, _, breakdowns := processPrometheusMetrics(req, results, model.LabelNames{"namespace", "pod"})
+
+		expected := map[string]*pb.StatusBreakdown{
+			"emojivoto-1": {
+				HttpStatusClasses: map[string]uint64{"2xx": 12, "5xx": 3},
+				GrpcStatusCodes:   map[string]uint64{},
+			},
+			"emojivoto-2": {
+				HttpStatusClasses: map[string]uint64{"2xx": 12},
+				GrpcStatusCodes:   map[string]uint64{"OK": 7, "UNAVAILABLE": 4},
+			},
+		}
+		for name, breakdown := range expected {
+			key := rKey{Namespace: "emojivoto", Type: pkgK8s.Pod, Name: name}
+			if !proto.Equal(breakdowns[key], breakdown) {
+				t.Fatalf("Expected status breakdown for %s: %+v\nGot: %+v", name, breakdown, breakdowns[key])
+			}
+		}
+	})
+
 	t.Run("Queries prometheus for a specific resource if name is specified", func(t *testing.T) {
 		expectations := []statSumExpected{
 			{
@@ -1087,7 +1431,7 @@ status:
 
 		for _, exp := range expectations {
 			fakeGrpcServer := newGrpcServer(
-				&mockProm{Res: exp.mockPromResponse},
+				&InMemoryMetricsBackend{Res: exp.mockPromResponse},
 				nil,
 				nil,
 				k8sAPI,
@@ -1112,7 +1456,7 @@ status:
 			t.Fatalf("NewFakeAPI returned an error: %s", err)
 		}
 		fakeGrpcServer := newGrpcServer(
-			&mockProm{Res: model.Vector{}},
+			&InMemoryMetricsBackend{Res: model.Vector{}},
 			nil,
 			nil,
 			k8sAPI,
@@ -1490,6 +1834,145 @@ status:
 		testStatSummary(t, expectations)
 	})
 
+	t.Run("Queries prometheus for the leaves of a TrafficSplit", func(t *testing.T) {
+		splitRow := func(leaf, weight string, stats *pb.BasicStats) *pb.StatTable_PodGroup_Row {
+			return &pb.StatTable_PodGroup_Row{
+				Resource: &pb.Resource{
+					Namespace: "emojivoto",
+					Type:      pkgK8s.TrafficSplit,
+					Name:      "web-split",
+				},
+				TimeWindow: "1m",
+				Stats:      stats,
+				TsStats: &pb.TrafficSplitStats{
+					Apex:   "web-svc",
+					Leaf:   leaf,
+					Weight: weight,
+				},
+			}
+		}
+
+		expectations := []statSumExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					k8sConfigs: []string{`
+apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: web-split
+  namespace: emojivoto
+spec:
+  service: web-svc
+  backends:
+  - service: web-v1
+    weight: 900m
+  - service: web-v2
+    weight: 100m
+`,
+					},
+					mockPromResponse: model.Vector{
+						genPromSample("web-v1", "service", "emojivoto", true),
+					},
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{authority=~"^web-svc(\\.emojivoto(\\.svc(\\.[^:]+)?)?)?(:\\d+)?$", direction="outbound", dst_namespace="emojivoto", dst_service=~"^(web-v1|web-v2)$"}[1m])) by (le, dst_namespace, dst_service))`,
+						`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{authority=~"^web-svc(\\.emojivoto(\\.svc(\\.[^:]+)?)?)?(:\\d+)?$", direction="outbound", dst_namespace="emojivoto", dst_service=~"^(web-v1|web-v2)$"}[1m])) by (le, dst_namespace, dst_service))`,
+						`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{authority=~"^web-svc(\\.emojivoto(\\.svc(\\.[^:]+)?)?)?(:\\d+)?$", direction="outbound", dst_namespace="emojivoto", dst_service=~"^(web-v1|web-v2)$"}[1m])) by (le, dst_namespace, dst_service))`,
+						`sum(increase(response_total{authority=~"^web-svc(\\.emojivoto(\\.svc(\\.[^:]+)?)?)?(:\\d+)?$", direction="outbound", dst_namespace="emojivoto", dst_service=~"^(web-v1|web-v2)$"}[1m])) by (dst_namespace, dst_service, classification, tls)`,
+					},
+				},
+				req: pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.TrafficSplit,
+						},
+					},
+					TimeWindow: "1m",
+				},
+				expectedResponse: pb.StatSummaryResponse{
+					Response: &pb.StatSummaryResponse_Ok_{
+						Ok: &pb.StatSummaryResponse_Ok{
+							StatTables: []*pb.StatTable{
+								{
+									Table: &pb.StatTable_PodGroup_{
+										PodGroup: &pb.StatTable_PodGroup{
+											Rows: []*pb.StatTable_PodGroup_Row{
+												splitRow("web-v1", "900m", &pb.BasicStats{
+													SuccessCount: 123,
+													LatencyMsP50: 123,
+													LatencyMsP95: 123,
+													LatencyMsP99: 123,
+												}),
+												splitRow("web-v2", "100m", nil),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		testStatSummary(t, expectations)
+	})
+
+	t.Run("Validates trafficsplit stat requests", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI()
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		fakeGrpcServer := newGrpcServer(
+			&InMemoryMetricsBackend{Res: model.Vector{}},
+			nil,
+			nil,
+			k8sAPI,
+			"linkerd",
+			[]string{},
+		)
+
+		invalidRequests := []statSumExpected{
+			{
+				req: pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Type: pkgK8s.TrafficSplit,
+						},
+					},
+					Outbound: &pb.StatSummaryRequest_ToResource{
+						ToResource: &pb.Resource{
+							Type: pkgK8s.Deployment,
+						},
+					},
+				},
+			},
+			{
+				req: pb.StatSummaryRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Type: pkgK8s.Deployment,
+						},
+					},
+					Outbound: &pb.StatSummaryRequest_FromResource{
+						FromResource: &pb.Resource{
+							Type: pkgK8s.TrafficSplit,
+						},
+					},
+				},
+			},
+		}
+
+		for _, invalid := range invalidRequests {
+			rsp, err := fakeGrpcServer.StatSummary(context.TODO(), &invalid.req)
+
+			if err != nil || rsp.GetError() == nil {
+				t.Fatalf("Expected validation error on StatSummaryResponse, got %v, %v", rsp, err)
+			}
+		}
+	})
+
 	t.Run("Stats returned are nil when SkipStats is true", func(t *testing.T) {
 		expectations := []statSumExpected{
 			{
@@ -1532,3 +2015,21 @@ status:
 		testStatSummary(t, expectations)
 	})
 }
+
+func TestApexAuthorityRegex(t *testing.T) {
+	split := &tsv1alpha1.TrafficSplit{}
+	split.Namespace = "emojivoto"
+	split.Spec.Service = "web"
+	apex := regexp.MustCompile(apexAuthorityRegex(split))
+
+	for _, authority := range []string{"web", "web:8080", "web.emojivoto", "web.emojivoto.svc", "web.emojivoto.svc.cluster.local:80"} {
+		if !apex.MatchString(authority) {
+			t.Fatalf("Expected %s to match the apex of the split", authority)
+		}
+	}
+	for _, authority := range []string{"web-v1", "webXemojivoto.svc", "web.books.svc.cluster.local", "web.emojivoto.svc.cluster.local:http"} {
+		if apex.MatchString(authority) {
+			t.Fatalf("Expected %s not to match the apex of the split", authority)
+		}
+	}
+}
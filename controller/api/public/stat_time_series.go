@@ -0,0 +1,218 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/api/util"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+)
+
+// Prometheus refuses range queries that would return more points than this
+// per series.
+const maxTimeSeriesPoints = 11000
+
+func (s *grpcServer) StatTimeSeries(ctx context.Context, req *pb.StatTimeSeriesRequest) (*pb.StatTimeSeriesResponse, error) {
+
+	// check for well-formed request
+	if req.GetSelector().GetResource() == nil {
+		return statTimeSeriesError(req, "StatTimeSeries request missing Selector Resource"), nil
+	}
+
+	if req.Selector.Resource.Type == k8s.All {
+		return statTimeSeriesError(req, "resource type 'all' is not supported for time series"), nil
+	}
+
+	// special case to check for services as outbound only
+	if isInvalidServiceRequest(req.Selector, req.GetFromResource()) {
+		return statTimeSeriesError(req, "service only supported as a target on 'from' queries, or as a destination on 'to' queries"), nil
+	}
+
+	if req.GetToResource().GetType() == k8s.All || req.GetFromResource().GetType() == k8s.All {
+		return statTimeSeriesError(req, "resource type 'all' is not supported as a filter"), nil
+	}
+
+	selector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return statTimeSeriesError(req, err.Error()), nil
+	}
+	if !selector.Empty() && isNonK8sResourceQuery(req.GetSelector().GetResource().GetType()) {
+		return statTimeSeriesError(req, fmt.Sprintf("label selectors are not supported for resource type '%s'", req.GetSelector().GetResource().GetType())), nil
+	}
+
+	queryRange, err := time.ParseDuration(req.Range)
+	if err != nil {
+		return statTimeSeriesError(req, fmt.Sprintf("invalid range: %s", err)), nil
+	}
+	step, err := time.ParseDuration(req.Step)
+	if err != nil {
+		return statTimeSeriesError(req, fmt.Sprintf("invalid step: %s", err)), nil
+	}
+	if step <= 0 || queryRange < step {
+		return statTimeSeriesError(req, "step must be positive and no longer than range"), nil
+	}
+	if int64(queryRange/step) >= maxTimeSeriesPoints {
+		return statTimeSeriesError(req, fmt.Sprintf("range [%s] and step [%s] exceed the maximum of %d points per series", req.Range, req.Step, maxTimeSeriesPoints)), nil
+	}
+
+	// each point aggregates over the step by default, so that consecutive
+	// points cover the whole range without overlapping
+	timeWindow := req.TimeWindow
+	if timeWindow == "" {
+		timeWindow = req.Step
+	}
+
+	summaryReq := timeSeriesToSummaryRequest(req)
+	reqLabels, groupBy := buildRequestLabels(summaryReq)
+
+	end := time.Now()
+	r := promv1.Range{
+		Start: end.Add(-queryRange),
+		End:   end,
+		Step:  step,
+	}
+
+	results, err := s.getPrometheusRangeMetrics(ctx, r, reqQuery, latencyQuantileQuery, reqLabels.String(), timeWindow, groupBy.String())
+	if err != nil {
+		return nil, util.GRPCError(err)
+	}
+
+	series := processPrometheusRangeMetrics(summaryReq, results, groupBy, timeWindow)
+	if !selector.Empty() {
+		selected, err := s.getMatchingResources(req.GetSelector().GetResource(), selector)
+		if err != nil {
+			return nil, util.GRPCError(err)
+		}
+
+		filtered := []*pb.StatTimeSeries{}
+		for _, ts := range series {
+			if _, ok := selected[resourceKey(ts.GetResource())]; ok {
+				filtered = append(filtered, ts)
+			}
+		}
+		series = filtered
+	}
+
+	rsp := pb.StatTimeSeriesResponse{
+		Response: &pb.StatTimeSeriesResponse_Ok_{ // https://github.com/golang/protobuf/issues/205
+			Ok: &pb.StatTimeSeriesResponse_Ok{
+				Series: series,
+			},
+		},
+	}
+
+	return &rsp, nil
+}
+
+func statTimeSeriesError(req *pb.StatTimeSeriesRequest, message string) *pb.StatTimeSeriesResponse {
+	return &pb.StatTimeSeriesResponse{
+		Response: &pb.StatTimeSeriesResponse_Error{
+			Error: &pb.ResourceError{
+				Resource: req.GetSelector().GetResource(),
+				Error:    message,
+			},
+		},
+	}
+}
+
+// timeSeriesToSummaryRequest builds the StatSummaryRequest equivalent to req,
+// so that both RPCs select traffic with the same Prometheus labels.
+func timeSeriesToSummaryRequest(req *pb.StatTimeSeriesRequest) *pb.StatSummaryRequest {
+	summaryReq := &pb.StatSummaryRequest{
+		Selector:   req.Selector,
+		TimeWindow: req.TimeWindow,
+	}
+
+	switch out := req.Outbound.(type) {
+	case *pb.StatTimeSeriesRequest_ToResource:
+		summaryReq.Outbound = &pb.StatSummaryRequest_ToResource{ToResource: out.ToResource}
+	case *pb.StatTimeSeriesRequest_FromResource:
+		summaryReq.Outbound = &pb.StatSummaryRequest_FromResource{FromResource: out.FromResource}
+	default:
+		summaryReq.Outbound = &pb.StatSummaryRequest_None{None: &pb.Empty{}}
+	}
+
+	return summaryReq
+}
+
+func processPrometheusRangeMetrics(req *pb.StatSummaryRequest, results []promRangeResult, groupBy model.LabelNames, timeWindow string) []*pb.StatTimeSeries {
+	points := make(map[rKey]map[model.Time]*pb.BasicStats)
+
+	for _, result := range results {
+		for _, stream := range result.matrix {
+			resource := metricToKey(req, stream.Metric, groupBy)
+			if points[resource] == nil {
+				points[resource] = make(map[model.Time]*pb.BasicStats)
+			}
+
+			for _, pair := range stream.Values {
+				stats := points[resource][pair.Timestamp]
+				if stats == nil {
+					stats = &pb.BasicStats{}
+					points[resource][pair.Timestamp] = stats
+				}
+
+				value := extractValue(pair.Value)
+
+				switch result.prom {
+				case promRequests:
+					switch string(stream.Metric[model.LabelName("classification")]) {
+					case success:
+						stats.SuccessCount += value
+					case failure:
+						stats.FailureCount += value
+					}
+				case promLatencyP50:
+					stats.LatencyMsP50 = value
+				case promLatencyP95:
+					stats.LatencyMsP95 = value
+				case promLatencyP99:
+					stats.LatencyMsP99 = value
+				}
+			}
+		}
+	}
+
+	keys := make([]rKey, 0, len(points))
+	for key := range points {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Namespace != keys[j].Namespace {
+			return keys[i].Namespace < keys[j].Namespace
+		}
+		return keys[i].Name < keys[j].Name
+	})
+
+	series := make([]*pb.StatTimeSeries, 0, len(keys))
+	for _, key := range keys {
+		timestamps := make([]model.Time, 0, len(points[key]))
+		for ts := range points[key] {
+			timestamps = append(timestamps, ts)
+		}
+		sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+		s := &pb.StatTimeSeries{
+			Resource: &pb.Resource{
+				Type:      key.Type,
+				Namespace: key.Namespace,
+				Name:      key.Name,
+			},
+			TimeWindow: timeWindow,
+		}
+		for _, ts := range timestamps {
+			s.Points = append(s.Points, &pb.StatTimeSeries_Point{
+				TimestampMs: int64(ts),
+				Stats:       points[key][ts],
+			})
+		}
+		series = append(series, s)
+	}
+
+	return series
+}
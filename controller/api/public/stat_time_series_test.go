@@ -0,0 +1,180 @@
+package public
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/prometheus/common/model"
+)
+
+type statTimeSeriesExpected struct {
+	expectedStatRPC
+	req              pb.StatTimeSeriesRequest  // the request we would like to test
+	expectedResponse pb.StatTimeSeriesResponse // the time series response we expect
+}
+
+func genPromSampleStream(resName string, resType string, resNs string, timestamps ...model.Time) *model.SampleStream {
+	stream := &model.SampleStream{
+		Metric: model.Metric{
+			model.LabelName(resType): model.LabelValue(resName),
+			"namespace":              model.LabelValue(resNs),
+			"classification":         model.LabelValue("success"),
+			"tls":                    model.LabelValue("true"),
+		},
+	}
+	for _, ts := range timestamps {
+		stream.Values = append(stream.Values, model.SamplePair{Timestamp: ts, Value: 123})
+	}
+	return stream
+}
+
+func genTimeSeriesPoint(timestamp int64) *pb.StatTimeSeries_Point {
+	return &pb.StatTimeSeries_Point{
+		TimestampMs: timestamp,
+		Stats: &pb.BasicStats{
+			SuccessCount: 123,
+			LatencyMsP50: 123,
+			LatencyMsP95: 123,
+			LatencyMsP99: 123,
+		},
+	}
+}
+
+func testStatTimeSeries(t *testing.T, expectations []statTimeSeriesExpected) {
+	for _, exp := range expectations {
+		mockProm, fakeGrpcServer, err := newMockGrpcServer(exp.expectedStatRPC)
+		if err != nil {
+			t.Fatalf("Error creating mock grpc server: %s", err)
+		}
+
+		rsp, err := fakeGrpcServer.StatTimeSeries(context.TODO(), &exp.req)
+		if err != exp.err {
+			t.Fatalf("Expected error: %s, Got: %s", exp.err, err)
+		}
+
+		err = exp.verifyPromQueries(mockProm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !proto.Equal(&exp.expectedResponse, rsp) {
+			t.Fatalf("Expected: %+v\n Got: %+v", &exp.expectedResponse, rsp)
+		}
+	}
+}
+
+func TestStatTimeSeries(t *testing.T) {
+	t.Run("Successfully performs a range query based on resource type", func(t *testing.T) {
+		expectations := []statTimeSeriesExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err: nil,
+					mockPromResponse: model.Matrix{
+						genPromSampleStream("emoji", "deployment", "emojivoto", 2000, 1000),
+						genPromSampleStream("web", "deployment", "emojivoto", 1000),
+					},
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto"}[1m])) by (le, namespace, deployment))`,
+						`histogram_quantile(0.95, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto"}[1m])) by (le, namespace, deployment))`,
+						`histogram_quantile(0.99, sum(irate(response_latency_ms_bucket{direction="inbound", namespace="emojivoto"}[1m])) by (le, namespace, deployment))`,
+						`sum(increase(response_total{direction="inbound", namespace="emojivoto"}[1m])) by (namespace, deployment, classification, tls)`,
+					},
+				},
+				req: pb.StatTimeSeriesRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Deployment,
+						},
+					},
+					Range: "1h",
+					Step:  "1m",
+				},
+				expectedResponse: pb.StatTimeSeriesResponse{
+					Response: &pb.StatTimeSeriesResponse_Ok_{
+						Ok: &pb.StatTimeSeriesResponse_Ok{
+							Series: []*pb.StatTimeSeries{
+								{
+									Resource: &pb.Resource{
+										Namespace: "emojivoto",
+										Type:      pkgK8s.Deployment,
+										Name:      "emoji",
+									},
+									TimeWindow: "1m",
+									Points:     []*pb.StatTimeSeries_Point{genTimeSeriesPoint(1000), genTimeSeriesPoint(2000)},
+								},
+								{
+									Resource: &pb.Resource{
+										Namespace: "emojivoto",
+										Type:      pkgK8s.Deployment,
+										Name:      "web",
+									},
+									TimeWindow: "1m",
+									Points:     []*pb.StatTimeSeries_Point{genTimeSeriesPoint(1000)},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		testStatTimeSeries(t, expectations)
+	})
+
+	t.Run("Given an invalid request, returns an error response without querying Prometheus", func(t *testing.T) {
+		selector := &pb.ResourceSelection{
+			Resource: &pb.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Deployment,
+			},
+		}
+		errorResponse := func(resource *pb.Resource, message string) pb.StatTimeSeriesResponse {
+			return pb.StatTimeSeriesResponse{
+				Response: &pb.StatTimeSeriesResponse_Error{
+					Error: &pb.ResourceError{
+						Resource: resource,
+						Error:    message,
+					},
+				},
+			}
+		}
+
+		expectations := []statTimeSeriesExpected{
+			{
+				expectedStatRPC:  expectedStatRPC{expectedPrometheusQueries: []string{}},
+				req:              pb.StatTimeSeriesRequest{Range: "1h", Step: "1m"},
+				expectedResponse: errorResponse(nil, "StatTimeSeries request missing Selector Resource"),
+			},
+			{
+				expectedStatRPC: expectedStatRPC{expectedPrometheusQueries: []string{}},
+				req: pb.StatTimeSeriesRequest{
+					Selector: &pb.ResourceSelection{Resource: &pb.Resource{Type: pkgK8s.All}},
+					Range:    "1h",
+					Step:     "1m",
+				},
+				expectedResponse: errorResponse(&pb.Resource{Type: pkgK8s.All}, "resource type 'all' is not supported for time series"),
+			},
+			{
+				expectedStatRPC:  expectedStatRPC{expectedPrometheusQueries: []string{}},
+				req:              pb.StatTimeSeriesRequest{Selector: selector, Range: "1h", Step: "0s"},
+				expectedResponse: errorResponse(selector.Resource, "step must be positive and no longer than range"),
+			},
+			{
+				expectedStatRPC:  expectedStatRPC{expectedPrometheusQueries: []string{}},
+				req:              pb.StatTimeSeriesRequest{Selector: selector, Range: "1m", Step: "1h"},
+				expectedResponse: errorResponse(selector.Resource, "step must be positive and no longer than range"),
+			},
+			{
+				expectedStatRPC:  expectedStatRPC{expectedPrometheusQueries: []string{}},
+				req:              pb.StatTimeSeriesRequest{Selector: selector, Range: "24h", Step: "1s"},
+				expectedResponse: errorResponse(selector.Resource, "range [24h] and step [1s] exceed the maximum of 11000 points per series"),
+			},
+		}
+
+		testStatTimeSeries(t, expectations)
+	})
+}
@@ -6,32 +6,32 @@ import (
 	"io"
 	"reflect"
 	"sort"
-	"sync"
-	"time"
 
 	"github.com/linkerd/linkerd2/controller/api/discovery"
 	healthcheckPb "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 	configPb "github.com/linkerd/linkerd2/controller/gen/config"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
-	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"google.golang.org/grpc"
 )
 
 // MockAPIClient satisfies the Public API's gRPC interfaces (public.APIClient).
 type MockAPIClient struct {
-	ErrorToReturn                  error
-	VersionInfoToReturn            *pb.VersionInfo
-	ListPodsResponseToReturn       *pb.ListPodsResponse
-	ListServicesResponseToReturn   *pb.ListServicesResponse
-	StatSummaryResponseToReturn    *pb.StatSummaryResponse
-	TopRoutesResponseToReturn      *pb.TopRoutesResponse
-	EdgesResponseToReturn          *pb.EdgesResponse
-	SelfCheckResponseToReturn      *healthcheckPb.SelfCheckResponse
-	ConfigResponseToReturn         *configPb.All
-	APITapClientToReturn           pb.Api_TapClient
-	APITapByResourceClientToReturn pb.Api_TapByResourceClient
+	ErrorToReturn                     error
+	VersionInfoToReturn               *pb.VersionInfo
+	ListPodsResponseToReturn          *pb.ListPodsResponse
+	ListServicesResponseToReturn      *pb.ListServicesResponse
+	StatSummaryResponseToReturn       *pb.StatSummaryResponse
+	StatTimeSeriesResponseToReturn    *pb.StatTimeSeriesResponse
+	TopRoutesResponseToReturn         *pb.TopRoutesResponse
+	SLOStatusResponseToReturn         *pb.SLOStatusResponse
+	EdgesResponseToReturn             *pb.EdgesResponse
+	SelfCheckResponseToReturn         *healthcheckPb.SelfCheckResponse
+	ConfigResponseToReturn            *configPb.All
+	APITapClientToReturn              pb.Api_TapClient
+	APITapByResourceClientToReturn    pb.Api_TapByResourceClient
+	APIWatchStatSummaryClientToReturn pb.Api_WatchStatSummaryClient
 	*discovery.MockDiscoveryClient
 }
 
@@ -40,11 +40,26 @@ func (c *MockAPIClient) StatSummary(ctx context.Context, in *pb.StatSummaryReque
 	return c.StatSummaryResponseToReturn, c.ErrorToReturn
 }
 
+// WatchStatSummary provides a mock of a Public API method.
+func (c *MockAPIClient) WatchStatSummary(ctx context.Context, in *pb.WatchStatSummaryRequest, opts ...grpc.CallOption) (pb.Api_WatchStatSummaryClient, error) {
+	return c.APIWatchStatSummaryClientToReturn, c.ErrorToReturn
+}
+
+// StatTimeSeries provides a mock of a Public API method.
+func (c *MockAPIClient) StatTimeSeries(ctx context.Context, in *pb.StatTimeSeriesRequest, opts ...grpc.CallOption) (*pb.StatTimeSeriesResponse, error) {
+	return c.StatTimeSeriesResponseToReturn, c.ErrorToReturn
+}
+
 // TopRoutes provides a mock of a Public API method.
 func (c *MockAPIClient) TopRoutes(ctx context.Context, in *pb.TopRoutesRequest, opts ...grpc.CallOption) (*pb.TopRoutesResponse, error) {
 	return c.TopRoutesResponseToReturn, c.ErrorToReturn
 }
 
+// SLOStatus provides a mock of a Public API method.
+func (c *MockAPIClient) SLOStatus(ctx context.Context, in *pb.SLOStatusRequest, opts ...grpc.CallOption) (*pb.SLOStatusResponse, error) {
+	return c.SLOStatusResponseToReturn, c.ErrorToReturn
+}
+
 // Edges provides a mock of a Public API method.
 func (c *MockAPIClient) Edges(ctx context.Context, in *pb.EdgesRequest, opts ...grpc.CallOption) (*pb.EdgesResponse, error) {
 	return c.EdgesResponseToReturn, c.ErrorToReturn
@@ -109,14 +124,21 @@ func (a *MockAPITapByResourceClient) Recv() (*pb.TapEvent, error) {
 	return &eventPopped, errorPopped
 }
 
-//
-// Prometheus client
-//
+// MockAPIWatchStatSummaryClient satisfies the WatchStatSummaryClient gRPC
+// interface.
+type MockAPIWatchStatSummaryClient struct {
+	StatSummaryResponsesToReturn []*pb.StatSummaryResponse
+	grpc.ClientStream
+}
 
-type mockProm struct {
-	Res             model.Value
-	QueriesExecuted []string // expose the queries our Mock Prometheus receives, to test query generation
-	rwLock          sync.Mutex
+// Recv satisfies the WatchStatSummaryClient.Recv() gRPC method.
+func (a *MockAPIWatchStatSummaryClient) Recv() (*pb.StatSummaryResponse, error) {
+	if len(a.StatSummaryResponsesToReturn) == 0 {
+		return nil, io.EOF
+	}
+	var rsp *pb.StatSummaryResponse
+	rsp, a.StatSummaryResponsesToReturn = a.StatSummaryResponsesToReturn[0], a.StatSummaryResponsesToReturn[1:]
+	return rsp, nil
 }
 
 // PodCounts is a test helper struct that is used for representing data in a
@@ -127,47 +149,6 @@ type PodCounts struct {
 	FailedPods  uint64
 }
 
-func (m *mockProm) Query(ctx context.Context, query string, ts time.Time) (model.Value, error) {
-	m.rwLock.Lock()
-	defer m.rwLock.Unlock()
-	m.QueriesExecuted = append(m.QueriesExecuted, query)
-	return m.Res, nil
-}
-func (m *mockProm) QueryRange(ctx context.Context, query string, r promv1.Range) (model.Value, error) {
-	m.rwLock.Lock()
-	defer m.rwLock.Unlock()
-	m.QueriesExecuted = append(m.QueriesExecuted, query)
-	return m.Res, nil
-}
-
-func (m *mockProm) AlertManagers(ctx context.Context) (promv1.AlertManagersResult, error) {
-	return promv1.AlertManagersResult{}, nil
-}
-func (m *mockProm) CleanTombstones(ctx context.Context) error {
-	return nil
-}
-func (m *mockProm) Config(ctx context.Context) (promv1.ConfigResult, error) {
-	return promv1.ConfigResult{}, nil
-}
-func (m *mockProm) DeleteSeries(ctx context.Context, matches []string, startTime time.Time, endTime time.Time) error {
-	return nil
-}
-func (m *mockProm) Flags(ctx context.Context) (promv1.FlagsResult, error) {
-	return promv1.FlagsResult{}, nil
-}
-func (m *mockProm) LabelValues(ctx context.Context, label string) (model.LabelValues, error) {
-	return nil, nil
-}
-func (m *mockProm) Series(ctx context.Context, matches []string, startTime time.Time, endTime time.Time) ([]model.LabelSet, error) {
-	return nil, nil
-}
-func (m *mockProm) Snapshot(ctx context.Context, skipHead bool) (promv1.SnapshotResult, error) {
-	return promv1.SnapshotResult{}, nil
-}
-func (m *mockProm) Targets(ctx context.Context) (promv1.TargetsResult, error) {
-	return promv1.TargetsResult{}, nil
-}
-
 // GenStatSummaryResponse generates a mock Public API StatSummaryResponse
 // object.
 func GenStatSummaryResponse(resName, resType string, resNs []string, counts *PodCounts, basicStats bool, tcpStats bool) pb.StatSummaryResponse {
@@ -228,8 +209,47 @@ func GenStatSummaryResponse(resName, resType string, resNs []string, counts *Pod
 	return resp
 }
 
-// GenEdgesResponse generates a mock Public API StatSummaryResponse
-// object.
+// GenStatTimeSeriesResponse generates a mock Public API
+// StatTimeSeriesResponse object, with one point per entry in successCounts.
+// Points are a minute apart and each one fails a single request.
+func GenStatTimeSeriesResponse(resName, resType string, resNs []string, successCounts []uint64) pb.StatTimeSeriesResponse {
+	series := []*pb.StatTimeSeries{}
+	for _, ns := range resNs {
+		s := &pb.StatTimeSeries{
+			Resource: &pb.Resource{
+				Namespace: ns,
+				Type:      resType,
+				Name:      resName,
+			},
+			TimeWindow: "1m",
+		}
+
+		for i, count := range successCounts {
+			s.Points = append(s.Points, &pb.StatTimeSeries_Point{
+				TimestampMs: int64(i) * 60000,
+				Stats: &pb.BasicStats{
+					SuccessCount: count,
+					FailureCount: 1,
+					LatencyMsP50: count,
+					LatencyMsP95: 2 * count,
+					LatencyMsP99: 3 * count,
+				},
+			})
+		}
+
+		series = append(series, s)
+	}
+
+	return pb.StatTimeSeriesResponse{
+		Response: &pb.StatTimeSeriesResponse_Ok_{
+			Ok: &pb.StatTimeSeriesResponse_Ok{
+				Series: series,
+			},
+		},
+	}
+}
+
+// GenEdgesResponse generates a mock Public API EdgesResponse object.
 func GenEdgesResponse(resourceType string, resSrc, resDst, resClient, resServer, msg []string) pb.EdgesResponse {
 	edges := []*pb.Edge{}
 	for i := range resSrc {
@@ -245,6 +265,15 @@ func GenEdgesResponse(resourceType string, resSrc, resDst, resClient, resServer,
 			ClientId:      resClient[i],
 			ServerId:      resServer[i],
 			NoIdentityMsg: msg[i],
+			Stats: &pb.BasicStats{
+				SuccessCount: 90,
+				FailureCount: 10,
+				LatencyMsP50: 123,
+				LatencyMsP95: 123,
+				LatencyMsP99: 123,
+			},
+			TimeWindow: "1m",
+			Protocol:   "http",
 		}
 		edges = append(edges, edge)
 	}
@@ -320,13 +349,13 @@ type expectedStatRPC struct {
 	expectedPrometheusQueries []string    // queries we expect public-api to issue to prometheus
 }
 
-func newMockGrpcServer(exp expectedStatRPC) (*mockProm, *grpcServer, error) {
+func newMockGrpcServer(exp expectedStatRPC) (*InMemoryMetricsBackend, *grpcServer, error) {
 	k8sAPI, err := k8s.NewFakeAPI(exp.k8sConfigs...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	mockProm := &mockProm{Res: exp.mockPromResponse}
+	mockProm := &InMemoryMetricsBackend{Res: exp.mockPromResponse}
 	fakeGrpcServer := newGrpcServer(
 		mockProm,
 		nil,
@@ -341,7 +370,7 @@ func newMockGrpcServer(exp expectedStatRPC) (*mockProm, *grpcServer, error) {
 	return mockProm, fakeGrpcServer, nil
 }
 
-func (exp expectedStatRPC) verifyPromQueries(mockProm *mockProm) error {
+func (exp expectedStatRPC) verifyPromQueries(mockProm *InMemoryMetricsBackend) error {
 	// if exp.expectedPrometheusQueries is an empty slice we still wanna check no queries were executed.
 	if exp.expectedPrometheusQueries != nil {
 		sort.Strings(exp.expectedPrometheusQueries)
@@ -7,6 +7,8 @@ import (
 	"sort"
 	"strings"
 
+	tsv1alpha1 "github.com/deislabs/smi-sdk-go/pkg/apis/split/v1alpha1"
+	"github.com/linkerd/linkerd2/controller/api/util"
 	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha1"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	api "github.com/linkerd/linkerd2/controller/k8s"
@@ -21,6 +23,7 @@ const (
 	routeReqQuery             = "sum(increase(route_response_total%s[%s])) by (%s, dst, classification)"
 	actualRouteReqQuery       = "sum(increase(route_actual_response_total%s[%s])) by (%s, dst, classification)"
 	routeLatencyQuantileQuery = "histogram_quantile(%s, sum(irate(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s))"
+	routeLatencyBucketsQuery  = "sum(increase(route_response_latency_ms_bucket%s[%s])) by (le, dst, %s)"
 	dstLabel                  = `dst=~"(%s)(:\\d+)?"`
 	// DefaultRouteName is the name to display for requests that don't match any routes.
 	DefaultRouteName = "[DEFAULT]"
@@ -60,8 +63,13 @@ func (s *grpcServer) TopRoutes(ctx context.Context, req *pb.TopRoutesRequest) (*
 		return topRoutesError(req, "Authority cannot be the target of a routes query; try using an authority in the --to flag instead"), nil
 	}
 
+	selector, err := util.LabelSelectorFor(req.GetSelector())
+	if err != nil {
+		return topRoutesError(req, err.Error()), nil
+	}
+
 	// Non-authority resource
-	objects, err := s.k8sAPI.GetObjects(targetResource.Namespace, targetResource.Type, targetResource.Name)
+	objects, err := s.k8sAPI.GetObjectsMatching(targetResource.Namespace, targetResource.Type, targetResource.Name, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +134,19 @@ func (s *grpcServer) topRoutesFor(ctx context.Context, req *pb.TopRoutesRequest,
 
 	profiles := make(map[string]*sp.ServiceProfile)
 
-	if requestedResource.GetType() == k8s.Authority {
+	if typ == k8s.TrafficSplit {
+		// The routes of a TrafficSplit are those of its apex service, which
+		// clients address before their requests are split across the leaves.
+		split, ok := object.(*tsv1alpha1.TrafficSplit)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", object)
+		}
+		svc, err := s.k8sAPI.Svc().Lister().Services(split.Namespace).Get(split.Spec.Service)
+		if err != nil {
+			return nil, err
+		}
+		profiles[svc.GetName()] = s.k8sAPI.GetServiceProfileFor(svc, clientNs)
+	} else if requestedResource.GetType() == k8s.Authority {
 		// Authorities may not be a source, so we know this is a ToResource.
 		profiles, err = s.getProfilesForAuthority(requestedResource.GetName(), clientNs)
 		if err != nil {
@@ -184,9 +204,12 @@ func validateRequest(req *pb.TopRoutesRequest) *pb.TopRoutesResponse {
 	if req.GetNone() == nil {
 		// This is an outbound (--to) request.
 		targetType := req.GetSelector().GetResource().GetType()
-		if targetType == k8s.Service || targetType == k8s.Authority {
+		if targetType == k8s.Service || targetType == k8s.Authority || targetType == k8s.TrafficSplit {
 			return topRoutesError(req, fmt.Sprintf("The %s resource type is not supported with 'to' queries", targetType))
 		}
+		if req.GetToResource().GetType() == k8s.TrafficSplit {
+			return topRoutesError(req, fmt.Sprintf("The %s resource type is not supported as a 'to' resource", k8s.TrafficSplit))
+		}
 	}
 	return nil
 }
@@ -240,6 +263,9 @@ func (s *grpcServer) getRouteMetrics(ctx context.Context, req *pb.TopRoutesReque
 		// If this req has an Outbound, then query the actual request counts as well.
 		queries[promActualRequests] = actualRouteReqQuery
 	}
+	if req.LatencyHistogram {
+		queries[promLatencyBuckets] = routeLatencyBucketsQuery
+	}
 
 	results, err := s.getPrometheusMetrics(ctx, queries, routeLatencyQuantileQuery, reqLabels, timeWindow, groupBy)
 	if err != nil {
@@ -287,6 +313,13 @@ func (s *grpcServer) buildRouteLabels(req *pb.TopRoutesRequest, dsts []string, r
 		return renderLabels(labels, dsts)
 
 	default:
+		if resource.GetType() == k8s.TrafficSplit {
+			// requests to the apex service of a split, from clients in any
+			// namespace
+			labels = labels.Merge(promDirectionLabels("outbound"))
+			return renderLabels(labels, dsts)
+		}
+
 		labels = labels.Merge(promDirectionLabels("inbound"))
 		labels = labels.Merge(promQueryLabels(resource))
 		return renderLabels(labels, dsts)
@@ -343,6 +376,8 @@ func processRouteMetrics(results []promResult, timeWindow string, table indexedT
 				table[key].Stats.LatencyMsP95 = value
 			case promLatencyP99:
 				table[key].Stats.LatencyMsP99 = value
+			case promLatencyBuckets:
+				addLatencyBucket(table[key].Stats, sample)
 			}
 		}
 	}
@@ -100,6 +100,20 @@ spec:
           storage: 10Gi
 `
 
+// trafficsplit/books-split
+var booksTrafficSplitConfig = `apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: books-split
+  namespace: default
+spec:
+  service: books
+  backends:
+  - service: books-v1
+    weight: 500m
+  - service: books-v2
+    weight: 500m`
+
 var booksServiceConfig = []string{
 	// service/books
 	`apiVersion: v1
@@ -265,6 +279,45 @@ func TestTopRoutes(t *testing.T) {
 		testTopRoutes(t, expectations)
 	})
 
+	t.Run("Queries prometheus for the latency histogram when requested", func(t *testing.T) {
+		routes := []string{"/a"}
+		counts := []uint64{123}
+		expectations := []topRoutesExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err:              nil,
+					mockPromResponse: routesMetric([]string{"/a"}),
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.95, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route))`,
+						`sum(increase(route_response_latency_ms_bucket{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (le, dst, rt_route)`,
+						`sum(increase(route_response_total{deployment="books", direction="inbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?", namespace="default"}[1m])) by (rt_route, dst, classification)`,
+					},
+					k8sConfigs: booksConfig,
+				},
+				req: pb.TopRoutesRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "default",
+							Type:      pkgK8s.Deployment,
+							Name:      "books",
+						},
+					},
+					TimeWindow: "1m",
+					Outbound: &pb.TopRoutesRequest_None{
+						None: &pb.Empty{},
+					},
+					LatencyHistogram: true,
+				},
+				// the mock response has no `le` label, so no buckets are added
+				expectedResponse: GenTopRoutesResponse(routes, counts, false, "books"),
+			},
+		}
+
+		testTopRoutes(t, expectations)
+	})
+
 	t.Run("Successfully performs a routes query for a service", func(t *testing.T) {
 		routes := []string{"/a"}
 		counts := []uint64{123}
@@ -301,6 +354,42 @@ func TestTopRoutes(t *testing.T) {
 		testTopRoutes(t, expectations)
 	})
 
+	t.Run("Successfully performs a routes query for a trafficsplit", func(t *testing.T) {
+		routes := []string{"/a"}
+		counts := []uint64{123}
+		expectations := []topRoutesExpected{
+			{
+				expectedStatRPC: expectedStatRPC{
+					err:              nil,
+					mockPromResponse: routesMetric([]string{"/a"}),
+					expectedPrometheusQueries: []string{
+						`histogram_quantile(0.5, sum(irate(route_response_latency_ms_bucket{direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.95, sum(irate(route_response_latency_ms_bucket{direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?"}[1m])) by (le, dst, rt_route))`,
+						`histogram_quantile(0.99, sum(irate(route_response_latency_ms_bucket{direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?"}[1m])) by (le, dst, rt_route))`,
+						`sum(increase(route_response_total{direction="outbound", dst=~"(books.default.svc.cluster.local)(:\\d+)?"}[1m])) by (rt_route, dst, classification)`,
+					},
+					k8sConfigs: append(booksServiceConfig, booksTrafficSplitConfig),
+				},
+				req: pb.TopRoutesRequest{
+					Selector: &pb.ResourceSelection{
+						Resource: &pb.Resource{
+							Namespace: "default",
+							Type:      pkgK8s.TrafficSplit,
+							Name:      "books-split",
+						},
+					},
+					TimeWindow: "1m",
+					Outbound: &pb.TopRoutesRequest_None{
+						None: &pb.Empty{},
+					},
+				},
+				expectedResponse: GenTopRoutesResponse(routes, counts, false, "books"),
+			},
+		}
+
+		testTopRoutes(t, expectations)
+	})
+
 	t.Run("Successfully performs a routes query for a daemonset", func(t *testing.T) {
 		routes := []string{"/a"}
 		counts := []uint64{123}
@@ -0,0 +1,180 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultWatchInterval = 10 * time.Second
+	minWatchInterval     = time.Second
+)
+
+type statSummaryResult struct {
+	rsp *pb.StatSummaryResponse
+	err error
+}
+
+// statSummaryWatch polls StatSummary for one request, on behalf of every
+// watcher of that request.
+type statSummaryWatch struct {
+	sync.Mutex
+	subscribers map[chan statSummaryResult]struct{}
+	latest      *statSummaryResult
+	cancel      context.CancelFunc
+}
+
+// statSummaryWatches keeps one statSummaryWatch per distinct watch request,
+// so that watchers of the same selector, window and interval share a single
+// series of backend queries.
+type statSummaryWatches struct {
+	sync.Mutex
+	watches map[string]*statSummaryWatch
+}
+
+func newStatSummaryWatches() *statSummaryWatches {
+	return &statSummaryWatches{
+		watches: make(map[string]*statSummaryWatch),
+	}
+}
+
+// subscribe returns a channel that receives the result of every poll of the
+// watch for key, starting a new watch that calls poll every interval if there
+// is none. The channel only holds the latest result, so slow watchers skip
+// stale tables rather than holding up the others. The returned function must
+// be called once the caller stops watching.
+func (ws *statSummaryWatches) subscribe(
+	key string,
+	interval time.Duration,
+	poll func(context.Context) (*pb.StatSummaryResponse, error),
+) (<-chan statSummaryResult, func()) {
+	ws.Lock()
+	defer ws.Unlock()
+
+	w, ok := ws.watches[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		w = &statSummaryWatch{
+			subscribers: make(map[chan statSummaryResult]struct{}),
+			cancel:      cancel,
+		}
+		ws.watches[key] = w
+		go w.run(ctx, interval, poll)
+	}
+
+	updates := make(chan statSummaryResult, 1)
+	w.Lock()
+	w.subscribers[updates] = struct{}{}
+	if w.latest != nil {
+		updates <- *w.latest
+	}
+	w.Unlock()
+
+	unsubscribe := func() {
+		ws.Lock()
+		defer ws.Unlock()
+
+		w.Lock()
+		delete(w.subscribers, updates)
+		remaining := len(w.subscribers)
+		w.Unlock()
+
+		if remaining == 0 {
+			w.cancel()
+			delete(ws.watches, key)
+		}
+	}
+
+	return updates, unsubscribe
+}
+
+func (w *statSummaryWatch) run(ctx context.Context, interval time.Duration, poll func(context.Context) (*pb.StatSummaryResponse, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		rsp, err := poll(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		w.publish(statSummaryResult{rsp: rsp, err: err})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *statSummaryWatch) publish(result statSummaryResult) {
+	w.Lock()
+	defer w.Unlock()
+
+	w.latest = &result
+	for updates := range w.subscribers {
+		select {
+		case updates <- result:
+		default:
+			// replace the result the watcher has not picked up yet
+			select {
+			case <-updates:
+			default:
+			}
+			updates <- result
+		}
+	}
+}
+
+func (s *grpcServer) WatchStatSummary(req *pb.WatchStatSummaryRequest, stream pb.Api_WatchStatSummaryServer) error {
+	log.Debugf("WatchStatSummary request: %+v", req)
+
+	if req.GetRequest() == nil {
+		return status.Error(codes.InvalidArgument, "WatchStatSummary received nil StatSummaryRequest")
+	}
+
+	interval := defaultWatchInterval
+	if req.GetInterval() != "" {
+		var err error
+		interval, err = time.ParseDuration(req.GetInterval())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid watch interval \"%s\": %s", req.GetInterval(), err)
+		}
+		if interval < minWatchInterval {
+			return status.Errorf(codes.InvalidArgument, "watch interval must be at least %s", minWatchInterval)
+		}
+	}
+
+	key := fmt.Sprintf("%s/%s", proto.CompactTextString(req.GetRequest()), interval)
+	updates, unsubscribe := s.statWatches.subscribe(key, interval, func(ctx context.Context) (*pb.StatSummaryResponse, error) {
+		return s.StatSummary(ctx, req.GetRequest())
+	})
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case result := <-updates:
+			rsp := result.rsp
+			if result.err != nil {
+				// a failed poll, such as a transient Prometheus error, is
+				// reported to the watcher in place of the table, and the
+				// watch carries on polling
+				log.Errorf("WatchStatSummary poll failed: %s", result.err)
+				rsp = statSummaryError(req.GetRequest(), result.err.Error())
+			}
+			if err := stream.Send(rsp); err != nil {
+				return err
+			}
+		}
+	}
+}
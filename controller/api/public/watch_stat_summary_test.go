@@ -0,0 +1,158 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatSummaryWatches(t *testing.T) {
+	t.Run("Shares one poll between watchers of the same request", func(t *testing.T) {
+		var polls int32
+		release := make(chan struct{})
+		poll := func(ctx context.Context) (*pb.StatSummaryResponse, error) {
+			<-release
+			atomic.AddInt32(&polls, 1)
+			return &pb.StatSummaryResponse{}, nil
+		}
+
+		watches := newStatSummaryWatches()
+		first, unsubscribeFirst := watches.subscribe("key", time.Hour, poll)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := watches.subscribe("key", time.Hour, poll)
+		defer unsubscribeSecond()
+		close(release)
+
+		for _, updates := range []<-chan statSummaryResult{first, second} {
+			select {
+			case result := <-updates:
+				if result.err != nil {
+					t.Fatalf("Unexpected error: %s", result.err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("Timed out waiting for a stat summary")
+			}
+		}
+
+		if n := atomic.LoadInt32(&polls); n != 1 {
+			t.Fatalf("Expected 1 poll, got %d", n)
+		}
+	})
+
+	t.Run("Stops polling once the last watcher unsubscribes", func(t *testing.T) {
+		done := make(chan struct{})
+		poll := func(ctx context.Context) (*pb.StatSummaryResponse, error) {
+			go func() {
+				<-ctx.Done()
+				close(done)
+			}()
+			return &pb.StatSummaryResponse{}, nil
+		}
+
+		watches := newStatSummaryWatches()
+		updates, unsubscribe := watches.subscribe("key", time.Hour, poll)
+		<-updates
+		unsubscribe()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for the watch to stop")
+		}
+		if len(watches.watches) != 0 {
+			t.Fatalf("Expected no watches, got %d", len(watches.watches))
+		}
+	})
+}
+
+// mockWatchStatSummaryServer hands every response it is sent to rsps.
+type mockWatchStatSummaryServer struct {
+	pb.Api_WatchStatSummaryServer
+	ctx  context.Context
+	rsps chan *pb.StatSummaryResponse
+}
+
+func (m *mockWatchStatSummaryServer) Context() context.Context { return m.ctx }
+
+func (m *mockWatchStatSummaryServer) Send(rsp *pb.StatSummaryResponse) error {
+	m.rsps <- rsp
+	return nil
+}
+
+func TestWatchStatSummary(t *testing.T) {
+	t.Run("Reports failed polls to watchers and keeps watching", func(t *testing.T) {
+		mockProm, server, err := newMockGrpcServer(expectedStatRPC{
+			k8sConfigs: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: linkerd
+status:
+  phase: Running
+`,
+			},
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		mockProm.Err = errors.New("prometheus unavailable")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &mockWatchStatSummaryServer{ctx: ctx, rsps: make(chan *pb.StatSummaryResponse, 1)}
+		req := &pb.WatchStatSummaryRequest{
+			Request: &pb.StatSummaryRequest{
+				Selector: &pb.ResourceSelection{
+					Resource: &pb.Resource{Namespace: "emojivoto", Type: pkgK8s.Pod},
+				},
+				TimeWindow: "1m",
+			},
+		}
+		done := make(chan error)
+		go func() {
+			done <- server.WatchStatSummary(req, stream)
+		}()
+
+		select {
+		case rsp := <-stream.rsps:
+			if rsp.GetError() == nil {
+				t.Fatalf("Expected an error response, got %+v", rsp)
+			}
+		case err := <-done:
+			t.Fatalf("Expected the watch to carry on, got [%v]", err)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for a stat summary")
+		}
+
+		cancel()
+		if err := <-done; err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Rejects invalid requests", func(t *testing.T) {
+		server := &grpcServer{statWatches: newStatSummaryWatches()}
+
+		requests := []*pb.WatchStatSummaryRequest{
+			{},
+			{Request: &pb.StatSummaryRequest{}, Interval: "soon"},
+			{Request: &pb.StatSummaryRequest{}, Interval: "100ms"},
+		}
+
+		for _, req := range requests {
+			err := server.WatchStatSummary(req, nil)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("Expected InvalidArgument error for %+v, got [%v]", req, err)
+			}
+		}
+	})
+}
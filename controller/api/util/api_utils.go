@@ -3,9 +3,11 @@ package util
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"google.golang.org/grpc/codes"
@@ -20,7 +22,9 @@ import (
 */
 
 var (
-	defaultMetricTimeWindow = "1m"
+	defaultMetricTimeWindow      = "1m"
+	defaultMetricTimeSeriesRange = "1h"
+	defaultMetricTimeSeriesStep  = "1m"
 
 	// ValidTargets specifies resource types allowed as a target:
 	// target resource on an inbound query
@@ -54,32 +58,46 @@ var (
 // StatsBaseRequestParams contains parameters that are used to build requests
 // for metrics data.  This includes requests to StatSummary and TopRoutes.
 type StatsBaseRequestParams struct {
-	TimeWindow    string
-	Namespace     string
-	ResourceType  string
-	ResourceName  string
-	AllNamespaces bool
+	TimeWindow       string
+	Namespace        string
+	ResourceType     string
+	ResourceName     string
+	AllNamespaces    bool
+	LatencyHistogram bool
+	LabelSelector    string
 }
 
 // StatsSummaryRequestParams contains parameters that are used to build
 // StatSummary requests.
 type StatsSummaryRequestParams struct {
 	StatsBaseRequestParams
-	ToNamespace   string
-	ToType        string
-	ToName        string
-	FromNamespace string
-	FromType      string
-	FromName      string
-	SkipStats     bool
-	TCPStats      bool
+	ToNamespace     string
+	ToType          string
+	ToName          string
+	FromNamespace   string
+	FromType        string
+	FromName        string
+	SkipStats       bool
+	TCPStats        bool
+	StatusBreakdown bool
+}
+
+// StatTimeSeriesRequestParams contains parameters that are used to build
+// StatTimeSeries requests.
+type StatTimeSeriesRequestParams struct {
+	StatsSummaryRequestParams
+	Range string
+	Step  string
 }
 
 // EdgesRequestParams contains parameters that are used to build
 // Edges requests.
 type EdgesRequestParams struct {
-	Namespace    string
-	ResourceType string
+	Namespace     string
+	ResourceType  string
+	TimeWindow    string
+	AllNamespaces bool
+	LabelSelector string
 }
 
 // TopRoutesRequestParams contains parameters that are used to build TopRoutes
@@ -94,15 +112,21 @@ type TopRoutesRequestParams struct {
 // TapRequestParams contains parameters that are used to build a
 // TapByResourceRequest.
 type TapRequestParams struct {
-	Resource    string
-	Namespace   string
-	ToResource  string
-	ToNamespace string
-	MaxRps      float32
-	Scheme      string
-	Method      string
-	Authority   string
-	Path        string
+	Resource       string
+	Resources      []string
+	Namespace      string
+	LabelSelector  string
+	ToResource     string
+	ToNamespace    string
+	MaxRps         float32
+	Scheme         string
+	Method         string
+	Authority      string
+	Path           string
+	Status         string
+	GrpcStatus     string
+	SlowerThan     time.Duration
+	ReportSampling bool
 }
 
 // GRPCError generates a gRPC error code, as defined in
@@ -165,6 +189,11 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 		return nil, err
 	}
 
+	labelSelector, err := BuildLabelSelector(p.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	statRequest := &pb.StatSummaryRequest{
 		Selector: &pb.ResourceSelection{
 			Resource: &pb.Resource{
@@ -172,10 +201,13 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 				Name:      p.ResourceName,
 				Type:      resourceType,
 			},
+			Labels: labelSelector,
 		},
-		TimeWindow: window,
-		SkipStats:  p.SkipStats,
-		TcpStats:   p.TCPStats,
+		TimeWindow:       window,
+		SkipStats:        p.SkipStats,
+		TcpStats:         p.TCPStats,
+		LatencyHistogram: p.LatencyHistogram,
+		StatusBreakdown:  p.StatusBreakdown,
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
@@ -227,12 +259,70 @@ func BuildStatSummaryRequest(p StatsSummaryRequestParams) (*pb.StatSummaryReques
 	return statRequest, nil
 }
 
+// BuildStatTimeSeriesRequest builds a Public API StatTimeSeriesRequest from a
+// StatTimeSeriesRequestParams. Unless a time window is given, each point in
+// the series aggregates over one step.
+func BuildStatTimeSeriesRequest(p StatTimeSeriesRequestParams) (*pb.StatTimeSeriesRequest, error) {
+	queryRange := defaultMetricTimeSeriesRange
+	if p.Range != "" {
+		_, err := time.ParseDuration(p.Range)
+		if err != nil {
+			return nil, err
+		}
+		queryRange = p.Range
+	}
+
+	step := defaultMetricTimeSeriesStep
+	if p.Step != "" {
+		_, err := time.ParseDuration(p.Step)
+		if err != nil {
+			return nil, err
+		}
+		step = p.Step
+	}
+
+	if p.TimeWindow == "" {
+		p.TimeWindow = step
+	}
+
+	statRequest, err := BuildStatSummaryRequest(p.StatsSummaryRequestParams)
+	if err != nil {
+		return nil, err
+	}
+
+	timeSeriesRequest := &pb.StatTimeSeriesRequest{
+		Selector:   statRequest.Selector,
+		TimeWindow: statRequest.TimeWindow,
+		Range:      queryRange,
+		Step:       step,
+	}
+
+	switch out := statRequest.Outbound.(type) {
+	case *pb.StatSummaryRequest_ToResource:
+		timeSeriesRequest.Outbound = &pb.StatTimeSeriesRequest_ToResource{ToResource: out.ToResource}
+	case *pb.StatSummaryRequest_FromResource:
+		timeSeriesRequest.Outbound = &pb.StatTimeSeriesRequest_FromResource{FromResource: out.FromResource}
+	}
+
+	return timeSeriesRequest, nil
+}
+
 // BuildEdgesRequest builds a Public API EdgesRequest from a
 // EdgesRequestParams.
 func BuildEdgesRequest(p EdgesRequestParams) (*pb.EdgesRequest, error) {
+	window := defaultMetricTimeWindow
+	if p.TimeWindow != "" {
+		_, err := time.ParseDuration(p.TimeWindow)
+		if err != nil {
+			return nil, err
+		}
+		window = p.TimeWindow
+	}
 
 	namespace := p.Namespace
-	if p.Namespace == "" {
+	if p.AllNamespaces {
+		namespace = ""
+	} else if p.Namespace == "" {
 		namespace = corev1.NamespaceDefault
 	}
 
@@ -241,13 +331,20 @@ func BuildEdgesRequest(p EdgesRequestParams) (*pb.EdgesRequest, error) {
 		return nil, err
 	}
 
+	labelSelector, err := BuildLabelSelector(p.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	edgesRequest := &pb.EdgesRequest{
 		Selector: &pb.ResourceSelection{
 			Resource: &pb.Resource{
 				Namespace: namespace,
 				Type:      resourceType,
 			},
+			Labels: labelSelector,
 		},
+		TimeWindow: window,
 	}
 
 	return edgesRequest, nil
@@ -281,6 +378,11 @@ func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, erro
 		return nil, err
 	}
 
+	labelSelector, err := BuildLabelSelector(p.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	topRoutesRequest := &pb.TopRoutesRequest{
 		Selector: &pb.ResourceSelection{
 			Resource: &pb.Resource{
@@ -288,8 +390,10 @@ func BuildTopRoutesRequest(p TopRoutesRequestParams) (*pb.TopRoutesRequest, erro
 				Name:      p.ResourceName,
 				Type:      resourceType,
 			},
+			Labels: labelSelector,
 		},
-		TimeWindow: window,
+		TimeWindow:       window,
+		LatencyHistogram: p.LatencyHistogram,
 	}
 
 	if p.ToName != "" || p.ToType != "" || p.ToNamespace != "" {
@@ -443,6 +547,26 @@ func BuildTapByResourceRequest(params TapRequestParams) (*pb.TapByResourceReques
 		return nil, fmt.Errorf("unsupported resource type [%s]", target.Type)
 	}
 
+	labelSelector, err := BuildLabelSelector(params.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []*pb.ResourceSelection
+	for _, resource := range params.Resources {
+		target, err := BuildResource(params.Namespace, resource)
+		if err != nil {
+			return nil, fmt.Errorf("target resource invalid: %s", err)
+		}
+		if !contains(ValidTargets, target.Type) {
+			return nil, fmt.Errorf("unsupported resource type [%s]", target.Type)
+		}
+		targets = append(targets, &pb.ResourceSelection{
+			Resource: &target,
+			Labels:   labelSelector,
+		})
+	}
+
 	matches := []*pb.TapByResourceRequest_Match{}
 
 	if params.ToResource != "" {
@@ -489,11 +613,44 @@ func BuildTapByResourceRequest(params TapRequestParams) (*pb.TapByResourceReques
 		matches = append(matches, &match)
 	}
 
+	if params.Status != "" {
+		statusRange, err := parseStatusRange(params.Status)
+		if err != nil {
+			return nil, err
+		}
+		match := buildMatchResponse(&pb.TapByResourceRequest_Match_Response{
+			Match: &pb.TapByResourceRequest_Match_Response_Status{Status: statusRange},
+		})
+		matches = append(matches, &match)
+	}
+	if params.GrpcStatus != "" {
+		code, err := parseGrpcStatus(params.GrpcStatus)
+		if err != nil {
+			return nil, err
+		}
+		match := buildMatchResponse(&pb.TapByResourceRequest_Match_Response{
+			Match: &pb.TapByResourceRequest_Match_Response_GrpcStatus{GrpcStatus: uint32(code)},
+		})
+		matches = append(matches, &match)
+	}
+	if params.SlowerThan < 0 {
+		return nil, fmt.Errorf("invalid latency threshold [%s]", params.SlowerThan)
+	}
+	if params.SlowerThan > 0 {
+		match := buildMatchResponse(&pb.TapByResourceRequest_Match_Response{
+			Match: &pb.TapByResourceRequest_Match_Response_SlowerThan{SlowerThan: ptypes.DurationProto(params.SlowerThan)},
+		})
+		matches = append(matches, &match)
+	}
+
 	return &pb.TapByResourceRequest{
 		Target: &pb.ResourceSelection{
 			Resource: &target,
+			Labels:   labelSelector,
 		},
-		MaxRps: params.MaxRps,
+		Targets:        targets,
+		MaxRps:         params.MaxRps,
+		ReportSampling: params.ReportSampling,
 		Match: &pb.TapByResourceRequest_Match{
 			Match: &pb.TapByResourceRequest_Match_All{
 				All: &pb.TapByResourceRequest_Match_Seq{
@@ -512,6 +669,57 @@ func buildMatchHTTP(match *pb.TapByResourceRequest_Match_Http) pb.TapByResourceR
 	}
 }
 
+func buildMatchResponse(match *pb.TapByResourceRequest_Match_Response) pb.TapByResourceRequest_Match {
+	return pb.TapByResourceRequest_Match{
+		Match: &pb.TapByResourceRequest_Match_Response_{
+			Response: match,
+		},
+	}
+}
+
+// parseStatusRange parses an HTTP status ("404"), a class of statuses ("5xx")
+// or an inclusive range of statuses ("500-503").
+func parseStatusRange(s string) (*pb.TapByResourceRequest_Match_Response_StatusRange, error) {
+	invalid := fmt.Errorf("invalid status [%s], expected a status (404), a class of statuses (5xx) or a range (500-503)", s)
+
+	var min, max uint64
+	var err error
+	switch {
+	case len(s) == 3 && strings.HasSuffix(strings.ToLower(s), "xx"):
+		min, err = strconv.ParseUint(s[:1], 10, 32)
+		min *= 100
+		max = min + 99
+	case strings.Contains(s, "-"):
+		bounds := strings.SplitN(s, "-", 2)
+		if min, err = strconv.ParseUint(bounds[0], 10, 32); err == nil {
+			max, err = strconv.ParseUint(bounds[1], 10, 32)
+		}
+	default:
+		min, err = strconv.ParseUint(s, 10, 32)
+		max = min
+	}
+	if err != nil || min < 100 || max > 599 || min > max {
+		return nil, invalid
+	}
+
+	return &pb.TapByResourceRequest_Match_Response_StatusRange{Min: uint32(min), Max: uint32(max)}, nil
+}
+
+// parseGrpcStatus parses a gRPC status code, given by number or by name
+// (e.g. "14", "Unavailable").
+func parseGrpcStatus(s string) (codes.Code, error) {
+	if n, err := strconv.ParseUint(s, 10, 32); err == nil && n <= uint64(codes.Unauthenticated) {
+		return codes.Code(n), nil
+	}
+	name := strings.Replace(s, "_", "", -1)
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if strings.EqualFold(code.String(), name) {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid gRPC status [%s]", s)
+}
+
 func contains(list []string, s string) bool {
 	for _, elem := range list {
 		if s == elem {
@@ -4,7 +4,10 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/duration"
 	pb "github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	"google.golang.org/grpc/codes"
@@ -190,6 +193,203 @@ func TestBuildTopRoutesRequest(t *testing.T) {
 	})
 }
 
+func TestBuildStatTimeSeriesRequest(t *testing.T) {
+	t.Run("Defaults the range, step and time window", func(t *testing.T) {
+		req, err := BuildStatTimeSeriesRequest(
+			StatTimeSeriesRequestParams{
+				StatsSummaryRequestParams: StatsSummaryRequestParams{
+					StatsBaseRequestParams: StatsBaseRequestParams{
+						ResourceType: k8s.Deployment,
+					},
+				},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from BuildStatTimeSeriesRequest: %s", err)
+		}
+		if req.Range != "1h" || req.Step != "1m" || req.TimeWindow != "1m" {
+			t.Fatalf("Unexpected defaults from BuildStatTimeSeriesRequest: range [%s], step [%s], time window [%s]", req.Range, req.Step, req.TimeWindow)
+		}
+	})
+
+	t.Run("Aggregates each point over the step unless a time window is given", func(t *testing.T) {
+		expectations := map[string]string{
+			"":    "5m",
+			"30s": "30s",
+		}
+
+		for timeWindow, expected := range expectations {
+			req, err := BuildStatTimeSeriesRequest(
+				StatTimeSeriesRequestParams{
+					StatsSummaryRequestParams: StatsSummaryRequestParams{
+						StatsBaseRequestParams: StatsBaseRequestParams{
+							TimeWindow:   timeWindow,
+							ResourceType: k8s.Deployment,
+						},
+					},
+					Range: "6h",
+					Step:  "5m",
+				},
+			)
+			if err != nil {
+				t.Fatalf("Unexpected error from BuildStatTimeSeriesRequest [%s]: %s", timeWindow, err)
+			}
+			if req.TimeWindow != expected {
+				t.Fatalf("Unexpected TimeWindow from BuildStatTimeSeriesRequest [%s => %s], expected %s", timeWindow, req.TimeWindow, expected)
+			}
+		}
+	})
+
+	t.Run("Carries over the outbound resource", func(t *testing.T) {
+		req, err := BuildStatTimeSeriesRequest(
+			StatTimeSeriesRequestParams{
+				StatsSummaryRequestParams: StatsSummaryRequestParams{
+					StatsBaseRequestParams: StatsBaseRequestParams{
+						ResourceType: k8s.Deployment,
+						Namespace:    "emojivoto",
+					},
+					ToName: "web",
+				},
+			},
+		)
+		if err != nil {
+			t.Fatalf("Unexpected error from BuildStatTimeSeriesRequest: %s", err)
+		}
+		expected := &pb.Resource{Namespace: "emojivoto", Type: k8s.Deployment, Name: "web"}
+		if !reflect.DeepEqual(req.GetToResource(), expected) {
+			t.Fatalf("Unexpected ToResource from BuildStatTimeSeriesRequest: %+v, expected %+v", req.GetToResource(), expected)
+		}
+	})
+
+	t.Run("Rejects invalid ranges and steps", func(t *testing.T) {
+		for _, p := range []StatTimeSeriesRequestParams{{Range: "s"}, {Step: "s"}} {
+			p.ResourceType = k8s.Deployment
+			_, err := BuildStatTimeSeriesRequest(p)
+			if err == nil {
+				t.Fatalf("BuildStatTimeSeriesRequest(%+v) unexpectedly succeeded", p)
+			}
+		}
+	})
+}
+
+func TestBuildTapByResourceRequest(t *testing.T) {
+	t.Run("Builds response matches", func(t *testing.T) {
+		statusRange := func(min, max uint32) *pb.TapByResourceRequest_Match_Response {
+			return &pb.TapByResourceRequest_Match_Response{
+				Match: &pb.TapByResourceRequest_Match_Response_Status{
+					Status: &pb.TapByResourceRequest_Match_Response_StatusRange{Min: min, Max: max},
+				},
+			}
+		}
+		grpcStatus := func(code codes.Code) *pb.TapByResourceRequest_Match_Response {
+			return &pb.TapByResourceRequest_Match_Response{
+				Match: &pb.TapByResourceRequest_Match_Response_GrpcStatus{GrpcStatus: uint32(code)},
+			}
+		}
+
+		expectations := []struct {
+			params   TapRequestParams
+			expected []*pb.TapByResourceRequest_Match_Response
+		}{
+			{
+				params:   TapRequestParams{Resource: "deploy/web", Status: "5xx"},
+				expected: []*pb.TapByResourceRequest_Match_Response{statusRange(500, 599)},
+			},
+			{
+				params:   TapRequestParams{Resource: "deploy/web", Status: "404", GrpcStatus: "0"},
+				expected: []*pb.TapByResourceRequest_Match_Response{statusRange(404, 404), grpcStatus(codes.OK)},
+			},
+			{
+				params:   TapRequestParams{Resource: "deploy/web", Status: "500-503", GrpcStatus: "unavailable"},
+				expected: []*pb.TapByResourceRequest_Match_Response{statusRange(500, 503), grpcStatus(codes.Unavailable)},
+			},
+			{
+				params: TapRequestParams{Resource: "deploy/web", GrpcStatus: "DEADLINE_EXCEEDED", SlowerThan: 500 * time.Millisecond},
+				expected: []*pb.TapByResourceRequest_Match_Response{
+					grpcStatus(codes.DeadlineExceeded),
+					{
+						Match: &pb.TapByResourceRequest_Match_Response_SlowerThan{
+							SlowerThan: &duration.Duration{Nanos: 500000000},
+						},
+					},
+				},
+			},
+		}
+
+		for _, exp := range expectations {
+			req, err := BuildTapByResourceRequest(exp.params)
+			if err != nil {
+				t.Fatalf("Unexpected error for %+v: %s", exp.params, err)
+			}
+
+			responses := []*pb.TapByResourceRequest_Match_Response{}
+			for _, match := range req.GetMatch().GetAll().GetMatches() {
+				if response := match.GetResponse(); response != nil {
+					responses = append(responses, response)
+				}
+			}
+			if len(responses) != len(exp.expected) {
+				t.Fatalf("Expected %d response matches for %+v, got %+v", len(exp.expected), exp.params, responses)
+			}
+			for i := range responses {
+				if !proto.Equal(responses[i], exp.expected[i]) {
+					t.Fatalf("Expected response match %+v for %+v, got %+v", exp.expected[i], exp.params, responses[i])
+				}
+			}
+		}
+	})
+
+	t.Run("Rejects invalid response matches", func(t *testing.T) {
+		invalid := []TapRequestParams{
+			{Resource: "deploy/web", Status: "5x"},
+			{Resource: "deploy/web", Status: "6xx"},
+			{Resource: "deploy/web", Status: "503-500"},
+			{Resource: "deploy/web", Status: "99"},
+			{Resource: "deploy/web", GrpcStatus: "17"},
+			{Resource: "deploy/web", GrpcStatus: "Broken"},
+			{Resource: "deploy/web", SlowerThan: -time.Second},
+		}
+
+		for _, params := range invalid {
+			if _, err := BuildTapByResourceRequest(params); err == nil {
+				t.Fatalf("Expected %+v to be rejected", params)
+			}
+		}
+	})
+
+	t.Run("Builds further targets in the same namespace", func(t *testing.T) {
+		req, err := BuildTapByResourceRequest(TapRequestParams{
+			Resource:      "deploy/web",
+			Resources:     []string{"deploy/api", "po/api-0"},
+			Namespace:     "emojivoto",
+			LabelSelector: "app=emoji",
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expected := []*pb.Resource{
+			{Namespace: "emojivoto", Type: k8s.Deployment, Name: "api"},
+			{Namespace: "emojivoto", Type: k8s.Pod, Name: "api-0"},
+		}
+		if len(req.GetTargets()) != len(expected) {
+			t.Fatalf("Expected %d further targets, got %+v", len(expected), req.GetTargets())
+		}
+		for i, target := range req.GetTargets() {
+			if !proto.Equal(target.GetResource(), expected[i]) {
+				t.Fatalf("Expected target %+v, got %+v", expected[i], target.GetResource())
+			}
+			if !proto.Equal(target.GetLabels(), req.GetTarget().GetLabels()) {
+				t.Fatalf("Expected target %+v to share the label selector, got %+v", expected[i], target.GetLabels())
+			}
+		}
+
+		if _, err := BuildTapByResourceRequest(TapRequestParams{Resource: "deploy/web", Resources: []string{"svc/web"}}); err == nil {
+			t.Fatalf("Expected services to be rejected as further targets")
+		}
+	})
+}
+
 func TestBuildResource(t *testing.T) {
 	type resourceExp struct {
 		namespace string
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+// HistogramQuantile estimates the q-quantile (0 <= q <= 1) of a latency
+// histogram, in milliseconds, interpolating linearly within the bucket the
+// quantile falls in. This matches Prometheus' `histogram_quantile`, so that
+// quantiles computed from a histogram agree with the ones the public API
+// reports. NaN is returned if the histogram is empty or lacks a +Inf bucket.
+func HistogramQuantile(q float64, h *pb.LatencyHistogram) float64 {
+	buckets := h.GetBuckets()
+	if len(buckets) < 2 || !math.IsInf(buckets[len(buckets)-1].LeMs, 1) {
+		return math.NaN()
+	}
+
+	observations := float64(buckets[len(buckets)-1].Count)
+	if observations == 0 {
+		return math.NaN()
+	}
+
+	rank := q * observations
+	b := 0
+	for b < len(buckets)-1 && float64(buckets[b].Count) < rank {
+		b++
+	}
+
+	if b == len(buckets)-1 {
+		// the quantile falls in the +Inf bucket, so report its lower bound
+		return buckets[len(buckets)-2].LeMs
+	}
+	if b == 0 && buckets[0].LeMs <= 0 {
+		return buckets[0].LeMs
+	}
+
+	bucketStart := 0.0
+	bucketEnd := buckets[b].LeMs
+	count := float64(buckets[b].Count)
+	if b > 0 {
+		bucketStart = buckets[b-1].LeMs
+		count -= float64(buckets[b-1].Count)
+		rank -= float64(buckets[b-1].Count)
+	}
+	if count == 0 {
+		return bucketEnd
+	}
+	return bucketStart + (bucketEnd-bucketStart)*(rank/count)
+}
+
+// ParsePercentile parses a percentile name into a quantile. The first two
+// digits following the "p" are the percentage and any further digits its
+// decimal places, so "p05" is 0.05, "p50" is 0.5 and "p999" is 0.999;
+// "p99.9" is also accepted. Forms that read ambiguously, such as "p5" or
+// "p100", are rejected rather than guessed at.
+func ParsePercentile(name string) (float64, error) {
+	invalid := fmt.Errorf("invalid percentile [%s], must be of the form p50, p99 or p999", name)
+	if !strings.HasPrefix(name, "p") {
+		return 0, invalid
+	}
+	digits := name[1:]
+	if strings.ContainsAny(digits, "+-eE") {
+		return 0, invalid
+	}
+
+	var q float64
+	var err error
+	if strings.Contains(digits, ".") {
+		q, err = strconv.ParseFloat(digits, 64)
+		q /= 100
+	} else {
+		// "p5" could mean 5% or 50%, and "p100" 100% or 10%
+		if len(digits) < 2 || (len(digits) > 2 && strings.HasSuffix(digits, "0")) {
+			return 0, invalid
+		}
+		q, err = strconv.ParseFloat("0."+digits, 64)
+	}
+	if err != nil || q <= 0 || q >= 1 {
+		return 0, invalid
+	}
+
+	return q, nil
+}
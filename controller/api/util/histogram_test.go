@@ -0,0 +1,84 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	histogram := &pb.LatencyHistogram{
+		Buckets: []*pb.LatencyHistogram_Bucket{
+			{LeMs: 10, Count: 500},
+			{LeMs: 20, Count: 900},
+			{LeMs: 50, Count: 990},
+			{LeMs: 100, Count: 999},
+			{LeMs: math.Inf(1), Count: 1000},
+		},
+	}
+
+	expectations := []struct {
+		q        float64
+		expected float64
+	}{
+		{0, 0},
+		{0.25, 5},
+		{0.5, 10},
+		{0.7, 15},
+		{0.99, 50},
+		{0.9945, 75},
+		{0.999, 100},
+		{0.9999, 100},
+	}
+
+	for _, exp := range expectations {
+		actual := HistogramQuantile(exp.q, histogram)
+		if math.Abs(actual-exp.expected) > 1e-9 {
+			t.Fatalf("HistogramQuantile(%v) returned %v, expected %v", exp.q, actual, exp.expected)
+		}
+	}
+
+	t.Run("Returns NaN for unusable histograms", func(t *testing.T) {
+		histograms := []*pb.LatencyHistogram{
+			nil,
+			{},
+			{Buckets: []*pb.LatencyHistogram_Bucket{{LeMs: 10, Count: 1}, {LeMs: 20, Count: 2}}},
+			{Buckets: []*pb.LatencyHistogram_Bucket{{LeMs: 10, Count: 0}, {LeMs: math.Inf(1), Count: 0}}},
+		}
+		for _, h := range histograms {
+			if q := HistogramQuantile(0.5, h); !math.IsNaN(q) {
+				t.Fatalf("HistogramQuantile(0.5, %v) returned %v, expected NaN", h, q)
+			}
+		}
+	})
+}
+
+func TestParsePercentile(t *testing.T) {
+	expectations := map[string]float64{
+		"p05":   0.05,
+		"p50":   0.5,
+		"p95":   0.95,
+		"p99":   0.99,
+		"p999":  0.999,
+		"p99.9": 0.999,
+		"p9999": 0.9999,
+	}
+
+	for name, expected := range expectations {
+		q, err := ParsePercentile(name)
+		if err != nil {
+			t.Fatalf("Unexpected error from ParsePercentile(%s): %s", name, err)
+		}
+		if math.Abs(q-expected) > 1e-9 {
+			t.Fatalf("ParsePercentile(%s) returned %v, expected %v", name, q, expected)
+		}
+	}
+
+	for _, name := range []string{"", "p", "50", "p0", "p1", "p5", "p100", "p500", "p100.0", "pfoo", "p-5", "p1e3"} {
+		_, err := ParsePercentile(name)
+		if err == nil {
+			t.Fatalf("ParsePercentile(%s) unexpectedly succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package util
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// BuildLabelSelector parses a string-formatted label selector, as passed to
+// `kubectl get --selector`, into a LabelSelector. An empty string yields nil,
+// which selects everything.
+func BuildLabelSelector(selector string) (*pb.LabelSelector, error) {
+	if selector == "" {
+		return nil, nil
+	}
+
+	reqs, err := labels.ParseToRequirements(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector \"%s\": %s", selector, err)
+	}
+
+	labelSelector := &pb.LabelSelector{}
+	for _, req := range reqs {
+		var op pb.LabelSelectorRequirement_Operator
+		switch req.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+			if labelSelector.MatchLabels == nil {
+				labelSelector.MatchLabels = make(map[string]string)
+			}
+			labelSelector.MatchLabels[req.Key()] = req.Values().List()[0]
+			continue
+		case selection.In:
+			op = pb.LabelSelectorRequirement_IN
+		case selection.NotEquals, selection.NotIn:
+			// `key!=value` selects the same resources as `key notin (value)`
+			op = pb.LabelSelectorRequirement_NOT_IN
+		case selection.Exists:
+			op = pb.LabelSelectorRequirement_EXISTS
+		case selection.DoesNotExist:
+			op = pb.LabelSelectorRequirement_DOES_NOT_EXIST
+		default:
+			return nil, fmt.Errorf("invalid label selector \"%s\": operator \"%s\" is not supported", selector, req.Operator())
+		}
+
+		labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, &pb.LabelSelectorRequirement{
+			Key:      req.Key(),
+			Operator: op,
+			Values:   req.Values().List(),
+		})
+	}
+
+	return labelSelector, nil
+}
+
+// LabelSelectorFor returns a selector for the labels a ResourceSelection
+// restricts resources to, combining its string-formatted and structured
+// label selectors. It returns an error if either is invalid.
+func LabelSelectorFor(selection *pb.ResourceSelection) (labels.Selector, error) {
+	selector := labels.Everything()
+
+	if s := selection.GetLabelSelector(); s != "" {
+		reqs, err := labels.ParseToRequirements(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector \"%s\": %s", s, err)
+		}
+		selector = selector.Add(reqs...)
+	}
+
+	reqs, err := labelRequirements(selection.GetLabels())
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %s", err)
+	}
+	return selector.Add(reqs...), nil
+}
+
+func labelRequirements(labelSelector *pb.LabelSelector) ([]labels.Requirement, error) {
+	reqs := []labels.Requirement{}
+
+	keys := make([]string, 0, len(labelSelector.GetMatchLabels()))
+	for key := range labelSelector.GetMatchLabels() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		req, err := labels.NewRequirement(key, selection.Equals, []string{labelSelector.GetMatchLabels()[key]})
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, *req)
+	}
+
+	for _, expr := range labelSelector.GetMatchExpressions() {
+		var op selection.Operator
+		switch expr.GetOperator() {
+		case pb.LabelSelectorRequirement_IN:
+			op = selection.In
+		case pb.LabelSelectorRequirement_NOT_IN:
+			op = selection.NotIn
+		case pb.LabelSelectorRequirement_EXISTS:
+			op = selection.Exists
+		case pb.LabelSelectorRequirement_DOES_NOT_EXIST:
+			op = selection.DoesNotExist
+		default:
+			return nil, fmt.Errorf("unknown operator %d for key \"%s\"", expr.GetOperator(), expr.GetKey())
+		}
+
+		req, err := labels.NewRequirement(expr.GetKey(), op, expr.GetValues())
+		if err != nil {
+			return nil, err
+		}
+		reqs = append(reqs, *req)
+	}
+
+	return reqs, nil
+}
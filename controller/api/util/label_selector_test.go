@@ -0,0 +1,105 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestBuildLabelSelector(t *testing.T) {
+	t.Run("Parses equality and set-based requirements", func(t *testing.T) {
+		expectations := map[string]*pb.LabelSelector{
+			"": nil,
+			"app=web,tier==frontend": {
+				MatchLabels: map[string]string{"app": "web", "tier": "frontend"},
+			},
+			"env in (prod,staging),track!=canary,!legacy,owner": {
+				MatchExpressions: []*pb.LabelSelectorRequirement{
+					{Key: "env", Operator: pb.LabelSelectorRequirement_IN, Values: []string{"prod", "staging"}},
+					{Key: "legacy", Operator: pb.LabelSelectorRequirement_DOES_NOT_EXIST},
+					{Key: "owner", Operator: pb.LabelSelectorRequirement_EXISTS},
+					{Key: "track", Operator: pb.LabelSelectorRequirement_NOT_IN, Values: []string{"canary"}},
+				},
+			},
+		}
+
+		for selector, expected := range expectations {
+			labelSelector, err := BuildLabelSelector(selector)
+			if err != nil {
+				t.Fatalf("Unexpected error for selector [%s]: %s", selector, err)
+			}
+			if !proto.Equal(labelSelector, expected) {
+				t.Fatalf("Expected selector [%s] to parse to %+v, got %+v", selector, expected, labelSelector)
+			}
+		}
+	})
+
+	t.Run("Rejects invalid selectors", func(t *testing.T) {
+		for _, selector := range []string{"app=-web", "env in (prod", "replicas>1", "-app=web"} {
+			if _, err := BuildLabelSelector(selector); err == nil {
+				t.Fatalf("Expected selector [%s] to be rejected", selector)
+			}
+		}
+	})
+}
+
+func TestLabelSelectorFor(t *testing.T) {
+	t.Run("Combines string and structured selectors", func(t *testing.T) {
+		selection := &pb.ResourceSelection{
+			LabelSelector: "app=web",
+			Labels: &pb.LabelSelector{
+				MatchExpressions: []*pb.LabelSelectorRequirement{
+					{Key: "env", Operator: pb.LabelSelectorRequirement_NOT_IN, Values: []string{"dev"}},
+				},
+			},
+		}
+
+		selector, err := LabelSelectorFor(selection)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		expectations := map[string]bool{
+			"app=web":          true,
+			"app=web,env=prod": true,
+			"app=web,env=dev":  false,
+			"app=api":          false,
+		}
+		for set, expected := range expectations {
+			objLabels, err := labels.ConvertSelectorToLabelsMap(set)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if selector.Matches(objLabels) != expected {
+				t.Fatalf("Expected selector [%s] matching labels [%s] to be %t", selector, set, expected)
+			}
+		}
+	})
+
+	t.Run("Selects everything without selectors", func(t *testing.T) {
+		selector, err := LabelSelectorFor(&pb.ResourceSelection{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if !selector.Empty() {
+			t.Fatalf("Expected an empty selector, got [%s]", selector)
+		}
+	})
+
+	t.Run("Rejects invalid structured selectors", func(t *testing.T) {
+		invalid := []*pb.LabelSelector{
+			{MatchLabels: map[string]string{"app": "not a valid value"}},
+			{MatchExpressions: []*pb.LabelSelectorRequirement{{Key: "env", Operator: pb.LabelSelectorRequirement_IN}}},
+			{MatchExpressions: []*pb.LabelSelectorRequirement{{Key: "env", Operator: pb.LabelSelectorRequirement_EXISTS, Values: []string{"prod"}}}},
+			{MatchExpressions: []*pb.LabelSelectorRequirement{{Key: "env", Operator: 42, Values: []string{"prod"}}}},
+		}
+
+		for _, labelSelector := range invalid {
+			if _, err := LabelSelectorFor(&pb.ResourceSelection{Labels: labelSelector}); err == nil {
+				t.Fatalf("Expected selector %+v to be rejected", labelSelector)
+			}
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/linkerd/linkerd2/controller/api/destination"
@@ -20,6 +21,7 @@ func main() {
 	addr := flag.String("addr", ":8086", "address to serve on")
 	metricsAddr := flag.String("metrics-addr", ":9996", "address to serve scrapable metrics on")
 	kubeConfigPath := flag.String("kubeconfig", "", "path to kube config")
+	topLevelOwnerKinds := flag.String("top-level-owner-kinds", strings.Join(k8s.DefaultTopLevelOwnerKinds, ","), "comma separated list of kinds at which pod owner resolution stops, even if they are owned by another object")
 	enableH2Upgrade := flag.Bool("enable-h2-upgrade", true, "Enable transparently upgraded HTTP2 connections among pods in the service mesh")
 	disableIdentity := flag.Bool("disable-identity", false, "Disable identity configuration")
 	controllerNamespace := flag.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
@@ -35,6 +37,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
+	k8sAPI.SetTopLevelOwnerKinds(strings.Split(*topLevelOwnerKinds, ","))
 
 	done := make(chan struct{})
 
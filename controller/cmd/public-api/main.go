@@ -3,10 +3,12 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/linkerd/linkerd2/controller/api/discovery"
 	"github.com/linkerd/linkerd2/controller/api/public"
@@ -14,19 +16,27 @@ import (
 	"github.com/linkerd/linkerd2/controller/tap"
 	"github.com/linkerd/linkerd2/pkg/admin"
 	"github.com/linkerd/linkerd2/pkg/flags"
-	promApi "github.com/prometheus/client_golang/api"
 	log "github.com/sirupsen/logrus"
 )
 
 func main() {
 	addr := flag.String("addr", ":8085", "address to serve on")
 	kubeConfigPath := flag.String("kubeconfig", "", "path to kube config")
-	prometheusURL := flag.String("prometheus-url", "http://127.0.0.1:9090", "prometheus url")
+	topLevelOwnerKinds := flag.String("top-level-owner-kinds", strings.Join(k8s.DefaultTopLevelOwnerKinds, ","), "comma separated list of kinds at which pod owner resolution stops, even if they are owned by another object")
+	prometheusURL := flag.String("prometheus-url", "http://127.0.0.1:9090", "URL of the Prometheus-compatible API to read metrics from; may point at a metrics store outside the cluster")
+	prometheusCAFile := flag.String("prometheus-ca-file", "", "path to a PEM bundle used to verify the Prometheus endpoint's certificate")
+	prometheusCertFile := flag.String("prometheus-cert-file", "", "path to a client certificate presented to the Prometheus endpoint")
+	prometheusKeyFile := flag.String("prometheus-key-file", "", "path to the key of the client certificate presented to the Prometheus endpoint")
+	prometheusInsecureSkipVerify := flag.Bool("prometheus-insecure-skip-verify", false, "skip verification of the Prometheus endpoint's certificate")
+	metricsCacheTTL := flag.Duration("metrics-cache-ttl", 5*time.Second, "how long Prometheus query results are reused; 0 disables caching, identical concurrent queries are still coalesced")
+	prometheusHeaders := headerFlag{}
+	flag.Var(&prometheusHeaders, "prometheus-header", "header added to every Prometheus request, as \"Name: value\"; may be repeated")
 	metricsAddr := flag.String("metrics-addr", ":9995", "address to serve scrapable metrics on")
 	destinationAPIAddr := flag.String("destination-addr", "127.0.0.1:8086", "address of destination service")
 	tapAddr := flag.String("tap-addr", "127.0.0.1:8088", "address of tap service")
 	controllerNamespace := flag.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
 	ignoredNamespaces := flag.String("ignore-namespaces", "kube-system", "comma separated list of namespaces to not list pods from")
+	enableAuthz := flag.Bool("enable-authz", false, "require callers to present a Kubernetes bearer token, and only serve the namespaces they are authorized for")
 	flags.ConfigureAndParse()
 
 	stop := make(chan os.Signal, 1)
@@ -46,25 +56,35 @@ func main() {
 
 	k8sAPI, err := k8s.InitializeAPI(
 		*kubeConfigPath,
-		k8s.DS, k8s.Deploy, k8s.Job, k8s.NS, k8s.Pod, k8s.RC, k8s.RS, k8s.Svc, k8s.SS, k8s.SP,
+		k8s.DS, k8s.Deploy, k8s.Job, k8s.NS, k8s.Pod, k8s.RC, k8s.RS, k8s.Svc, k8s.SS, k8s.SP, k8s.SLO, k8s.TS,
 	)
 	if err != nil {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
+	k8sAPI.SetTopLevelOwnerKinds(strings.Split(*topLevelOwnerKinds, ","))
 
-	prometheusClient, err := promApi.NewClient(promApi.Config{Address: *prometheusURL})
+	metricsBackend, err := public.NewPrometheusBackend(public.PrometheusBackendConfig{
+		URL:                *prometheusURL,
+		Headers:            prometheusHeaders,
+		CAFile:             *prometheusCAFile,
+		CertFile:           *prometheusCertFile,
+		KeyFile:            *prometheusKeyFile,
+		InsecureSkipVerify: *prometheusInsecureSkipVerify,
+	})
 	if err != nil {
-		log.Fatal(err.Error())
+		log.Fatalf("Failed to initialize metrics backend: %s", err)
 	}
+	metricsBackend = public.NewCachingMetricsBackend(metricsBackend, *metricsCacheTTL)
 
 	server := public.NewServer(
 		*addr,
-		prometheusClient,
+		metricsBackend,
 		tapClient,
 		discoveryClient,
 		k8sAPI,
 		*controllerNamespace,
 		strings.Split(*ignoredNamespaces, ","),
+		*enableAuthz,
 	)
 
 	k8sAPI.Sync() // blocks until caches are synced
@@ -81,3 +101,19 @@ func main() {
 	log.Infof("shutting down HTTP server on %+v", *addr)
 	server.Shutdown(context.Background())
 }
+
+// headerFlag collects repeated "Name: value" flags into a header map.
+type headerFlag map[string]string
+
+func (h headerFlag) String() string {
+	return fmt.Sprintf("%v", map[string]string(h))
+}
+
+func (h headerFlag) Set(value string) error {
+	parts := strings.SplitN(value, ":", 2)
+	if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+		return fmt.Errorf("invalid header %q, expected \"Name: value\"", value)
+	}
+	h[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	return nil
+}
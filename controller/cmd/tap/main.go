@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/linkerd/linkerd2/controller/k8s"
@@ -17,8 +18,11 @@ func main() {
 	addr := flag.String("addr", ":8088", "address to serve on")
 	metricsAddr := flag.String("metrics-addr", ":9998", "address to serve scrapable metrics on")
 	kubeConfigPath := flag.String("kubeconfig", "", "path to kube config")
+	topLevelOwnerKinds := flag.String("top-level-owner-kinds", strings.Join(k8s.DefaultTopLevelOwnerKinds, ","), "comma separated list of kinds at which pod owner resolution stops, even if they are owned by another object")
 	controllerNamespace := flag.String("controller-namespace", "linkerd", "namespace in which Linkerd is installed")
 	tapPort := flag.Uint("tap-port", 4190, "proxy tap port to connect to")
+	enableAuthz := flag.Bool("enable-authz", false, "require callers to forward a Kubernetes bearer token that may tap the target namespace")
+	auditSinks := flag.String("audit-sinks", strings.Join(tap.DefaultAuditSinks, ","), "comma separated list of sinks that tap sessions are recorded to: \"log\", \"events\" (Kubernetes Events in the tapped namespace) or \"file=PATH\" (JSON lines); empty to disable")
 	flags.ConfigureAndParse()
 
 	stop := make(chan os.Signal, 1)
@@ -39,8 +43,21 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to initialize K8s API: %s", err)
 	}
+	k8sAPI.SetTopLevelOwnerKinds(strings.Split(*topLevelOwnerKinds, ","))
 
-	server, lis, err := tap.NewServer(*addr, *tapPort, *controllerNamespace, k8sAPI)
+	sinks := []tap.AuditSink{}
+	for _, spec := range strings.Split(*auditSinks, ",") {
+		if strings.TrimSpace(spec) == "" {
+			continue
+		}
+		sink, err := tap.NewAuditSink(spec, k8sAPI.Client, *controllerNamespace)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		sinks = append(sinks, sink)
+	}
+
+	server, lis, err := tap.NewServer(*addr, *tapPort, *controllerNamespace, k8sAPI, *enableAuthz, sinks)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -0,0 +1,4 @@
+package slo
+
+// GroupName identifies the API Group Name for a ServiceLevelObjective.
+const GroupName = "slo.linkerd.io"
@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=slo.linkerd.io
+
+package v1alpha1
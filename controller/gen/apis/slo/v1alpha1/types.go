@@ -0,0 +1,67 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceLevelObjective describes a serviceLevelObjective resource
+type ServiceLevelObjective struct {
+	// TypeMeta is the metadata for the resource, like kind and apiversion
+	metav1.TypeMeta `json:",inline"`
+	// ObjectMeta contains the metadata for the particular object, including
+	// things like...
+	//  - name
+	//  - namespace
+	//  - self link
+	//  - labels
+	//  - ... etc ...
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the custom resource spec
+	Spec ServiceLevelObjectiveSpec `json:"spec"`
+}
+
+// ServiceLevelObjectiveSpec specifies a ServiceLevelObjective resource.
+// Exactly one of SuccessRate and Latency must be set.
+type ServiceLevelObjectiveSpec struct {
+	Target          Target                `json:"target"`
+	SuccessRate     *SuccessRateObjective `json:"successRate,omitempty"`
+	Latency         *LatencyObjective     `json:"latency,omitempty"`
+	Window          string                `json:"window"`
+	BurnRateWindows []string              `json:"burnRateWindows,omitempty"`
+}
+
+// Target names the resource, and optionally one of its ServiceProfile routes,
+// that an objective applies to.
+type Target struct {
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+	Route string `json:"route,omitempty"`
+}
+
+// SuccessRateObjective is the ratio of requests that must succeed.
+type SuccessRateObjective struct {
+	Objective float64 `json:"objective"`
+}
+
+// LatencyObjective is the ratio of requests that must complete within
+// ThresholdMs. The threshold must be one of the proxy's latency histogram
+// bucket bounds.
+type LatencyObjective struct {
+	ThresholdMs uint32  `json:"thresholdMs"`
+	Objective   float64 `json:"objective"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceLevelObjectiveList is a list of ServiceLevelObjective resources.
+type ServiceLevelObjectiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ServiceLevelObjective `json:"items"`
+}
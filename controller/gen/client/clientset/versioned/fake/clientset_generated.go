@@ -22,6 +22,8 @@ import (
 	clientset "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	linkerdv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serviceprofile/v1alpha1"
 	fakelinkerdv1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/serviceprofile/v1alpha1/fake"
+	slov1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/slo/v1alpha1"
+	fakeslov1alpha1 "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/typed/slo/v1alpha1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -80,3 +82,13 @@ func (c *Clientset) LinkerdV1alpha1() linkerdv1alpha1.LinkerdV1alpha1Interface {
 func (c *Clientset) Linkerd() linkerdv1alpha1.LinkerdV1alpha1Interface {
 	return &fakelinkerdv1alpha1.FakeLinkerdV1alpha1{Fake: &c.Fake}
 }
+
+// SloV1alpha1 retrieves the SloV1alpha1Client
+func (c *Clientset) SloV1alpha1() slov1alpha1.SloV1alpha1Interface {
+	return &fakeslov1alpha1.FakeSloV1alpha1{Fake: &c.Fake}
+}
+
+// Slo retrieves the SloV1alpha1Client
+func (c *Clientset) Slo() slov1alpha1.SloV1alpha1Interface {
+	return &fakeslov1alpha1.FakeSloV1alpha1{Fake: &c.Fake}
+}
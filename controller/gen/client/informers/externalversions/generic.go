@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha1"
+	slov1alpha1 "github.com/linkerd/linkerd2/controller/gen/apis/slo/v1alpha1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -56,6 +57,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case v1alpha1.SchemeGroupVersion.WithResource("serviceprofiles"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Linkerd().V1alpha1().ServiceProfiles().Informer()}, nil
 
+		// Group=slo.linkerd.io, Version=v1alpha1
+	case slov1alpha1.SchemeGroupVersion.WithResource("servicelevelobjectives"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Slo().V1alpha1().ServiceLevelObjectives().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -7,6 +7,7 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import healthcheck "github.com/linkerd/linkerd2/controller/gen/common/healthcheck"
 import config "github.com/linkerd/linkerd2/controller/gen/config"
 
@@ -26,6 +27,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type ListPodsRequest_Condition int32
+
+const (
+	ListPodsRequest_ANY   ListPodsRequest_Condition = 0
+	ListPodsRequest_TRUE  ListPodsRequest_Condition = 1
+	ListPodsRequest_FALSE ListPodsRequest_Condition = 2
+)
+
+var ListPodsRequest_Condition_name = map[int32]string{
+	0: "ANY",
+	1: "TRUE",
+	2: "FALSE",
+}
+var ListPodsRequest_Condition_value = map[string]int32{
+	"ANY":   0,
+	"TRUE":  1,
+	"FALSE": 2,
+}
+
+func (x ListPodsRequest_Condition) String() string {
+	return proto.EnumName(ListPodsRequest_Condition_name, int32(x))
+}
+func (ListPodsRequest_Condition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{5, 0}
+}
+
 type HttpMethod_Registered int32
 
 const (
@@ -67,7 +94,7 @@ func (x HttpMethod_Registered) String() string {
 	return proto.EnumName(HttpMethod_Registered_name, int32(x))
 }
 func (HttpMethod_Registered) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{10, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{10, 0}
 }
 
 type Scheme_Registered int32
@@ -90,7 +117,7 @@ func (x Scheme_Registered) String() string {
 	return proto.EnumName(Scheme_Registered_name, int32(x))
 }
 func (Scheme_Registered) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{11, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{11, 0}
 }
 
 type TapEvent_ProxyDirection int32
@@ -116,7 +143,36 @@ func (x TapEvent_ProxyDirection) String() string {
 	return proto.EnumName(TapEvent_ProxyDirection_name, int32(x))
 }
 func (TapEvent_ProxyDirection) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 0}
+}
+
+type LabelSelectorRequirement_Operator int32
+
+const (
+	LabelSelectorRequirement_IN             LabelSelectorRequirement_Operator = 0
+	LabelSelectorRequirement_NOT_IN         LabelSelectorRequirement_Operator = 1
+	LabelSelectorRequirement_EXISTS         LabelSelectorRequirement_Operator = 2
+	LabelSelectorRequirement_DOES_NOT_EXIST LabelSelectorRequirement_Operator = 3
+)
+
+var LabelSelectorRequirement_Operator_name = map[int32]string{
+	0: "IN",
+	1: "NOT_IN",
+	2: "EXISTS",
+	3: "DOES_NOT_EXIST",
+}
+var LabelSelectorRequirement_Operator_value = map[string]int32{
+	"IN":             0,
+	"NOT_IN":         1,
+	"EXISTS":         2,
+	"DOES_NOT_EXIST": 3,
+}
+
+func (x LabelSelectorRequirement_Operator) String() string {
+	return proto.EnumName(LabelSelectorRequirement_Operator_name, int32(x))
+}
+func (LabelSelectorRequirement_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{23, 0}
 }
 
 type Empty struct {
@@ -129,7 +185,7 @@ func (m *Empty) Reset()         { *m = Empty{} }
 func (m *Empty) String() string { return proto.CompactTextString(m) }
 func (*Empty) ProtoMessage()    {}
 func (*Empty) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{0}
 }
 func (m *Empty) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Empty.Unmarshal(m, b)
@@ -162,7 +218,7 @@ func (m *VersionInfo) Reset()         { *m = VersionInfo{} }
 func (m *VersionInfo) String() string { return proto.CompactTextString(m) }
 func (*VersionInfo) ProtoMessage()    {}
 func (*VersionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{1}
+	return fileDescriptor_public_070233ff61e27ff7, []int{1}
 }
 func (m *VersionInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VersionInfo.Unmarshal(m, b)
@@ -214,7 +270,7 @@ func (m *ListServicesRequest) Reset()         { *m = ListServicesRequest{} }
 func (m *ListServicesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListServicesRequest) ProtoMessage()    {}
 func (*ListServicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{2}
+	return fileDescriptor_public_070233ff61e27ff7, []int{2}
 }
 func (m *ListServicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListServicesRequest.Unmarshal(m, b)
@@ -252,7 +308,7 @@ func (m *ListServicesResponse) Reset()         { *m = ListServicesResponse{} }
 func (m *ListServicesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListServicesResponse) ProtoMessage()    {}
 func (*ListServicesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{3}
+	return fileDescriptor_public_070233ff61e27ff7, []int{3}
 }
 func (m *ListServicesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListServicesResponse.Unmarshal(m, b)
@@ -291,7 +347,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{4}
+	return fileDescriptor_public_070233ff61e27ff7, []int{4}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Service.Unmarshal(m, b)
@@ -326,18 +382,28 @@ func (m *Service) GetNamespace() string {
 }
 
 type ListPodsRequest struct {
-	Namespace            string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Deprecated: Do not use.
-	Selector             *ResourceSelection `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Namespace string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Deprecated: Do not use.
+	Selector  *ResourceSelection `protobuf:"bytes,2,opt,name=selector,proto3" json:"selector,omitempty"`
+	// The maximum number of pods to return, zero meaning no limit. If more pods
+	// match, the response has a `next_page_token` to request the rest with.
+	Limit     uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return pods that are (or are not) meshed.
+	Meshed ListPodsRequest_Condition `protobuf:"varint,5,opt,name=meshed,proto3,enum=linkerd2.public.ListPodsRequest_Condition" json:"meshed,omitempty"`
+	// Only return pods whose proxy runs this version.
+	ProxyVersion string `protobuf:"bytes,6,opt,name=proxy_version,json=proxyVersion,proto3" json:"proxy_version,omitempty"`
+	// Only return pods whose proxy is (or is not) ready.
+	ProxyReady           ListPodsRequest_Condition `protobuf:"varint,7,opt,name=proxy_ready,json=proxyReady,proto3,enum=linkerd2.public.ListPodsRequest_Condition" json:"proxy_ready,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ListPodsRequest) Reset()         { *m = ListPodsRequest{} }
 func (m *ListPodsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPodsRequest) ProtoMessage()    {}
 func (*ListPodsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{5}
+	return fileDescriptor_public_070233ff61e27ff7, []int{5}
 }
 func (m *ListPodsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPodsRequest.Unmarshal(m, b)
@@ -372,8 +438,45 @@ func (m *ListPodsRequest) GetSelector() *ResourceSelection {
 	return nil
 }
 
+func (m *ListPodsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListPodsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListPodsRequest) GetMeshed() ListPodsRequest_Condition {
+	if m != nil {
+		return m.Meshed
+	}
+	return ListPodsRequest_ANY
+}
+
+func (m *ListPodsRequest) GetProxyVersion() string {
+	if m != nil {
+		return m.ProxyVersion
+	}
+	return ""
+}
+
+func (m *ListPodsRequest) GetProxyReady() ListPodsRequest_Condition {
+	if m != nil {
+		return m.ProxyReady
+	}
+	return ListPodsRequest_ANY
+}
+
 type ListPodsResponse struct {
-	Pods                 []*Pod   `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
+	Pods []*Pod `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
+	// Empty once the last page has been returned.
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -383,7 +486,7 @@ func (m *ListPodsResponse) Reset()         { *m = ListPodsResponse{} }
 func (m *ListPodsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPodsResponse) ProtoMessage()    {}
 func (*ListPodsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{6}
+	return fileDescriptor_public_070233ff61e27ff7, []int{6}
 }
 func (m *ListPodsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPodsResponse.Unmarshal(m, b)
@@ -410,6 +513,13 @@ func (m *ListPodsResponse) GetPods() []*Pod {
 	return nil
 }
 
+func (m *ListPodsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type Pod struct {
 	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	PodIP string `protobuf:"bytes,2,opt,name=podIP,proto3" json:"podIP,omitempty"`
@@ -439,7 +549,7 @@ func (m *Pod) Reset()         { *m = Pod{} }
 func (m *Pod) String() string { return proto.CompactTextString(m) }
 func (*Pod) ProtoMessage()    {}
 func (*Pod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{7}
+	return fileDescriptor_public_070233ff61e27ff7, []int{7}
 }
 func (m *Pod) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pod.Unmarshal(m, b)
@@ -776,7 +886,7 @@ func (m *TapRequest) Reset()         { *m = TapRequest{} }
 func (m *TapRequest) String() string { return proto.CompactTextString(m) }
 func (*TapRequest) ProtoMessage()    {}
 func (*TapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{8}
+	return fileDescriptor_public_070233ff61e27ff7, []int{8}
 }
 func (m *TapRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapRequest.Unmarshal(m, b)
@@ -969,17 +1079,24 @@ type TapByResourceRequest struct {
 	// Selects over events to be reported.
 	Match *TapByResourceRequest_Match `protobuf:"bytes,2,opt,name=match,proto3" json:"match,omitempty"`
 	// Limits the number of events to be inspected.
-	MaxRps               float32  `protobuf:"fixed32,3,opt,name=maxRps,proto3" json:"maxRps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxRps float32 `protobuf:"fixed32,3,opt,name=maxRps,proto3" json:"maxRps,omitempty"`
+	// If set, a Sampling event is reported for each tapped pod at the end of
+	// every window in which it was observed.
+	ReportSampling bool `protobuf:"varint,4,opt,name=report_sampling,json=reportSampling,proto3" json:"report_sampling,omitempty"`
+	// Describes further kubernetes pods to tap along with those of target. The
+	// events of every target are merged into one stream, in which each event
+	// names the target it was reported for.
+	Targets              []*ResourceSelection `protobuf:"bytes,5,rep,name=targets,proto3" json:"targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *TapByResourceRequest) Reset()         { *m = TapByResourceRequest{} }
 func (m *TapByResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*TapByResourceRequest) ProtoMessage()    {}
 func (*TapByResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{9}
+	return fileDescriptor_public_070233ff61e27ff7, []int{9}
 }
 func (m *TapByResourceRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapByResourceRequest.Unmarshal(m, b)
@@ -1020,6 +1137,20 @@ func (m *TapByResourceRequest) GetMaxRps() float32 {
 	return 0
 }
 
+func (m *TapByResourceRequest) GetReportSampling() bool {
+	if m != nil {
+		return m.ReportSampling
+	}
+	return false
+}
+
+func (m *TapByResourceRequest) GetTargets() []*ResourceSelection {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
 type TapByResourceRequest_Match struct {
 	// Types that are valid to be assigned to Match:
 	//	*TapByResourceRequest_Match_All
@@ -1027,6 +1158,7 @@ type TapByResourceRequest_Match struct {
 	//	*TapByResourceRequest_Match_Not
 	//	*TapByResourceRequest_Match_Destinations
 	//	*TapByResourceRequest_Match_Http_
+	//	*TapByResourceRequest_Match_Response_
 	Match                isTapByResourceRequest_Match_Match `protobuf_oneof:"match"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
 	XXX_unrecognized     []byte                             `json:"-"`
@@ -1037,7 +1169,7 @@ func (m *TapByResourceRequest_Match) Reset()         { *m = TapByResourceRequest
 func (m *TapByResourceRequest_Match) String() string { return proto.CompactTextString(m) }
 func (*TapByResourceRequest_Match) ProtoMessage()    {}
 func (*TapByResourceRequest_Match) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{9, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{9, 0}
 }
 func (m *TapByResourceRequest_Match) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapByResourceRequest_Match.Unmarshal(m, b)
@@ -1081,6 +1213,10 @@ type TapByResourceRequest_Match_Http_ struct {
 	Http *TapByResourceRequest_Match_Http `protobuf:"bytes,5,opt,name=http,proto3,oneof"`
 }
 
+type TapByResourceRequest_Match_Response_ struct {
+	Response *TapByResourceRequest_Match_Response `protobuf:"bytes,6,opt,name=response,proto3,oneof"`
+}
+
 func (*TapByResourceRequest_Match_All) isTapByResourceRequest_Match_Match() {}
 
 func (*TapByResourceRequest_Match_Any) isTapByResourceRequest_Match_Match() {}
@@ -1091,6 +1227,8 @@ func (*TapByResourceRequest_Match_Destinations) isTapByResourceRequest_Match_Mat
 
 func (*TapByResourceRequest_Match_Http_) isTapByResourceRequest_Match_Match() {}
 
+func (*TapByResourceRequest_Match_Response_) isTapByResourceRequest_Match_Match() {}
+
 func (m *TapByResourceRequest_Match) GetMatch() isTapByResourceRequest_Match_Match {
 	if m != nil {
 		return m.Match
@@ -1133,6 +1271,13 @@ func (m *TapByResourceRequest_Match) GetHttp() *TapByResourceRequest_Match_Http
 	return nil
 }
 
+func (m *TapByResourceRequest_Match) GetResponse() *TapByResourceRequest_Match_Response {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Response_); ok {
+		return x.Response
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*TapByResourceRequest_Match) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _TapByResourceRequest_Match_OneofMarshaler, _TapByResourceRequest_Match_OneofUnmarshaler, _TapByResourceRequest_Match_OneofSizer, []interface{}{
@@ -1141,6 +1286,7 @@ func (*TapByResourceRequest_Match) XXX_OneofFuncs() (func(msg proto.Message, b *
 		(*TapByResourceRequest_Match_Not)(nil),
 		(*TapByResourceRequest_Match_Destinations)(nil),
 		(*TapByResourceRequest_Match_Http_)(nil),
+		(*TapByResourceRequest_Match_Response_)(nil),
 	}
 }
 
@@ -1173,6 +1319,11 @@ func _TapByResourceRequest_Match_OneofMarshaler(msg proto.Message, b *proto.Buff
 		if err := b.EncodeMessage(x.Http); err != nil {
 			return err
 		}
+	case *TapByResourceRequest_Match_Response_:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Response); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("TapByResourceRequest_Match.Match has unexpected type %T", x)
@@ -1223,6 +1374,14 @@ func _TapByResourceRequest_Match_OneofUnmarshaler(msg proto.Message, tag, wire i
 		err := b.DecodeMessage(msg)
 		m.Match = &TapByResourceRequest_Match_Http_{msg}
 		return true, err
+	case 6: // match.response
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TapByResourceRequest_Match_Response)
+		err := b.DecodeMessage(msg)
+		m.Match = &TapByResourceRequest_Match_Response_{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1257,6 +1416,11 @@ func _TapByResourceRequest_Match_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TapByResourceRequest_Match_Response_:
+		s := proto.Size(x.Response)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1275,7 +1439,7 @@ func (m *TapByResourceRequest_Match_Seq) Reset()         { *m = TapByResourceReq
 func (m *TapByResourceRequest_Match_Seq) String() string { return proto.CompactTextString(m) }
 func (*TapByResourceRequest_Match_Seq) ProtoMessage()    {}
 func (*TapByResourceRequest_Match_Seq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{9, 0, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{9, 0, 0}
 }
 func (m *TapByResourceRequest_Match_Seq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapByResourceRequest_Match_Seq.Unmarshal(m, b)
@@ -1318,7 +1482,7 @@ func (m *TapByResourceRequest_Match_Http) Reset()         { *m = TapByResourceRe
 func (m *TapByResourceRequest_Match_Http) String() string { return proto.CompactTextString(m) }
 func (*TapByResourceRequest_Match_Http) ProtoMessage()    {}
 func (*TapByResourceRequest_Match_Http) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{9, 0, 1}
+	return fileDescriptor_public_070233ff61e27ff7, []int{9, 0, 1}
 }
 func (m *TapByResourceRequest_Match_Http) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapByResourceRequest_Match_Http.Unmarshal(m, b)
@@ -1497,6 +1661,232 @@ func _TapByResourceRequest_Match_Http_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type TapByResourceRequest_Match_Response struct {
+	// Types that are valid to be assigned to Match:
+	//	*TapByResourceRequest_Match_Response_Status
+	//	*TapByResourceRequest_Match_Response_GrpcStatus
+	//	*TapByResourceRequest_Match_Response_SlowerThan
+	Match                isTapByResourceRequest_Match_Response_Match `protobuf_oneof:"match"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *TapByResourceRequest_Match_Response) Reset()         { *m = TapByResourceRequest_Match_Response{} }
+func (m *TapByResourceRequest_Match_Response) String() string { return proto.CompactTextString(m) }
+func (*TapByResourceRequest_Match_Response) ProtoMessage()    {}
+func (*TapByResourceRequest_Match_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{9, 0, 2}
+}
+func (m *TapByResourceRequest_Match_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapByResourceRequest_Match_Response.Unmarshal(m, b)
+}
+func (m *TapByResourceRequest_Match_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapByResourceRequest_Match_Response.Marshal(b, m, deterministic)
+}
+func (dst *TapByResourceRequest_Match_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapByResourceRequest_Match_Response.Merge(dst, src)
+}
+func (m *TapByResourceRequest_Match_Response) XXX_Size() int {
+	return xxx_messageInfo_TapByResourceRequest_Match_Response.Size(m)
+}
+func (m *TapByResourceRequest_Match_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapByResourceRequest_Match_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapByResourceRequest_Match_Response proto.InternalMessageInfo
+
+type isTapByResourceRequest_Match_Response_Match interface {
+	isTapByResourceRequest_Match_Response_Match()
+}
+
+type TapByResourceRequest_Match_Response_Status struct {
+	Status *TapByResourceRequest_Match_Response_StatusRange `protobuf:"bytes,1,opt,name=status,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Response_GrpcStatus struct {
+	GrpcStatus uint32 `protobuf:"varint,2,opt,name=grpc_status,json=grpcStatus,proto3,oneof"`
+}
+
+type TapByResourceRequest_Match_Response_SlowerThan struct {
+	SlowerThan *duration.Duration `protobuf:"bytes,3,opt,name=slower_than,json=slowerThan,proto3,oneof"`
+}
+
+func (*TapByResourceRequest_Match_Response_Status) isTapByResourceRequest_Match_Response_Match() {}
+
+func (*TapByResourceRequest_Match_Response_GrpcStatus) isTapByResourceRequest_Match_Response_Match() {
+}
+
+func (*TapByResourceRequest_Match_Response_SlowerThan) isTapByResourceRequest_Match_Response_Match() {
+}
+
+func (m *TapByResourceRequest_Match_Response) GetMatch() isTapByResourceRequest_Match_Response_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *TapByResourceRequest_Match_Response) GetStatus() *TapByResourceRequest_Match_Response_StatusRange {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Response_Status); ok {
+		return x.Status
+	}
+	return nil
+}
+
+func (m *TapByResourceRequest_Match_Response) GetGrpcStatus() uint32 {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Response_GrpcStatus); ok {
+		return x.GrpcStatus
+	}
+	return 0
+}
+
+func (m *TapByResourceRequest_Match_Response) GetSlowerThan() *duration.Duration {
+	if x, ok := m.GetMatch().(*TapByResourceRequest_Match_Response_SlowerThan); ok {
+		return x.SlowerThan
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*TapByResourceRequest_Match_Response) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _TapByResourceRequest_Match_Response_OneofMarshaler, _TapByResourceRequest_Match_Response_OneofUnmarshaler, _TapByResourceRequest_Match_Response_OneofSizer, []interface{}{
+		(*TapByResourceRequest_Match_Response_Status)(nil),
+		(*TapByResourceRequest_Match_Response_GrpcStatus)(nil),
+		(*TapByResourceRequest_Match_Response_SlowerThan)(nil),
+	}
+}
+
+func _TapByResourceRequest_Match_Response_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*TapByResourceRequest_Match_Response)
+	// match
+	switch x := m.Match.(type) {
+	case *TapByResourceRequest_Match_Response_Status:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Status); err != nil {
+			return err
+		}
+	case *TapByResourceRequest_Match_Response_GrpcStatus:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.GrpcStatus))
+	case *TapByResourceRequest_Match_Response_SlowerThan:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SlowerThan); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("TapByResourceRequest_Match_Response.Match has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _TapByResourceRequest_Match_Response_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*TapByResourceRequest_Match_Response)
+	switch tag {
+	case 1: // match.status
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TapByResourceRequest_Match_Response_StatusRange)
+		err := b.DecodeMessage(msg)
+		m.Match = &TapByResourceRequest_Match_Response_Status{msg}
+		return true, err
+	case 2: // match.grpc_status
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Match = &TapByResourceRequest_Match_Response_GrpcStatus{uint32(x)}
+		return true, err
+	case 3: // match.slower_than
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(duration.Duration)
+		err := b.DecodeMessage(msg)
+		m.Match = &TapByResourceRequest_Match_Response_SlowerThan{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _TapByResourceRequest_Match_Response_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*TapByResourceRequest_Match_Response)
+	// match
+	switch x := m.Match.(type) {
+	case *TapByResourceRequest_Match_Response_Status:
+		s := proto.Size(x.Status)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TapByResourceRequest_Match_Response_GrpcStatus:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.GrpcStatus))
+	case *TapByResourceRequest_Match_Response_SlowerThan:
+		s := proto.Size(x.SlowerThan)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// An inclusive range of HTTP status codes.
+type TapByResourceRequest_Match_Response_StatusRange struct {
+	Min                  uint32   `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  uint32   `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TapByResourceRequest_Match_Response_StatusRange) Reset() {
+	*m = TapByResourceRequest_Match_Response_StatusRange{}
+}
+func (m *TapByResourceRequest_Match_Response_StatusRange) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TapByResourceRequest_Match_Response_StatusRange) ProtoMessage() {}
+func (*TapByResourceRequest_Match_Response_StatusRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{9, 0, 2, 0}
+}
+func (m *TapByResourceRequest_Match_Response_StatusRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapByResourceRequest_Match_Response_StatusRange.Unmarshal(m, b)
+}
+func (m *TapByResourceRequest_Match_Response_StatusRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapByResourceRequest_Match_Response_StatusRange.Marshal(b, m, deterministic)
+}
+func (dst *TapByResourceRequest_Match_Response_StatusRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapByResourceRequest_Match_Response_StatusRange.Merge(dst, src)
+}
+func (m *TapByResourceRequest_Match_Response_StatusRange) XXX_Size() int {
+	return xxx_messageInfo_TapByResourceRequest_Match_Response_StatusRange.Size(m)
+}
+func (m *TapByResourceRequest_Match_Response_StatusRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapByResourceRequest_Match_Response_StatusRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapByResourceRequest_Match_Response_StatusRange proto.InternalMessageInfo
+
+func (m *TapByResourceRequest_Match_Response_StatusRange) GetMin() uint32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *TapByResourceRequest_Match_Response_StatusRange) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
 type HttpMethod struct {
 	// Types that are valid to be assigned to Type:
 	//	*HttpMethod_Registered_
@@ -1511,7 +1901,7 @@ func (m *HttpMethod) Reset()         { *m = HttpMethod{} }
 func (m *HttpMethod) String() string { return proto.CompactTextString(m) }
 func (*HttpMethod) ProtoMessage()    {}
 func (*HttpMethod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{10}
+	return fileDescriptor_public_070233ff61e27ff7, []int{10}
 }
 func (m *HttpMethod) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HttpMethod.Unmarshal(m, b)
@@ -1647,7 +2037,7 @@ func (m *Scheme) Reset()         { *m = Scheme{} }
 func (m *Scheme) String() string { return proto.CompactTextString(m) }
 func (*Scheme) ProtoMessage()    {}
 func (*Scheme) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{11}
+	return fileDescriptor_public_070233ff61e27ff7, []int{11}
 }
 func (m *Scheme) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Scheme.Unmarshal(m, b)
@@ -1783,7 +2173,7 @@ func (m *IPAddress) Reset()         { *m = IPAddress{} }
 func (m *IPAddress) String() string { return proto.CompactTextString(m) }
 func (*IPAddress) ProtoMessage()    {}
 func (*IPAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{12}
+	return fileDescriptor_public_070233ff61e27ff7, []int{12}
 }
 func (m *IPAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IPAddress.Unmarshal(m, b)
@@ -1921,7 +2311,7 @@ func (m *IPv6) Reset()         { *m = IPv6{} }
 func (m *IPv6) String() string { return proto.CompactTextString(m) }
 func (*IPv6) ProtoMessage()    {}
 func (*IPv6) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{13}
+	return fileDescriptor_public_070233ff61e27ff7, []int{13}
 }
 func (m *IPv6) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IPv6.Unmarshal(m, b)
@@ -1967,7 +2357,7 @@ func (m *TcpAddress) Reset()         { *m = TcpAddress{} }
 func (m *TcpAddress) String() string { return proto.CompactTextString(m) }
 func (*TcpAddress) ProtoMessage()    {}
 func (*TcpAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{14}
+	return fileDescriptor_public_070233ff61e27ff7, []int{14}
 }
 func (m *TcpAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpAddress.Unmarshal(m, b)
@@ -2015,7 +2405,7 @@ func (m *Eos) Reset()         { *m = Eos{} }
 func (m *Eos) String() string { return proto.CompactTextString(m) }
 func (*Eos) ProtoMessage()    {}
 func (*Eos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{15}
+	return fileDescriptor_public_070233ff61e27ff7, []int{15}
 }
 func (m *Eos) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Eos.Unmarshal(m, b)
@@ -2145,17 +2535,20 @@ type TapEvent struct {
 	ProxyDirection  TapEvent_ProxyDirection `protobuf:"varint,6,opt,name=proxy_direction,json=proxyDirection,proto3,enum=linkerd2.public.TapEvent_ProxyDirection" json:"proxy_direction,omitempty"`
 	// Types that are valid to be assigned to Event:
 	//	*TapEvent_Http_
-	Event                isTapEvent_Event `protobuf_oneof:"event"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	//	*TapEvent_Sampling_
+	Event isTapEvent_Event `protobuf_oneof:"event"`
+	// The target of the TapByResourceRequest whose pods reported the event.
+	Target               *Resource `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *TapEvent) Reset()         { *m = TapEvent{} }
 func (m *TapEvent) String() string { return proto.CompactTextString(m) }
 func (*TapEvent) ProtoMessage()    {}
 func (*TapEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16}
 }
 func (m *TapEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent.Unmarshal(m, b)
@@ -2225,8 +2618,14 @@ type TapEvent_Http_ struct {
 	Http *TapEvent_Http `protobuf:"bytes,3,opt,name=http,proto3,oneof"`
 }
 
+type TapEvent_Sampling_ struct {
+	Sampling *TapEvent_Sampling `protobuf:"bytes,8,opt,name=sampling,proto3,oneof"`
+}
+
 func (*TapEvent_Http_) isTapEvent_Event() {}
 
+func (*TapEvent_Sampling_) isTapEvent_Event() {}
+
 func (m *TapEvent) GetEvent() isTapEvent_Event {
 	if m != nil {
 		return m.Event
@@ -2241,10 +2640,25 @@ func (m *TapEvent) GetHttp() *TapEvent_Http {
 	return nil
 }
 
+func (m *TapEvent) GetSampling() *TapEvent_Sampling {
+	if x, ok := m.GetEvent().(*TapEvent_Sampling_); ok {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (m *TapEvent) GetTarget() *Resource {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*TapEvent) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _TapEvent_OneofMarshaler, _TapEvent_OneofUnmarshaler, _TapEvent_OneofSizer, []interface{}{
 		(*TapEvent_Http_)(nil),
+		(*TapEvent_Sampling_)(nil),
 	}
 }
 
@@ -2257,6 +2671,11 @@ func _TapEvent_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.Http); err != nil {
 			return err
 		}
+	case *TapEvent_Sampling_:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Sampling); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("TapEvent.Event has unexpected type %T", x)
@@ -2275,6 +2694,14 @@ func _TapEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Event = &TapEvent_Http_{msg}
 		return true, err
+	case 8: // event.sampling
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TapEvent_Sampling)
+		err := b.DecodeMessage(msg)
+		m.Event = &TapEvent_Sampling_{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2289,6 +2716,11 @@ func _TapEvent_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *TapEvent_Sampling_:
+		s := proto.Size(x.Sampling)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2307,7 +2739,7 @@ func (m *TapEvent_EndpointMeta) Reset()         { *m = TapEvent_EndpointMeta{} }
 func (m *TapEvent_EndpointMeta) String() string { return proto.CompactTextString(m) }
 func (*TapEvent_EndpointMeta) ProtoMessage()    {}
 func (*TapEvent_EndpointMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 0}
 }
 func (m *TapEvent_EndpointMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent_EndpointMeta.Unmarshal(m, b)
@@ -2345,7 +2777,7 @@ func (m *TapEvent_RouteMeta) Reset()         { *m = TapEvent_RouteMeta{} }
 func (m *TapEvent_RouteMeta) String() string { return proto.CompactTextString(m) }
 func (*TapEvent_RouteMeta) ProtoMessage()    {}
 func (*TapEvent_RouteMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 1}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 1}
 }
 func (m *TapEvent_RouteMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent_RouteMeta.Unmarshal(m, b)
@@ -2372,28 +2804,105 @@ func (m *TapEvent_RouteMeta) GetLabels() map[string]string {
 	return nil
 }
 
-type TapEvent_Http struct {
-	// Types that are valid to be assigned to Event:
-	//	*TapEvent_Http_RequestInit_
-	//	*TapEvent_Http_ResponseInit_
-	//	*TapEvent_Http_ResponseEnd_
-	Event                isTapEvent_Http_Event `protobuf_oneof:"event"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// Reports how many of a tapped pod's requests were observed over a window.
+// Each pod is only observed up to its share of a tap's maxRps, and the
+// requests beyond it are dropped.
+type TapEvent_Sampling struct {
+	Pod       string `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The length of the window.
+	Window *duration.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// The number of requests that were observed.
+	Observed uint64 `protobuf:"varint,4,opt,name=observed,proto3" json:"observed,omitempty"`
+	// An estimate of the number of requests that were dropped, based on the
+	// rate at which they were observed before the pod's limit was reached.
+	Dropped              uint64   `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TapEvent_Http) Reset()         { *m = TapEvent_Http{} }
-func (m *TapEvent_Http) String() string { return proto.CompactTextString(m) }
-func (*TapEvent_Http) ProtoMessage()    {}
-func (*TapEvent_Http) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 2}
+func (m *TapEvent_Sampling) Reset()         { *m = TapEvent_Sampling{} }
+func (m *TapEvent_Sampling) String() string { return proto.CompactTextString(m) }
+func (*TapEvent_Sampling) ProtoMessage()    {}
+func (*TapEvent_Sampling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 2}
 }
-func (m *TapEvent_Http) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TapEvent_Http.Unmarshal(m, b)
+func (m *TapEvent_Sampling) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapEvent_Sampling.Unmarshal(m, b)
 }
-func (m *TapEvent_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TapEvent_Http.Marshal(b, m, deterministic)
+func (m *TapEvent_Sampling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapEvent_Sampling.Marshal(b, m, deterministic)
+}
+func (dst *TapEvent_Sampling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapEvent_Sampling.Merge(dst, src)
+}
+func (m *TapEvent_Sampling) XXX_Size() int {
+	return xxx_messageInfo_TapEvent_Sampling.Size(m)
+}
+func (m *TapEvent_Sampling) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapEvent_Sampling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapEvent_Sampling proto.InternalMessageInfo
+
+func (m *TapEvent_Sampling) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *TapEvent_Sampling) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *TapEvent_Sampling) GetWindow() *duration.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *TapEvent_Sampling) GetObserved() uint64 {
+	if m != nil {
+		return m.Observed
+	}
+	return 0
+}
+
+func (m *TapEvent_Sampling) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
+	}
+	return 0
+}
+
+type TapEvent_Http struct {
+	// Types that are valid to be assigned to Event:
+	//	*TapEvent_Http_RequestInit_
+	//	*TapEvent_Http_ResponseInit_
+	//	*TapEvent_Http_ResponseEnd_
+	Event                isTapEvent_Http_Event `protobuf_oneof:"event"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TapEvent_Http) Reset()         { *m = TapEvent_Http{} }
+func (m *TapEvent_Http) String() string { return proto.CompactTextString(m) }
+func (*TapEvent_Http) ProtoMessage()    {}
+func (*TapEvent_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 3}
+}
+func (m *TapEvent_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapEvent_Http.Unmarshal(m, b)
+}
+func (m *TapEvent_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapEvent_Http.Marshal(b, m, deterministic)
 }
 func (dst *TapEvent_Http) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_TapEvent_Http.Merge(dst, src)
@@ -2564,7 +3073,7 @@ func (m *TapEvent_Http_StreamId) Reset()         { *m = TapEvent_Http_StreamId{}
 func (m *TapEvent_Http_StreamId) String() string { return proto.CompactTextString(m) }
 func (*TapEvent_Http_StreamId) ProtoMessage()    {}
 func (*TapEvent_Http_StreamId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 2, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 3, 0}
 }
 func (m *TapEvent_Http_StreamId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent_Http_StreamId.Unmarshal(m, b)
@@ -2613,7 +3122,7 @@ func (m *TapEvent_Http_RequestInit) Reset()         { *m = TapEvent_Http_Request
 func (m *TapEvent_Http_RequestInit) String() string { return proto.CompactTextString(m) }
 func (*TapEvent_Http_RequestInit) ProtoMessage()    {}
 func (*TapEvent_Http_RequestInit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 2, 1}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 3, 1}
 }
 func (m *TapEvent_Http_RequestInit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent_Http_RequestInit.Unmarshal(m, b)
@@ -2681,7 +3190,7 @@ func (m *TapEvent_Http_ResponseInit) Reset()         { *m = TapEvent_Http_Respon
 func (m *TapEvent_Http_ResponseInit) String() string { return proto.CompactTextString(m) }
 func (*TapEvent_Http_ResponseInit) ProtoMessage()    {}
 func (*TapEvent_Http_ResponseInit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 2, 2}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 3, 2}
 }
 func (m *TapEvent_Http_ResponseInit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent_Http_ResponseInit.Unmarshal(m, b)
@@ -2737,7 +3246,7 @@ func (m *TapEvent_Http_ResponseEnd) Reset()         { *m = TapEvent_Http_Respons
 func (m *TapEvent_Http_ResponseEnd) String() string { return proto.CompactTextString(m) }
 func (*TapEvent_Http_ResponseEnd) ProtoMessage()    {}
 func (*TapEvent_Http_ResponseEnd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{16, 2, 3}
+	return fileDescriptor_public_070233ff61e27ff7, []int{16, 3, 3}
 }
 func (m *TapEvent_Http_ResponseEnd) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TapEvent_Http_ResponseEnd.Unmarshal(m, b)
@@ -2792,6 +3301,54 @@ func (m *TapEvent_Http_ResponseEnd) GetEos() *Eos {
 	return nil
 }
 
+// A tap event as recorded by `linkerd tap --record`, along with the time at
+// which it was received.
+type TapRecord struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event                *TapEvent            `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TapRecord) Reset()         { *m = TapRecord{} }
+func (m *TapRecord) String() string { return proto.CompactTextString(m) }
+func (*TapRecord) ProtoMessage()    {}
+func (*TapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{17}
+}
+func (m *TapRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TapRecord.Unmarshal(m, b)
+}
+func (m *TapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TapRecord.Marshal(b, m, deterministic)
+}
+func (dst *TapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TapRecord.Merge(dst, src)
+}
+func (m *TapRecord) XXX_Size() int {
+	return xxx_messageInfo_TapRecord.Size(m)
+}
+func (m *TapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TapRecord proto.InternalMessageInfo
+
+func (m *TapRecord) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *TapRecord) GetEvent() *TapEvent {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
 type ApiError struct {
 	Error                string   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2803,7 +3360,7 @@ func (m *ApiError) Reset()         { *m = ApiError{} }
 func (m *ApiError) String() string { return proto.CompactTextString(m) }
 func (*ApiError) ProtoMessage()    {}
 func (*ApiError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{17}
+	return fileDescriptor_public_070233ff61e27ff7, []int{18}
 }
 func (m *ApiError) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApiError.Unmarshal(m, b)
@@ -2841,7 +3398,7 @@ func (m *PodErrors) Reset()         { *m = PodErrors{} }
 func (m *PodErrors) String() string { return proto.CompactTextString(m) }
 func (*PodErrors) ProtoMessage()    {}
 func (*PodErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{18}
+	return fileDescriptor_public_070233ff61e27ff7, []int{19}
 }
 func (m *PodErrors) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PodErrors.Unmarshal(m, b)
@@ -2881,7 +3438,7 @@ func (m *PodErrors_PodError) Reset()         { *m = PodErrors_PodError{} }
 func (m *PodErrors_PodError) String() string { return proto.CompactTextString(m) }
 func (*PodErrors_PodError) ProtoMessage()    {}
 func (*PodErrors_PodError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{18, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{19, 0}
 }
 func (m *PodErrors_PodError) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PodErrors_PodError.Unmarshal(m, b)
@@ -2995,7 +3552,7 @@ func (m *PodErrors_PodError_ContainerError) Reset()         { *m = PodErrors_Pod
 func (m *PodErrors_PodError_ContainerError) String() string { return proto.CompactTextString(m) }
 func (*PodErrors_PodError_ContainerError) ProtoMessage()    {}
 func (*PodErrors_PodError_ContainerError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{18, 0, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{19, 0, 0}
 }
 func (m *PodErrors_PodError_ContainerError) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PodErrors_PodError_ContainerError.Unmarshal(m, b)
@@ -3066,7 +3623,7 @@ func (m *Resource) Reset()         { *m = Resource{} }
 func (m *Resource) String() string { return proto.CompactTextString(m) }
 func (*Resource) ProtoMessage()    {}
 func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{19}
+	return fileDescriptor_public_070233ff61e27ff7, []int{20}
 }
 func (m *Resource) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Resource.Unmarshal(m, b)
@@ -3111,21 +3668,20 @@ type ResourceSelection struct {
 	// Identifies a Kubernetes resource.
 	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	// A string-formatted Kubernetes label selector as passed to `kubectl get
-	// --selector`.
-	//
-	// XXX in the future this may be superceded by a data structure that more
-	// richly describes a parsed label selector.
-	LabelSelector        string   `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// --selector`. Prefer `labels`; if both are set, resources must match both.
+	LabelSelector string `protobuf:"bytes,2,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	// Restricts the selection to resources whose labels match.
+	Labels               *LabelSelector `protobuf:"bytes,3,opt,name=labels,proto3" json:"labels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ResourceSelection) Reset()         { *m = ResourceSelection{} }
 func (m *ResourceSelection) String() string { return proto.CompactTextString(m) }
 func (*ResourceSelection) ProtoMessage()    {}
 func (*ResourceSelection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{20}
+	return fileDescriptor_public_070233ff61e27ff7, []int{21}
 }
 func (m *ResourceSelection) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResourceSelection.Unmarshal(m, b)
@@ -3159,6 +3715,120 @@ func (m *ResourceSelection) GetLabelSelector() string {
 	return ""
 }
 
+func (m *ResourceSelection) GetLabels() *LabelSelector {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+// A parsed Kubernetes label selector. Resources must match all of
+// match_labels and all of match_expressions; an empty selector matches
+// everything.
+type LabelSelector struct {
+	MatchLabels          map[string]string           `protobuf:"bytes,1,rep,name=match_labels,json=matchLabels,proto3" json:"match_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MatchExpressions     []*LabelSelectorRequirement `protobuf:"bytes,2,rep,name=match_expressions,json=matchExpressions,proto3" json:"match_expressions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *LabelSelector) Reset()         { *m = LabelSelector{} }
+func (m *LabelSelector) String() string { return proto.CompactTextString(m) }
+func (*LabelSelector) ProtoMessage()    {}
+func (*LabelSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{22}
+}
+func (m *LabelSelector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelSelector.Unmarshal(m, b)
+}
+func (m *LabelSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelSelector.Marshal(b, m, deterministic)
+}
+func (dst *LabelSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelSelector.Merge(dst, src)
+}
+func (m *LabelSelector) XXX_Size() int {
+	return xxx_messageInfo_LabelSelector.Size(m)
+}
+func (m *LabelSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelSelector proto.InternalMessageInfo
+
+func (m *LabelSelector) GetMatchLabels() map[string]string {
+	if m != nil {
+		return m.MatchLabels
+	}
+	return nil
+}
+
+func (m *LabelSelector) GetMatchExpressions() []*LabelSelectorRequirement {
+	if m != nil {
+		return m.MatchExpressions
+	}
+	return nil
+}
+
+// A set-based label selector requirement, e.g. `tier in (web, api)` or
+// `!canary`.
+type LabelSelectorRequirement struct {
+	Key      string                            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator LabelSelectorRequirement_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=linkerd2.public.LabelSelectorRequirement_Operator" json:"operator,omitempty"`
+	// Must be non-empty for IN and NOT_IN, and empty for EXISTS and
+	// DOES_NOT_EXIST.
+	Values               []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelSelectorRequirement) Reset()         { *m = LabelSelectorRequirement{} }
+func (m *LabelSelectorRequirement) String() string { return proto.CompactTextString(m) }
+func (*LabelSelectorRequirement) ProtoMessage()    {}
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{23}
+}
+func (m *LabelSelectorRequirement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelSelectorRequirement.Unmarshal(m, b)
+}
+func (m *LabelSelectorRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelSelectorRequirement.Marshal(b, m, deterministic)
+}
+func (dst *LabelSelectorRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelSelectorRequirement.Merge(dst, src)
+}
+func (m *LabelSelectorRequirement) XXX_Size() int {
+	return xxx_messageInfo_LabelSelectorRequirement.Size(m)
+}
+func (m *LabelSelectorRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelSelectorRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelSelectorRequirement proto.InternalMessageInfo
+
+func (m *LabelSelectorRequirement) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LabelSelectorRequirement) GetOperator() LabelSelectorRequirement_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return LabelSelectorRequirement_IN
+}
+
+func (m *LabelSelectorRequirement) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
 type ResourceError struct {
 	Resource             *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
 	Error                string    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -3171,7 +3841,7 @@ func (m *ResourceError) Reset()         { *m = ResourceError{} }
 func (m *ResourceError) String() string { return proto.CompactTextString(m) }
 func (*ResourceError) ProtoMessage()    {}
 func (*ResourceError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{21}
+	return fileDescriptor_public_070233ff61e27ff7, []int{24}
 }
 func (m *ResourceError) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResourceError.Unmarshal(m, b)
@@ -3215,6 +3885,8 @@ type StatSummaryRequest struct {
 	Outbound             isStatSummaryRequest_Outbound `protobuf_oneof:"outbound"`
 	SkipStats            bool                          `protobuf:"varint,6,opt,name=skip_stats,json=skipStats,proto3" json:"skip_stats,omitempty"`
 	TcpStats             bool                          `protobuf:"varint,7,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
+	LatencyHistogram     bool                          `protobuf:"varint,8,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	StatusBreakdown      bool                          `protobuf:"varint,9,opt,name=status_breakdown,json=statusBreakdown,proto3" json:"status_breakdown,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -3224,7 +3896,7 @@ func (m *StatSummaryRequest) Reset()         { *m = StatSummaryRequest{} }
 func (m *StatSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryRequest) ProtoMessage()    {}
 func (*StatSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{22}
+	return fileDescriptor_public_070233ff61e27ff7, []int{25}
 }
 func (m *StatSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryRequest.Unmarshal(m, b)
@@ -3322,6 +3994,20 @@ func (m *StatSummaryRequest) GetTcpStats() bool {
 	return false
 }
 
+func (m *StatSummaryRequest) GetLatencyHistogram() bool {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return false
+}
+
+func (m *StatSummaryRequest) GetStatusBreakdown() bool {
+	if m != nil {
+		return m.StatusBreakdown
+	}
+	return false
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*StatSummaryRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _StatSummaryRequest_OneofMarshaler, _StatSummaryRequest_OneofUnmarshaler, _StatSummaryRequest_OneofSizer, []interface{}{
@@ -3415,6 +4101,53 @@ func _StatSummaryRequest_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type WatchStatSummaryRequest struct {
+	Request *StatSummaryRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// how often refreshed stats are pushed, e.g. "10s"; defaults to 10s
+	Interval             string   `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchStatSummaryRequest) Reset()         { *m = WatchStatSummaryRequest{} }
+func (m *WatchStatSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchStatSummaryRequest) ProtoMessage()    {}
+func (*WatchStatSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{26}
+}
+func (m *WatchStatSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchStatSummaryRequest.Unmarshal(m, b)
+}
+func (m *WatchStatSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchStatSummaryRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchStatSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStatSummaryRequest.Merge(dst, src)
+}
+func (m *WatchStatSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchStatSummaryRequest.Size(m)
+}
+func (m *WatchStatSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStatSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchStatSummaryRequest proto.InternalMessageInfo
+
+func (m *WatchStatSummaryRequest) GetRequest() *StatSummaryRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *WatchStatSummaryRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
 type StatSummaryResponse struct {
 	// Types that are valid to be assigned to Response:
 	//	*StatSummaryResponse_Ok_
@@ -3429,7 +4162,7 @@ func (m *StatSummaryResponse) Reset()         { *m = StatSummaryResponse{} }
 func (m *StatSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse) ProtoMessage()    {}
 func (*StatSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{23}
+	return fileDescriptor_public_070233ff61e27ff7, []int{27}
 }
 func (m *StatSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryResponse.Unmarshal(m, b)
@@ -3571,7 +4304,7 @@ func (m *StatSummaryResponse_Ok) Reset()         { *m = StatSummaryResponse_Ok{}
 func (m *StatSummaryResponse_Ok) String() string { return proto.CompactTextString(m) }
 func (*StatSummaryResponse_Ok) ProtoMessage()    {}
 func (*StatSummaryResponse_Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{23, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{27, 0}
 }
 func (m *StatSummaryResponse_Ok) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummaryResponse_Ok.Unmarshal(m, b)
@@ -3599,23 +4332,26 @@ func (m *StatSummaryResponse_Ok) GetStatTables() []*StatTable {
 }
 
 type BasicStats struct {
-	SuccessCount         uint64   `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailureCount         uint64   `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	LatencyMsP50         uint64   `protobuf:"varint,3,opt,name=latency_ms_p50,json=latencyMsP50,proto3" json:"latency_ms_p50,omitempty"`
-	LatencyMsP95         uint64   `protobuf:"varint,4,opt,name=latency_ms_p95,json=latencyMsP95,proto3" json:"latency_ms_p95,omitempty"`
-	LatencyMsP99         uint64   `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
-	ActualSuccessCount   uint64   `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
-	ActualFailureCount   uint64   `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SuccessCount       uint64 `protobuf:"varint,1,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount       uint64 `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	LatencyMsP50       uint64 `protobuf:"varint,3,opt,name=latency_ms_p50,json=latencyMsP50,proto3" json:"latency_ms_p50,omitempty"`
+	LatencyMsP95       uint64 `protobuf:"varint,4,opt,name=latency_ms_p95,json=latencyMsP95,proto3" json:"latency_ms_p95,omitempty"`
+	LatencyMsP99       uint64 `protobuf:"varint,5,opt,name=latency_ms_p99,json=latencyMsP99,proto3" json:"latency_ms_p99,omitempty"`
+	ActualSuccessCount uint64 `protobuf:"varint,6,opt,name=actual_success_count,json=actualSuccessCount,proto3" json:"actual_success_count,omitempty"`
+	ActualFailureCount uint64 `protobuf:"varint,7,opt,name=actual_failure_count,json=actualFailureCount,proto3" json:"actual_failure_count,omitempty"`
+	// Only populated if requested, as it is considerably larger than the
+	// percentiles above.
+	LatencyHistogram     *LatencyHistogram `protobuf:"bytes,8,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *BasicStats) Reset()         { *m = BasicStats{} }
 func (m *BasicStats) String() string { return proto.CompactTextString(m) }
 func (*BasicStats) ProtoMessage()    {}
 func (*BasicStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{24}
+	return fileDescriptor_public_070233ff61e27ff7, []int{28}
 }
 func (m *BasicStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BasicStats.Unmarshal(m, b)
@@ -3684,6 +4420,154 @@ func (m *BasicStats) GetActualFailureCount() uint64 {
 	return 0
 }
 
+func (m *BasicStats) GetLatencyHistogram() *LatencyHistogram {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return nil
+}
+
+// The distribution of response latencies over the time window, as bucketed by
+// the proxy's `response_latency_ms` histogram.
+type LatencyHistogram struct {
+	// Ordered by ascending upper bound.
+	Buckets              []*LatencyHistogram_Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *LatencyHistogram) Reset()         { *m = LatencyHistogram{} }
+func (m *LatencyHistogram) String() string { return proto.CompactTextString(m) }
+func (*LatencyHistogram) ProtoMessage()    {}
+func (*LatencyHistogram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{29}
+}
+func (m *LatencyHistogram) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatencyHistogram.Unmarshal(m, b)
+}
+func (m *LatencyHistogram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatencyHistogram.Marshal(b, m, deterministic)
+}
+func (dst *LatencyHistogram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyHistogram.Merge(dst, src)
+}
+func (m *LatencyHistogram) XXX_Size() int {
+	return xxx_messageInfo_LatencyHistogram.Size(m)
+}
+func (m *LatencyHistogram) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyHistogram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyHistogram proto.InternalMessageInfo
+
+func (m *LatencyHistogram) GetBuckets() []*LatencyHistogram_Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type LatencyHistogram_Bucket struct {
+	// Inclusive upper bound of the bucket, in milliseconds. The last bucket's
+	// bound is +Inf.
+	LeMs float64 `protobuf:"fixed64,1,opt,name=le_ms,json=leMs,proto3" json:"le_ms,omitempty"`
+	// Cumulative count of responses with a latency lower than or equal to
+	// le_ms.
+	Count                uint64   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LatencyHistogram_Bucket) Reset()         { *m = LatencyHistogram_Bucket{} }
+func (m *LatencyHistogram_Bucket) String() string { return proto.CompactTextString(m) }
+func (*LatencyHistogram_Bucket) ProtoMessage()    {}
+func (*LatencyHistogram_Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{29, 0}
+}
+func (m *LatencyHistogram_Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatencyHistogram_Bucket.Unmarshal(m, b)
+}
+func (m *LatencyHistogram_Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatencyHistogram_Bucket.Marshal(b, m, deterministic)
+}
+func (dst *LatencyHistogram_Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyHistogram_Bucket.Merge(dst, src)
+}
+func (m *LatencyHistogram_Bucket) XXX_Size() int {
+	return xxx_messageInfo_LatencyHistogram_Bucket.Size(m)
+}
+func (m *LatencyHistogram_Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyHistogram_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyHistogram_Bucket proto.InternalMessageInfo
+
+func (m *LatencyHistogram_Bucket) GetLeMs() float64 {
+	if m != nil {
+		return m.LeMs
+	}
+	return 0
+}
+
+func (m *LatencyHistogram_Bucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Response counts over the time window, broken down by status.
+type StatusBreakdown struct {
+	// Keyed by HTTP status class, e.g. "2xx" or "5xx".
+	HttpStatusClasses map[string]uint64 `protobuf:"bytes,1,rep,name=http_status_classes,json=httpStatusClasses,proto3" json:"http_status_classes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Keyed by gRPC status code name, e.g. "OK" or "UNAVAILABLE". Only gRPC
+	// responses are counted.
+	GrpcStatusCodes      map[string]uint64 `protobuf:"bytes,2,rep,name=grpc_status_codes,json=grpcStatusCodes,proto3" json:"grpc_status_codes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *StatusBreakdown) Reset()         { *m = StatusBreakdown{} }
+func (m *StatusBreakdown) String() string { return proto.CompactTextString(m) }
+func (*StatusBreakdown) ProtoMessage()    {}
+func (*StatusBreakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{30}
+}
+func (m *StatusBreakdown) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusBreakdown.Unmarshal(m, b)
+}
+func (m *StatusBreakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusBreakdown.Marshal(b, m, deterministic)
+}
+func (dst *StatusBreakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusBreakdown.Merge(dst, src)
+}
+func (m *StatusBreakdown) XXX_Size() int {
+	return xxx_messageInfo_StatusBreakdown.Size(m)
+}
+func (m *StatusBreakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusBreakdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusBreakdown proto.InternalMessageInfo
+
+func (m *StatusBreakdown) GetHttpStatusClasses() map[string]uint64 {
+	if m != nil {
+		return m.HttpStatusClasses
+	}
+	return nil
+}
+
+func (m *StatusBreakdown) GetGrpcStatusCodes() map[string]uint64 {
+	if m != nil {
+		return m.GrpcStatusCodes
+	}
+	return nil
+}
+
 type TcpStats struct {
 	// number of currently open connections
 	OpenConnections uint64 `protobuf:"varint,1,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
@@ -3700,7 +4584,7 @@ func (m *TcpStats) Reset()         { *m = TcpStats{} }
 func (m *TcpStats) String() string { return proto.CompactTextString(m) }
 func (*TcpStats) ProtoMessage()    {}
 func (*TcpStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{25}
+	return fileDescriptor_public_070233ff61e27ff7, []int{31}
 }
 func (m *TcpStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TcpStats.Unmarshal(m, b)
@@ -3754,7 +4638,7 @@ func (m *StatTable) Reset()         { *m = StatTable{} }
 func (m *StatTable) String() string { return proto.CompactTextString(m) }
 func (*StatTable) ProtoMessage()    {}
 func (*StatTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{26}
+	return fileDescriptor_public_070233ff61e27ff7, []int{32}
 }
 func (m *StatTable) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatTable.Unmarshal(m, b)
@@ -3864,7 +4748,7 @@ func (m *StatTable_PodGroup) Reset()         { *m = StatTable_PodGroup{} }
 func (m *StatTable_PodGroup) String() string { return proto.CompactTextString(m) }
 func (*StatTable_PodGroup) ProtoMessage()    {}
 func (*StatTable_PodGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{26, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{32, 0}
 }
 func (m *StatTable_PodGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatTable_PodGroup.Unmarshal(m, b)
@@ -3899,9 +4783,12 @@ type StatTable_PodGroup_Row struct {
 	// number of pending or running pods in this resource
 	RunningPodCount uint64 `protobuf:"varint,4,opt,name=running_pod_count,json=runningPodCount,proto3" json:"running_pod_count,omitempty"`
 	// number of pods in this resource that have Phase PodFailed
-	FailedPodCount uint64      `protobuf:"varint,6,opt,name=failed_pod_count,json=failedPodCount,proto3" json:"failed_pod_count,omitempty"`
-	Stats          *BasicStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	TcpStats       *TcpStats   `protobuf:"bytes,8,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
+	FailedPodCount  uint64           `protobuf:"varint,6,opt,name=failed_pod_count,json=failedPodCount,proto3" json:"failed_pod_count,omitempty"`
+	Stats           *BasicStats      `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	TcpStats        *TcpStats        `protobuf:"bytes,8,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
+	StatusBreakdown *StatusBreakdown `protobuf:"bytes,9,opt,name=status_breakdown,json=statusBreakdown,proto3" json:"status_breakdown,omitempty"`
+	// Only set on TrafficSplit rows, which hold the stats of one leaf of the split.
+	TsStats *TrafficSplitStats `protobuf:"bytes,10,opt,name=ts_stats,json=tsStats,proto3" json:"ts_stats,omitempty"`
 	// Stores a set of errors for each pod name. If a pod has no errors, it may be omitted.
 	ErrorsByPod          map[string]*PodErrors `protobuf:"bytes,7,rep,name=errors_by_pod,json=errorsByPod,proto3" json:"errors_by_pod,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -3913,7 +4800,7 @@ func (m *StatTable_PodGroup_Row) Reset()         { *m = StatTable_PodGroup_Row{}
 func (m *StatTable_PodGroup_Row) String() string { return proto.CompactTextString(m) }
 func (*StatTable_PodGroup_Row) ProtoMessage()    {}
 func (*StatTable_PodGroup_Row) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{26, 0, 0}
+	return fileDescriptor_public_070233ff61e27ff7, []int{32, 0, 0}
 }
 func (m *StatTable_PodGroup_Row) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatTable_PodGroup_Row.Unmarshal(m, b)
@@ -3982,161 +4869,408 @@ func (m *StatTable_PodGroup_Row) GetTcpStats() *TcpStats {
 	return nil
 }
 
-func (m *StatTable_PodGroup_Row) GetErrorsByPod() map[string]*PodErrors {
+func (m *StatTable_PodGroup_Row) GetStatusBreakdown() *StatusBreakdown {
 	if m != nil {
-		return m.ErrorsByPod
+		return m.StatusBreakdown
 	}
 	return nil
 }
 
-type EdgesRequest struct {
-	Selector             *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *StatTable_PodGroup_Row) GetTsStats() *TrafficSplitStats {
+	if m != nil {
+		return m.TsStats
+	}
+	return nil
 }
 
-func (m *EdgesRequest) Reset()         { *m = EdgesRequest{} }
-func (m *EdgesRequest) String() string { return proto.CompactTextString(m) }
-func (*EdgesRequest) ProtoMessage()    {}
-func (*EdgesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{27}
+func (m *StatTable_PodGroup_Row) GetErrorsByPod() map[string]*PodErrors {
+	if m != nil {
+		return m.ErrorsByPod
+	}
+	return nil
 }
-func (m *EdgesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EdgesRequest.Unmarshal(m, b)
+
+type TrafficSplitStats struct {
+	// The apex service that clients address.
+	Apex string `protobuf:"bytes,1,opt,name=apex,proto3" json:"apex,omitempty"`
+	// The backend service the stats were observed for.
+	Leaf string `protobuf:"bytes,2,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// The weight of the leaf, as configured on the TrafficSplit.
+	Weight               string   `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *EdgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EdgesRequest.Marshal(b, m, deterministic)
+
+func (m *TrafficSplitStats) Reset()         { *m = TrafficSplitStats{} }
+func (m *TrafficSplitStats) String() string { return proto.CompactTextString(m) }
+func (*TrafficSplitStats) ProtoMessage()    {}
+func (*TrafficSplitStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{33}
 }
-func (dst *EdgesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EdgesRequest.Merge(dst, src)
+func (m *TrafficSplitStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficSplitStats.Unmarshal(m, b)
 }
-func (m *EdgesRequest) XXX_Size() int {
-	return xxx_messageInfo_EdgesRequest.Size(m)
+func (m *TrafficSplitStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficSplitStats.Marshal(b, m, deterministic)
 }
-func (m *EdgesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EdgesRequest.DiscardUnknown(m)
+func (dst *TrafficSplitStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficSplitStats.Merge(dst, src)
+}
+func (m *TrafficSplitStats) XXX_Size() int {
+	return xxx_messageInfo_TrafficSplitStats.Size(m)
+}
+func (m *TrafficSplitStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficSplitStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EdgesRequest proto.InternalMessageInfo
+var xxx_messageInfo_TrafficSplitStats proto.InternalMessageInfo
 
-func (m *EdgesRequest) GetSelector() *ResourceSelection {
+func (m *TrafficSplitStats) GetApex() string {
+	if m != nil {
+		return m.Apex
+	}
+	return ""
+}
+
+func (m *TrafficSplitStats) GetLeaf() string {
+	if m != nil {
+		return m.Leaf
+	}
+	return ""
+}
+
+func (m *TrafficSplitStats) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// A request for the history of StatSummary-style stats over a range of time.
+type StatTimeSeriesRequest struct {
+	Selector *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// The window over which each point in the series aggregates traffic.
+	TimeWindow string `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// Types that are valid to be assigned to Outbound:
+	//	*StatTimeSeriesRequest_None
+	//	*StatTimeSeriesRequest_ToResource
+	//	*StatTimeSeriesRequest_FromResource
+	Outbound isStatTimeSeriesRequest_Outbound `protobuf_oneof:"outbound"`
+	// How far back from now the series extends (e.g. "1h").
+	Range string `protobuf:"bytes,6,opt,name=range,proto3" json:"range,omitempty"`
+	// The interval between consecutive points in the series (e.g. "1m").
+	Step                 string   `protobuf:"bytes,7,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatTimeSeriesRequest) Reset()         { *m = StatTimeSeriesRequest{} }
+func (m *StatTimeSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*StatTimeSeriesRequest) ProtoMessage()    {}
+func (*StatTimeSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{34}
+}
+func (m *StatTimeSeriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatTimeSeriesRequest.Unmarshal(m, b)
+}
+func (m *StatTimeSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatTimeSeriesRequest.Marshal(b, m, deterministic)
+}
+func (dst *StatTimeSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTimeSeriesRequest.Merge(dst, src)
+}
+func (m *StatTimeSeriesRequest) XXX_Size() int {
+	return xxx_messageInfo_StatTimeSeriesRequest.Size(m)
+}
+func (m *StatTimeSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTimeSeriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatTimeSeriesRequest proto.InternalMessageInfo
+
+func (m *StatTimeSeriesRequest) GetSelector() *ResourceSelection {
 	if m != nil {
 		return m.Selector
 	}
 	return nil
 }
 
-type EdgesResponse struct {
+func (m *StatTimeSeriesRequest) GetTimeWindow() string {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return ""
+}
+
+type isStatTimeSeriesRequest_Outbound interface {
+	isStatTimeSeriesRequest_Outbound()
+}
+
+type StatTimeSeriesRequest_None struct {
+	None *Empty `protobuf:"bytes,3,opt,name=none,proto3,oneof"`
+}
+
+type StatTimeSeriesRequest_ToResource struct {
+	ToResource *Resource `protobuf:"bytes,4,opt,name=to_resource,json=toResource,proto3,oneof"`
+}
+
+type StatTimeSeriesRequest_FromResource struct {
+	FromResource *Resource `protobuf:"bytes,5,opt,name=from_resource,json=fromResource,proto3,oneof"`
+}
+
+func (*StatTimeSeriesRequest_None) isStatTimeSeriesRequest_Outbound() {}
+
+func (*StatTimeSeriesRequest_ToResource) isStatTimeSeriesRequest_Outbound() {}
+
+func (*StatTimeSeriesRequest_FromResource) isStatTimeSeriesRequest_Outbound() {}
+
+func (m *StatTimeSeriesRequest) GetOutbound() isStatTimeSeriesRequest_Outbound {
+	if m != nil {
+		return m.Outbound
+	}
+	return nil
+}
+
+func (m *StatTimeSeriesRequest) GetNone() *Empty {
+	if x, ok := m.GetOutbound().(*StatTimeSeriesRequest_None); ok {
+		return x.None
+	}
+	return nil
+}
+
+func (m *StatTimeSeriesRequest) GetToResource() *Resource {
+	if x, ok := m.GetOutbound().(*StatTimeSeriesRequest_ToResource); ok {
+		return x.ToResource
+	}
+	return nil
+}
+
+func (m *StatTimeSeriesRequest) GetFromResource() *Resource {
+	if x, ok := m.GetOutbound().(*StatTimeSeriesRequest_FromResource); ok {
+		return x.FromResource
+	}
+	return nil
+}
+
+func (m *StatTimeSeriesRequest) GetRange() string {
+	if m != nil {
+		return m.Range
+	}
+	return ""
+}
+
+func (m *StatTimeSeriesRequest) GetStep() string {
+	if m != nil {
+		return m.Step
+	}
+	return ""
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*StatTimeSeriesRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _StatTimeSeriesRequest_OneofMarshaler, _StatTimeSeriesRequest_OneofUnmarshaler, _StatTimeSeriesRequest_OneofSizer, []interface{}{
+		(*StatTimeSeriesRequest_None)(nil),
+		(*StatTimeSeriesRequest_ToResource)(nil),
+		(*StatTimeSeriesRequest_FromResource)(nil),
+	}
+}
+
+func _StatTimeSeriesRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*StatTimeSeriesRequest)
+	// outbound
+	switch x := m.Outbound.(type) {
+	case *StatTimeSeriesRequest_None:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.None); err != nil {
+			return err
+		}
+	case *StatTimeSeriesRequest_ToResource:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ToResource); err != nil {
+			return err
+		}
+	case *StatTimeSeriesRequest_FromResource:
+		b.EncodeVarint(5<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FromResource); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("StatTimeSeriesRequest.Outbound has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _StatTimeSeriesRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*StatTimeSeriesRequest)
+	switch tag {
+	case 3: // outbound.none
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Empty)
+		err := b.DecodeMessage(msg)
+		m.Outbound = &StatTimeSeriesRequest_None{msg}
+		return true, err
+	case 4: // outbound.to_resource
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Resource)
+		err := b.DecodeMessage(msg)
+		m.Outbound = &StatTimeSeriesRequest_ToResource{msg}
+		return true, err
+	case 5: // outbound.from_resource
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Resource)
+		err := b.DecodeMessage(msg)
+		m.Outbound = &StatTimeSeriesRequest_FromResource{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _StatTimeSeriesRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*StatTimeSeriesRequest)
+	// outbound
+	switch x := m.Outbound.(type) {
+	case *StatTimeSeriesRequest_None:
+		s := proto.Size(x.None)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StatTimeSeriesRequest_ToResource:
+		s := proto.Size(x.ToResource)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *StatTimeSeriesRequest_FromResource:
+		s := proto.Size(x.FromResource)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type StatTimeSeriesResponse struct {
 	// Types that are valid to be assigned to Response:
-	//	*EdgesResponse_Ok_
-	//	*EdgesResponse_Error
-	Response             isEdgesResponse_Response `protobuf_oneof:"response"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	//	*StatTimeSeriesResponse_Ok_
+	//	*StatTimeSeriesResponse_Error
+	Response             isStatTimeSeriesResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
-func (m *EdgesResponse) Reset()         { *m = EdgesResponse{} }
-func (m *EdgesResponse) String() string { return proto.CompactTextString(m) }
-func (*EdgesResponse) ProtoMessage()    {}
-func (*EdgesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{28}
+func (m *StatTimeSeriesResponse) Reset()         { *m = StatTimeSeriesResponse{} }
+func (m *StatTimeSeriesResponse) String() string { return proto.CompactTextString(m) }
+func (*StatTimeSeriesResponse) ProtoMessage()    {}
+func (*StatTimeSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{35}
 }
-func (m *EdgesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EdgesResponse.Unmarshal(m, b)
+func (m *StatTimeSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatTimeSeriesResponse.Unmarshal(m, b)
 }
-func (m *EdgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EdgesResponse.Marshal(b, m, deterministic)
+func (m *StatTimeSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatTimeSeriesResponse.Marshal(b, m, deterministic)
 }
-func (dst *EdgesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EdgesResponse.Merge(dst, src)
+func (dst *StatTimeSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTimeSeriesResponse.Merge(dst, src)
 }
-func (m *EdgesResponse) XXX_Size() int {
-	return xxx_messageInfo_EdgesResponse.Size(m)
+func (m *StatTimeSeriesResponse) XXX_Size() int {
+	return xxx_messageInfo_StatTimeSeriesResponse.Size(m)
 }
-func (m *EdgesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EdgesResponse.DiscardUnknown(m)
+func (m *StatTimeSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTimeSeriesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EdgesResponse proto.InternalMessageInfo
+var xxx_messageInfo_StatTimeSeriesResponse proto.InternalMessageInfo
 
-type isEdgesResponse_Response interface {
-	isEdgesResponse_Response()
+type isStatTimeSeriesResponse_Response interface {
+	isStatTimeSeriesResponse_Response()
 }
 
-type EdgesResponse_Ok_ struct {
-	Ok *EdgesResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+type StatTimeSeriesResponse_Ok_ struct {
+	Ok *StatTimeSeriesResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
 }
 
-type EdgesResponse_Error struct {
+type StatTimeSeriesResponse_Error struct {
 	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
 }
 
-func (*EdgesResponse_Ok_) isEdgesResponse_Response() {}
+func (*StatTimeSeriesResponse_Ok_) isStatTimeSeriesResponse_Response() {}
 
-func (*EdgesResponse_Error) isEdgesResponse_Response() {}
+func (*StatTimeSeriesResponse_Error) isStatTimeSeriesResponse_Response() {}
 
-func (m *EdgesResponse) GetResponse() isEdgesResponse_Response {
+func (m *StatTimeSeriesResponse) GetResponse() isStatTimeSeriesResponse_Response {
 	if m != nil {
 		return m.Response
 	}
 	return nil
 }
 
-func (m *EdgesResponse) GetOk() *EdgesResponse_Ok {
-	if x, ok := m.GetResponse().(*EdgesResponse_Ok_); ok {
+func (m *StatTimeSeriesResponse) GetOk() *StatTimeSeriesResponse_Ok {
+	if x, ok := m.GetResponse().(*StatTimeSeriesResponse_Ok_); ok {
 		return x.Ok
 	}
 	return nil
 }
 
-func (m *EdgesResponse) GetError() *ResourceError {
-	if x, ok := m.GetResponse().(*EdgesResponse_Error); ok {
+func (m *StatTimeSeriesResponse) GetError() *ResourceError {
+	if x, ok := m.GetResponse().(*StatTimeSeriesResponse_Error); ok {
 		return x.Error
 	}
 	return nil
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*EdgesResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _EdgesResponse_OneofMarshaler, _EdgesResponse_OneofUnmarshaler, _EdgesResponse_OneofSizer, []interface{}{
-		(*EdgesResponse_Ok_)(nil),
-		(*EdgesResponse_Error)(nil),
+func (*StatTimeSeriesResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _StatTimeSeriesResponse_OneofMarshaler, _StatTimeSeriesResponse_OneofUnmarshaler, _StatTimeSeriesResponse_OneofSizer, []interface{}{
+		(*StatTimeSeriesResponse_Ok_)(nil),
+		(*StatTimeSeriesResponse_Error)(nil),
 	}
 }
 
-func _EdgesResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*EdgesResponse)
+func _StatTimeSeriesResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*StatTimeSeriesResponse)
 	// response
 	switch x := m.Response.(type) {
-	case *EdgesResponse_Ok_:
+	case *StatTimeSeriesResponse_Ok_:
 		b.EncodeVarint(1<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Ok); err != nil {
 			return err
 		}
-	case *EdgesResponse_Error:
+	case *StatTimeSeriesResponse_Error:
 		b.EncodeVarint(2<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.Error); err != nil {
 			return err
 		}
 	case nil:
 	default:
-		return fmt.Errorf("EdgesResponse.Response has unexpected type %T", x)
+		return fmt.Errorf("StatTimeSeriesResponse.Response has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _EdgesResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*EdgesResponse)
+func _StatTimeSeriesResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*StatTimeSeriesResponse)
 	switch tag {
 	case 1: // response.ok
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(EdgesResponse_Ok)
+		msg := new(StatTimeSeriesResponse_Ok)
 		err := b.DecodeMessage(msg)
-		m.Response = &EdgesResponse_Ok_{msg}
+		m.Response = &StatTimeSeriesResponse_Ok_{msg}
 		return true, err
 	case 2: // response.error
 		if wire != proto.WireBytes {
@@ -4144,23 +5278,23 @@ func _EdgesResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.
 		}
 		msg := new(ResourceError)
 		err := b.DecodeMessage(msg)
-		m.Response = &EdgesResponse_Error{msg}
+		m.Response = &StatTimeSeriesResponse_Error{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _EdgesResponse_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*EdgesResponse)
+func _StatTimeSeriesResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*StatTimeSeriesResponse)
 	// response
 	switch x := m.Response.(type) {
-	case *EdgesResponse_Ok_:
+	case *StatTimeSeriesResponse_Ok_:
 		s := proto.Size(x.Ok)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case *EdgesResponse_Error:
+	case *StatTimeSeriesResponse_Error:
 		s := proto.Size(x.Error)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
@@ -4172,265 +5306,330 @@ func _EdgesResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type EdgesResponse_Ok struct {
-	Edges                []*Edge  `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type StatTimeSeriesResponse_Ok struct {
+	Series               []*StatTimeSeries `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *EdgesResponse_Ok) Reset()         { *m = EdgesResponse_Ok{} }
-func (m *EdgesResponse_Ok) String() string { return proto.CompactTextString(m) }
-func (*EdgesResponse_Ok) ProtoMessage()    {}
-func (*EdgesResponse_Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{28, 0}
+func (m *StatTimeSeriesResponse_Ok) Reset()         { *m = StatTimeSeriesResponse_Ok{} }
+func (m *StatTimeSeriesResponse_Ok) String() string { return proto.CompactTextString(m) }
+func (*StatTimeSeriesResponse_Ok) ProtoMessage()    {}
+func (*StatTimeSeriesResponse_Ok) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{35, 0}
 }
-func (m *EdgesResponse_Ok) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EdgesResponse_Ok.Unmarshal(m, b)
+func (m *StatTimeSeriesResponse_Ok) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatTimeSeriesResponse_Ok.Unmarshal(m, b)
 }
-func (m *EdgesResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EdgesResponse_Ok.Marshal(b, m, deterministic)
+func (m *StatTimeSeriesResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatTimeSeriesResponse_Ok.Marshal(b, m, deterministic)
 }
-func (dst *EdgesResponse_Ok) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EdgesResponse_Ok.Merge(dst, src)
+func (dst *StatTimeSeriesResponse_Ok) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTimeSeriesResponse_Ok.Merge(dst, src)
 }
-func (m *EdgesResponse_Ok) XXX_Size() int {
-	return xxx_messageInfo_EdgesResponse_Ok.Size(m)
+func (m *StatTimeSeriesResponse_Ok) XXX_Size() int {
+	return xxx_messageInfo_StatTimeSeriesResponse_Ok.Size(m)
 }
-func (m *EdgesResponse_Ok) XXX_DiscardUnknown() {
-	xxx_messageInfo_EdgesResponse_Ok.DiscardUnknown(m)
+func (m *StatTimeSeriesResponse_Ok) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTimeSeriesResponse_Ok.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EdgesResponse_Ok proto.InternalMessageInfo
+var xxx_messageInfo_StatTimeSeriesResponse_Ok proto.InternalMessageInfo
 
-func (m *EdgesResponse_Ok) GetEdges() []*Edge {
+func (m *StatTimeSeriesResponse_Ok) GetSeries() []*StatTimeSeries {
 	if m != nil {
-		return m.Edges
+		return m.Series
 	}
 	return nil
 }
 
-type Edge struct {
-	Src                  *Resource `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
-	Dst                  *Resource `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
-	ClientId             string    `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ServerId             string    `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
-	NoIdentityMsg        string    `protobuf:"bytes,5,opt,name=no_identity_msg,json=noIdentityMsg,proto3" json:"no_identity_msg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type StatTimeSeries struct {
+	Resource   *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	TimeWindow string    `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// Points ordered by ascending timestamp.
+	Points               []*StatTimeSeries_Point `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *Edge) Reset()         { *m = Edge{} }
-func (m *Edge) String() string { return proto.CompactTextString(m) }
-func (*Edge) ProtoMessage()    {}
-func (*Edge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{29}
+func (m *StatTimeSeries) Reset()         { *m = StatTimeSeries{} }
+func (m *StatTimeSeries) String() string { return proto.CompactTextString(m) }
+func (*StatTimeSeries) ProtoMessage()    {}
+func (*StatTimeSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{36}
 }
-func (m *Edge) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Edge.Unmarshal(m, b)
+func (m *StatTimeSeries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatTimeSeries.Unmarshal(m, b)
 }
-func (m *Edge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Edge.Marshal(b, m, deterministic)
+func (m *StatTimeSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatTimeSeries.Marshal(b, m, deterministic)
 }
-func (dst *Edge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Edge.Merge(dst, src)
+func (dst *StatTimeSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTimeSeries.Merge(dst, src)
 }
-func (m *Edge) XXX_Size() int {
-	return xxx_messageInfo_Edge.Size(m)
+func (m *StatTimeSeries) XXX_Size() int {
+	return xxx_messageInfo_StatTimeSeries.Size(m)
 }
-func (m *Edge) XXX_DiscardUnknown() {
-	xxx_messageInfo_Edge.DiscardUnknown(m)
+func (m *StatTimeSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTimeSeries.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Edge proto.InternalMessageInfo
+var xxx_messageInfo_StatTimeSeries proto.InternalMessageInfo
 
-func (m *Edge) GetSrc() *Resource {
+func (m *StatTimeSeries) GetResource() *Resource {
 	if m != nil {
-		return m.Src
+		return m.Resource
 	}
 	return nil
 }
 
-func (m *Edge) GetDst() *Resource {
+func (m *StatTimeSeries) GetTimeWindow() string {
 	if m != nil {
-		return m.Dst
+		return m.TimeWindow
 	}
-	return nil
+	return ""
 }
 
-func (m *Edge) GetClientId() string {
+func (m *StatTimeSeries) GetPoints() []*StatTimeSeries_Point {
 	if m != nil {
-		return m.ClientId
+		return m.Points
 	}
-	return ""
+	return nil
 }
 
-func (m *Edge) GetServerId() string {
+type StatTimeSeries_Point struct {
+	// Milliseconds since the Unix epoch.
+	TimestampMs          int64       `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	Stats                *BasicStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StatTimeSeries_Point) Reset()         { *m = StatTimeSeries_Point{} }
+func (m *StatTimeSeries_Point) String() string { return proto.CompactTextString(m) }
+func (*StatTimeSeries_Point) ProtoMessage()    {}
+func (*StatTimeSeries_Point) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{36, 0}
+}
+func (m *StatTimeSeries_Point) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatTimeSeries_Point.Unmarshal(m, b)
+}
+func (m *StatTimeSeries_Point) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatTimeSeries_Point.Marshal(b, m, deterministic)
+}
+func (dst *StatTimeSeries_Point) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatTimeSeries_Point.Merge(dst, src)
+}
+func (m *StatTimeSeries_Point) XXX_Size() int {
+	return xxx_messageInfo_StatTimeSeries_Point.Size(m)
+}
+func (m *StatTimeSeries_Point) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatTimeSeries_Point.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatTimeSeries_Point proto.InternalMessageInfo
+
+func (m *StatTimeSeries_Point) GetTimestampMs() int64 {
 	if m != nil {
-		return m.ServerId
+		return m.TimestampMs
 	}
-	return ""
+	return 0
 }
 
-func (m *Edge) GetNoIdentityMsg() string {
+func (m *StatTimeSeries_Point) GetStats() *BasicStats {
 	if m != nil {
-		return m.NoIdentityMsg
+		return m.Stats
 	}
-	return ""
+	return nil
 }
 
-type TopRoutesRequest struct {
-	Selector   *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
-	TimeWindow string             `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
-	// Types that are valid to be assigned to Outbound:
-	//	*TopRoutesRequest_None
-	//	*TopRoutesRequest_ToResource
-	Outbound             isTopRoutesRequest_Outbound `protobuf_oneof:"outbound"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type EdgesRequest struct {
+	// An empty namespace in the selector's resource returns edges across the
+	// whole mesh.
+	Selector *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	// Edges only carry traffic stats if a time window is given.
+	TimeWindow           string   `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TopRoutesRequest) Reset()         { *m = TopRoutesRequest{} }
-func (m *TopRoutesRequest) String() string { return proto.CompactTextString(m) }
-func (*TopRoutesRequest) ProtoMessage()    {}
-func (*TopRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{30}
+func (m *EdgesRequest) Reset()         { *m = EdgesRequest{} }
+func (m *EdgesRequest) String() string { return proto.CompactTextString(m) }
+func (*EdgesRequest) ProtoMessage()    {}
+func (*EdgesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{37}
 }
-func (m *TopRoutesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TopRoutesRequest.Unmarshal(m, b)
+func (m *EdgesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgesRequest.Unmarshal(m, b)
 }
-func (m *TopRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TopRoutesRequest.Marshal(b, m, deterministic)
+func (m *EdgesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgesRequest.Marshal(b, m, deterministic)
 }
-func (dst *TopRoutesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopRoutesRequest.Merge(dst, src)
+func (dst *EdgesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgesRequest.Merge(dst, src)
 }
-func (m *TopRoutesRequest) XXX_Size() int {
-	return xxx_messageInfo_TopRoutesRequest.Size(m)
+func (m *EdgesRequest) XXX_Size() int {
+	return xxx_messageInfo_EdgesRequest.Size(m)
 }
-func (m *TopRoutesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopRoutesRequest.DiscardUnknown(m)
+func (m *EdgesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopRoutesRequest proto.InternalMessageInfo
+var xxx_messageInfo_EdgesRequest proto.InternalMessageInfo
 
-func (m *TopRoutesRequest) GetSelector() *ResourceSelection {
+func (m *EdgesRequest) GetSelector() *ResourceSelection {
 	if m != nil {
 		return m.Selector
 	}
 	return nil
 }
 
-func (m *TopRoutesRequest) GetTimeWindow() string {
+func (m *EdgesRequest) GetTimeWindow() string {
 	if m != nil {
 		return m.TimeWindow
 	}
 	return ""
 }
 
-type isTopRoutesRequest_Outbound interface {
-	isTopRoutesRequest_Outbound()
+type EdgesResponse struct {
+	// Types that are valid to be assigned to Response:
+	//	*EdgesResponse_Ok_
+	//	*EdgesResponse_Error
+	Response             isEdgesResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-type TopRoutesRequest_None struct {
-	None *Empty `protobuf:"bytes,3,opt,name=none,proto3,oneof"`
+func (m *EdgesResponse) Reset()         { *m = EdgesResponse{} }
+func (m *EdgesResponse) String() string { return proto.CompactTextString(m) }
+func (*EdgesResponse) ProtoMessage()    {}
+func (*EdgesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{38}
+}
+func (m *EdgesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgesResponse.Unmarshal(m, b)
+}
+func (m *EdgesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgesResponse.Marshal(b, m, deterministic)
+}
+func (dst *EdgesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgesResponse.Merge(dst, src)
+}
+func (m *EdgesResponse) XXX_Size() int {
+	return xxx_messageInfo_EdgesResponse.Size(m)
+}
+func (m *EdgesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgesResponse.DiscardUnknown(m)
 }
 
-type TopRoutesRequest_ToResource struct {
-	ToResource *Resource `protobuf:"bytes,7,opt,name=to_resource,json=toResource,proto3,oneof"`
+var xxx_messageInfo_EdgesResponse proto.InternalMessageInfo
+
+type isEdgesResponse_Response interface {
+	isEdgesResponse_Response()
 }
 
-func (*TopRoutesRequest_None) isTopRoutesRequest_Outbound() {}
+type EdgesResponse_Ok_ struct {
+	Ok *EdgesResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
 
-func (*TopRoutesRequest_ToResource) isTopRoutesRequest_Outbound() {}
+type EdgesResponse_Error struct {
+	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
 
-func (m *TopRoutesRequest) GetOutbound() isTopRoutesRequest_Outbound {
+func (*EdgesResponse_Ok_) isEdgesResponse_Response() {}
+
+func (*EdgesResponse_Error) isEdgesResponse_Response() {}
+
+func (m *EdgesResponse) GetResponse() isEdgesResponse_Response {
 	if m != nil {
-		return m.Outbound
+		return m.Response
 	}
 	return nil
 }
 
-func (m *TopRoutesRequest) GetNone() *Empty {
-	if x, ok := m.GetOutbound().(*TopRoutesRequest_None); ok {
-		return x.None
+func (m *EdgesResponse) GetOk() *EdgesResponse_Ok {
+	if x, ok := m.GetResponse().(*EdgesResponse_Ok_); ok {
+		return x.Ok
 	}
 	return nil
 }
 
-func (m *TopRoutesRequest) GetToResource() *Resource {
-	if x, ok := m.GetOutbound().(*TopRoutesRequest_ToResource); ok {
-		return x.ToResource
+func (m *EdgesResponse) GetError() *ResourceError {
+	if x, ok := m.GetResponse().(*EdgesResponse_Error); ok {
+		return x.Error
 	}
 	return nil
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*TopRoutesRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _TopRoutesRequest_OneofMarshaler, _TopRoutesRequest_OneofUnmarshaler, _TopRoutesRequest_OneofSizer, []interface{}{
-		(*TopRoutesRequest_None)(nil),
-		(*TopRoutesRequest_ToResource)(nil),
+func (*EdgesResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _EdgesResponse_OneofMarshaler, _EdgesResponse_OneofUnmarshaler, _EdgesResponse_OneofSizer, []interface{}{
+		(*EdgesResponse_Ok_)(nil),
+		(*EdgesResponse_Error)(nil),
 	}
 }
 
-func _TopRoutesRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*TopRoutesRequest)
-	// outbound
-	switch x := m.Outbound.(type) {
-	case *TopRoutesRequest_None:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.None); err != nil {
+func _EdgesResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*EdgesResponse)
+	// response
+	switch x := m.Response.(type) {
+	case *EdgesResponse_Ok_:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Ok); err != nil {
 			return err
 		}
-	case *TopRoutesRequest_ToResource:
-		b.EncodeVarint(7<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ToResource); err != nil {
+	case *EdgesResponse_Error:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
 			return err
 		}
 	case nil:
 	default:
-		return fmt.Errorf("TopRoutesRequest.Outbound has unexpected type %T", x)
+		return fmt.Errorf("EdgesResponse.Response has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _TopRoutesRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*TopRoutesRequest)
+func _EdgesResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*EdgesResponse)
 	switch tag {
-	case 3: // outbound.none
+	case 1: // response.ok
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(Empty)
+		msg := new(EdgesResponse_Ok)
 		err := b.DecodeMessage(msg)
-		m.Outbound = &TopRoutesRequest_None{msg}
+		m.Response = &EdgesResponse_Ok_{msg}
 		return true, err
-	case 7: // outbound.to_resource
+	case 2: // response.error
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(Resource)
+		msg := new(ResourceError)
 		err := b.DecodeMessage(msg)
-		m.Outbound = &TopRoutesRequest_ToResource{msg}
+		m.Response = &EdgesResponse_Error{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _TopRoutesRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*TopRoutesRequest)
-	// outbound
-	switch x := m.Outbound.(type) {
-	case *TopRoutesRequest_None:
-		s := proto.Size(x.None)
+func _EdgesResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*EdgesResponse)
+	// response
+	switch x := m.Response.(type) {
+	case *EdgesResponse_Ok_:
+		s := proto.Size(x.Ok)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case *TopRoutesRequest_ToResource:
-		s := proto.Size(x.ToResource)
+	case *EdgesResponse_Error:
+		s := proto.Size(x.Error)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
@@ -4441,141 +5640,795 @@ func _TopRoutesRequest_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type TopRoutesResponse struct {
-	// Types that are valid to be assigned to Response:
-	//	*TopRoutesResponse_Error
-	//	*TopRoutesResponse_Ok_
-	Response             isTopRoutesResponse_Response `protobuf_oneof:"response"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+type EdgesResponse_Ok struct {
+	Edges                []*Edge  `protobuf:"bytes,1,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TopRoutesResponse) Reset()         { *m = TopRoutesResponse{} }
-func (m *TopRoutesResponse) String() string { return proto.CompactTextString(m) }
-func (*TopRoutesResponse) ProtoMessage()    {}
-func (*TopRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{31}
+func (m *EdgesResponse_Ok) Reset()         { *m = EdgesResponse_Ok{} }
+func (m *EdgesResponse_Ok) String() string { return proto.CompactTextString(m) }
+func (*EdgesResponse_Ok) ProtoMessage()    {}
+func (*EdgesResponse_Ok) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{38, 0}
 }
-func (m *TopRoutesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TopRoutesResponse.Unmarshal(m, b)
+func (m *EdgesResponse_Ok) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgesResponse_Ok.Unmarshal(m, b)
+}
+func (m *EdgesResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgesResponse_Ok.Marshal(b, m, deterministic)
+}
+func (dst *EdgesResponse_Ok) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgesResponse_Ok.Merge(dst, src)
+}
+func (m *EdgesResponse_Ok) XXX_Size() int {
+	return xxx_messageInfo_EdgesResponse_Ok.Size(m)
+}
+func (m *EdgesResponse_Ok) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgesResponse_Ok.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgesResponse_Ok proto.InternalMessageInfo
+
+func (m *EdgesResponse_Ok) GetEdges() []*Edge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+type Edge struct {
+	Src           *Resource `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dst           *Resource `protobuf:"bytes,2,opt,name=dst,proto3" json:"dst,omitempty"`
+	ClientId      string    `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ServerId      string    `protobuf:"bytes,4,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	NoIdentityMsg string    `protobuf:"bytes,5,opt,name=no_identity_msg,json=noIdentityMsg,proto3" json:"no_identity_msg,omitempty"`
+	// Traffic sent over the edge during the time window, as observed by the
+	// source's proxy.
+	Stats      *BasicStats `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	TimeWindow string      `protobuf:"bytes,7,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// Either "http", for edges observed through their requests, or "tcp", for
+	// edges only observed through their TCP connections. Only "http" edges
+	// carry `stats`, and only "tcp" edges carry `tcp_stats`.
+	Protocol             string    `protobuf:"bytes,8,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	TcpStats             *TcpStats `protobuf:"bytes,9,opt,name=tcp_stats,json=tcpStats,proto3" json:"tcp_stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *Edge) Reset()         { *m = Edge{} }
+func (m *Edge) String() string { return proto.CompactTextString(m) }
+func (*Edge) ProtoMessage()    {}
+func (*Edge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{39}
+}
+func (m *Edge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Edge.Unmarshal(m, b)
+}
+func (m *Edge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Edge.Marshal(b, m, deterministic)
+}
+func (dst *Edge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Edge.Merge(dst, src)
+}
+func (m *Edge) XXX_Size() int {
+	return xxx_messageInfo_Edge.Size(m)
+}
+func (m *Edge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Edge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Edge proto.InternalMessageInfo
+
+func (m *Edge) GetSrc() *Resource {
+	if m != nil {
+		return m.Src
+	}
+	return nil
+}
+
+func (m *Edge) GetDst() *Resource {
+	if m != nil {
+		return m.Dst
+	}
+	return nil
+}
+
+func (m *Edge) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Edge) GetServerId() string {
+	if m != nil {
+		return m.ServerId
+	}
+	return ""
+}
+
+func (m *Edge) GetNoIdentityMsg() string {
+	if m != nil {
+		return m.NoIdentityMsg
+	}
+	return ""
+}
+
+func (m *Edge) GetStats() *BasicStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *Edge) GetTimeWindow() string {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return ""
+}
+
+func (m *Edge) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *Edge) GetTcpStats() *TcpStats {
+	if m != nil {
+		return m.TcpStats
+	}
+	return nil
+}
+
+type TopRoutesRequest struct {
+	Selector   *ResourceSelection `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	TimeWindow string             `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	// Types that are valid to be assigned to Outbound:
+	//	*TopRoutesRequest_None
+	//	*TopRoutesRequest_ToResource
+	Outbound             isTopRoutesRequest_Outbound `protobuf_oneof:"outbound"`
+	LatencyHistogram     bool                        `protobuf:"varint,8,opt,name=latency_histogram,json=latencyHistogram,proto3" json:"latency_histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *TopRoutesRequest) Reset()         { *m = TopRoutesRequest{} }
+func (m *TopRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*TopRoutesRequest) ProtoMessage()    {}
+func (*TopRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{40}
+}
+func (m *TopRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopRoutesRequest.Unmarshal(m, b)
+}
+func (m *TopRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopRoutesRequest.Marshal(b, m, deterministic)
+}
+func (dst *TopRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopRoutesRequest.Merge(dst, src)
+}
+func (m *TopRoutesRequest) XXX_Size() int {
+	return xxx_messageInfo_TopRoutesRequest.Size(m)
+}
+func (m *TopRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopRoutesRequest proto.InternalMessageInfo
+
+func (m *TopRoutesRequest) GetSelector() *ResourceSelection {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (m *TopRoutesRequest) GetTimeWindow() string {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return ""
+}
+
+type isTopRoutesRequest_Outbound interface {
+	isTopRoutesRequest_Outbound()
+}
+
+type TopRoutesRequest_None struct {
+	None *Empty `protobuf:"bytes,3,opt,name=none,proto3,oneof"`
+}
+
+type TopRoutesRequest_ToResource struct {
+	ToResource *Resource `protobuf:"bytes,7,opt,name=to_resource,json=toResource,proto3,oneof"`
+}
+
+func (*TopRoutesRequest_None) isTopRoutesRequest_Outbound() {}
+
+func (*TopRoutesRequest_ToResource) isTopRoutesRequest_Outbound() {}
+
+func (m *TopRoutesRequest) GetOutbound() isTopRoutesRequest_Outbound {
+	if m != nil {
+		return m.Outbound
+	}
+	return nil
+}
+
+func (m *TopRoutesRequest) GetNone() *Empty {
+	if x, ok := m.GetOutbound().(*TopRoutesRequest_None); ok {
+		return x.None
+	}
+	return nil
+}
+
+func (m *TopRoutesRequest) GetToResource() *Resource {
+	if x, ok := m.GetOutbound().(*TopRoutesRequest_ToResource); ok {
+		return x.ToResource
+	}
+	return nil
+}
+
+func (m *TopRoutesRequest) GetLatencyHistogram() bool {
+	if m != nil {
+		return m.LatencyHistogram
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*TopRoutesRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _TopRoutesRequest_OneofMarshaler, _TopRoutesRequest_OneofUnmarshaler, _TopRoutesRequest_OneofSizer, []interface{}{
+		(*TopRoutesRequest_None)(nil),
+		(*TopRoutesRequest_ToResource)(nil),
+	}
+}
+
+func _TopRoutesRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*TopRoutesRequest)
+	// outbound
+	switch x := m.Outbound.(type) {
+	case *TopRoutesRequest_None:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.None); err != nil {
+			return err
+		}
+	case *TopRoutesRequest_ToResource:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ToResource); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("TopRoutesRequest.Outbound has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _TopRoutesRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*TopRoutesRequest)
+	switch tag {
+	case 3: // outbound.none
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Empty)
+		err := b.DecodeMessage(msg)
+		m.Outbound = &TopRoutesRequest_None{msg}
+		return true, err
+	case 7: // outbound.to_resource
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Resource)
+		err := b.DecodeMessage(msg)
+		m.Outbound = &TopRoutesRequest_ToResource{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _TopRoutesRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*TopRoutesRequest)
+	// outbound
+	switch x := m.Outbound.(type) {
+	case *TopRoutesRequest_None:
+		s := proto.Size(x.None)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TopRoutesRequest_ToResource:
+		s := proto.Size(x.ToResource)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type TopRoutesResponse struct {
+	// Types that are valid to be assigned to Response:
+	//	*TopRoutesResponse_Error
+	//	*TopRoutesResponse_Ok_
+	Response             isTopRoutesResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *TopRoutesResponse) Reset()         { *m = TopRoutesResponse{} }
+func (m *TopRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*TopRoutesResponse) ProtoMessage()    {}
+func (*TopRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{41}
+}
+func (m *TopRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopRoutesResponse.Unmarshal(m, b)
 }
 func (m *TopRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	return xxx_messageInfo_TopRoutesResponse.Marshal(b, m, deterministic)
 }
-func (dst *TopRoutesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopRoutesResponse.Merge(dst, src)
+func (dst *TopRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopRoutesResponse.Merge(dst, src)
+}
+func (m *TopRoutesResponse) XXX_Size() int {
+	return xxx_messageInfo_TopRoutesResponse.Size(m)
+}
+func (m *TopRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopRoutesResponse proto.InternalMessageInfo
+
+type isTopRoutesResponse_Response interface {
+	isTopRoutesResponse_Response()
+}
+
+type TopRoutesResponse_Error struct {
+	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+type TopRoutesResponse_Ok_ struct {
+	Ok *TopRoutesResponse_Ok `protobuf:"bytes,3,opt,name=ok,proto3,oneof"`
+}
+
+func (*TopRoutesResponse_Error) isTopRoutesResponse_Response() {}
+
+func (*TopRoutesResponse_Ok_) isTopRoutesResponse_Response() {}
+
+func (m *TopRoutesResponse) GetResponse() isTopRoutesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *TopRoutesResponse) GetError() *ResourceError {
+	if x, ok := m.GetResponse().(*TopRoutesResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *TopRoutesResponse) GetOk() *TopRoutesResponse_Ok {
+	if x, ok := m.GetResponse().(*TopRoutesResponse_Ok_); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*TopRoutesResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _TopRoutesResponse_OneofMarshaler, _TopRoutesResponse_OneofUnmarshaler, _TopRoutesResponse_OneofSizer, []interface{}{
+		(*TopRoutesResponse_Error)(nil),
+		(*TopRoutesResponse_Ok_)(nil),
+	}
+}
+
+func _TopRoutesResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*TopRoutesResponse)
+	// response
+	switch x := m.Response.(type) {
+	case *TopRoutesResponse_Error:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
+			return err
+		}
+	case *TopRoutesResponse_Ok_:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Ok); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("TopRoutesResponse.Response has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _TopRoutesResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*TopRoutesResponse)
+	switch tag {
+	case 2: // response.error
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ResourceError)
+		err := b.DecodeMessage(msg)
+		m.Response = &TopRoutesResponse_Error{msg}
+		return true, err
+	case 3: // response.ok
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TopRoutesResponse_Ok)
+		err := b.DecodeMessage(msg)
+		m.Response = &TopRoutesResponse_Ok_{msg}
+		return true, err
+	default:
+		return false, nil
+	}
 }
-func (m *TopRoutesResponse) XXX_Size() int {
-	return xxx_messageInfo_TopRoutesResponse.Size(m)
+
+func _TopRoutesResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*TopRoutesResponse)
+	// response
+	switch x := m.Response.(type) {
+	case *TopRoutesResponse_Error:
+		s := proto.Size(x.Error)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *TopRoutesResponse_Ok_:
+		s := proto.Size(x.Ok)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
 }
-func (m *TopRoutesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopRoutesResponse.DiscardUnknown(m)
+
+type TopRoutesResponse_Ok struct {
+	Routes               []*RouteTable `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-var xxx_messageInfo_TopRoutesResponse proto.InternalMessageInfo
+func (m *TopRoutesResponse_Ok) Reset()         { *m = TopRoutesResponse_Ok{} }
+func (m *TopRoutesResponse_Ok) String() string { return proto.CompactTextString(m) }
+func (*TopRoutesResponse_Ok) ProtoMessage()    {}
+func (*TopRoutesResponse_Ok) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{41, 0}
+}
+func (m *TopRoutesResponse_Ok) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TopRoutesResponse_Ok.Unmarshal(m, b)
+}
+func (m *TopRoutesResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TopRoutesResponse_Ok.Marshal(b, m, deterministic)
+}
+func (dst *TopRoutesResponse_Ok) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopRoutesResponse_Ok.Merge(dst, src)
+}
+func (m *TopRoutesResponse_Ok) XXX_Size() int {
+	return xxx_messageInfo_TopRoutesResponse_Ok.Size(m)
+}
+func (m *TopRoutesResponse_Ok) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopRoutesResponse_Ok.DiscardUnknown(m)
+}
 
-type isTopRoutesResponse_Response interface {
-	isTopRoutesResponse_Response()
+var xxx_messageInfo_TopRoutesResponse_Ok proto.InternalMessageInfo
+
+func (m *TopRoutesResponse_Ok) GetRoutes() []*RouteTable {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-type TopRoutesResponse_Error struct {
-	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+type RouteTable struct {
+	Rows                 []*RouteTable_Row `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Resource             string            `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-type TopRoutesResponse_Ok_ struct {
-	Ok *TopRoutesResponse_Ok `protobuf:"bytes,3,opt,name=ok,proto3,oneof"`
+func (m *RouteTable) Reset()         { *m = RouteTable{} }
+func (m *RouteTable) String() string { return proto.CompactTextString(m) }
+func (*RouteTable) ProtoMessage()    {}
+func (*RouteTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{42}
+}
+func (m *RouteTable) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteTable.Unmarshal(m, b)
+}
+func (m *RouteTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteTable.Marshal(b, m, deterministic)
+}
+func (dst *RouteTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteTable.Merge(dst, src)
+}
+func (m *RouteTable) XXX_Size() int {
+	return xxx_messageInfo_RouteTable.Size(m)
+}
+func (m *RouteTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteTable.DiscardUnknown(m)
 }
 
-func (*TopRoutesResponse_Error) isTopRoutesResponse_Response() {}
+var xxx_messageInfo_RouteTable proto.InternalMessageInfo
 
-func (*TopRoutesResponse_Ok_) isTopRoutesResponse_Response() {}
+func (m *RouteTable) GetRows() []*RouteTable_Row {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
 
-func (m *TopRoutesResponse) GetResponse() isTopRoutesResponse_Response {
+func (m *RouteTable) GetResource() string {
 	if m != nil {
-		return m.Response
+		return m.Resource
+	}
+	return ""
+}
+
+type RouteTable_Row struct {
+	Route                string      `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	TimeWindow           string      `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	Authority            string      `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
+	Stats                *BasicStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RouteTable_Row) Reset()         { *m = RouteTable_Row{} }
+func (m *RouteTable_Row) String() string { return proto.CompactTextString(m) }
+func (*RouteTable_Row) ProtoMessage()    {}
+func (*RouteTable_Row) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{42, 0}
+}
+func (m *RouteTable_Row) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteTable_Row.Unmarshal(m, b)
+}
+func (m *RouteTable_Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteTable_Row.Marshal(b, m, deterministic)
+}
+func (dst *RouteTable_Row) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteTable_Row.Merge(dst, src)
+}
+func (m *RouteTable_Row) XXX_Size() int {
+	return xxx_messageInfo_RouteTable_Row.Size(m)
+}
+func (m *RouteTable_Row) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteTable_Row.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteTable_Row proto.InternalMessageInfo
+
+func (m *RouteTable_Row) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *RouteTable_Row) GetTimeWindow() string {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return ""
+}
+
+func (m *RouteTable_Row) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *RouteTable_Row) GetStats() *BasicStats {
+	if m != nil {
+		return m.Stats
 	}
 	return nil
 }
 
-func (m *TopRoutesResponse) GetError() *ResourceError {
-	if x, ok := m.GetResponse().(*TopRoutesResponse_Error); ok {
-		return x.Error
+// A request for the error budgets and burn rates of ServiceLevelObjectives.
+type SLOStatusRequest struct {
+	// The namespace of the objectives to evaluate; all namespaces if empty.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The name of a single objective to evaluate; requires a namespace.
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLOStatusRequest) Reset()         { *m = SLOStatusRequest{} }
+func (m *SLOStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SLOStatusRequest) ProtoMessage()    {}
+func (*SLOStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{43}
+}
+func (m *SLOStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatusRequest.Unmarshal(m, b)
+}
+func (m *SLOStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *SLOStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatusRequest.Merge(dst, src)
+}
+func (m *SLOStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_SLOStatusRequest.Size(m)
+}
+func (m *SLOStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatusRequest proto.InternalMessageInfo
+
+func (m *SLOStatusRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SLOStatusRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type SLOStatusResponse struct {
+	// Types that are valid to be assigned to Response:
+	//	*SLOStatusResponse_Ok_
+	//	*SLOStatusResponse_Error
+	Response             isSLOStatusResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SLOStatusResponse) Reset()         { *m = SLOStatusResponse{} }
+func (m *SLOStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SLOStatusResponse) ProtoMessage()    {}
+func (*SLOStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{44}
+}
+func (m *SLOStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatusResponse.Unmarshal(m, b)
+}
+func (m *SLOStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *SLOStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatusResponse.Merge(dst, src)
+}
+func (m *SLOStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_SLOStatusResponse.Size(m)
+}
+func (m *SLOStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatusResponse proto.InternalMessageInfo
+
+type isSLOStatusResponse_Response interface {
+	isSLOStatusResponse_Response()
+}
+
+type SLOStatusResponse_Ok_ struct {
+	Ok *SLOStatusResponse_Ok `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type SLOStatusResponse_Error struct {
+	Error *ResourceError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*SLOStatusResponse_Ok_) isSLOStatusResponse_Response() {}
+
+func (*SLOStatusResponse_Error) isSLOStatusResponse_Response() {}
+
+func (m *SLOStatusResponse) GetResponse() isSLOStatusResponse_Response {
+	if m != nil {
+		return m.Response
 	}
 	return nil
 }
 
-func (m *TopRoutesResponse) GetOk() *TopRoutesResponse_Ok {
-	if x, ok := m.GetResponse().(*TopRoutesResponse_Ok_); ok {
+func (m *SLOStatusResponse) GetOk() *SLOStatusResponse_Ok {
+	if x, ok := m.GetResponse().(*SLOStatusResponse_Ok_); ok {
 		return x.Ok
 	}
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*TopRoutesResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _TopRoutesResponse_OneofMarshaler, _TopRoutesResponse_OneofUnmarshaler, _TopRoutesResponse_OneofSizer, []interface{}{
-		(*TopRoutesResponse_Error)(nil),
-		(*TopRoutesResponse_Ok_)(nil),
+func (m *SLOStatusResponse) GetError() *ResourceError {
+	if x, ok := m.GetResponse().(*SLOStatusResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SLOStatusResponse) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SLOStatusResponse_OneofMarshaler, _SLOStatusResponse_OneofUnmarshaler, _SLOStatusResponse_OneofSizer, []interface{}{
+		(*SLOStatusResponse_Ok_)(nil),
+		(*SLOStatusResponse_Error)(nil),
 	}
 }
 
-func _TopRoutesResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*TopRoutesResponse)
+func _SLOStatusResponse_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SLOStatusResponse)
 	// response
 	switch x := m.Response.(type) {
-	case *TopRoutesResponse_Error:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Error); err != nil {
+	case *SLOStatusResponse_Ok_:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Ok); err != nil {
 			return err
 		}
-	case *TopRoutesResponse_Ok_:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.Ok); err != nil {
+	case *SLOStatusResponse_Error:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Error); err != nil {
 			return err
 		}
 	case nil:
 	default:
-		return fmt.Errorf("TopRoutesResponse.Response has unexpected type %T", x)
+		return fmt.Errorf("SLOStatusResponse.Response has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _TopRoutesResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*TopRoutesResponse)
+func _SLOStatusResponse_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SLOStatusResponse)
 	switch tag {
-	case 2: // response.error
+	case 1: // response.ok
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(ResourceError)
+		msg := new(SLOStatusResponse_Ok)
 		err := b.DecodeMessage(msg)
-		m.Response = &TopRoutesResponse_Error{msg}
+		m.Response = &SLOStatusResponse_Ok_{msg}
 		return true, err
-	case 3: // response.ok
+	case 2: // response.error
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(TopRoutesResponse_Ok)
+		msg := new(ResourceError)
 		err := b.DecodeMessage(msg)
-		m.Response = &TopRoutesResponse_Ok_{msg}
+		m.Response = &SLOStatusResponse_Error{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _TopRoutesResponse_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*TopRoutesResponse)
+func _SLOStatusResponse_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SLOStatusResponse)
 	// response
 	switch x := m.Response.(type) {
-	case *TopRoutesResponse_Error:
-		s := proto.Size(x.Error)
+	case *SLOStatusResponse_Ok_:
+		s := proto.Size(x.Ok)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case *TopRoutesResponse_Ok_:
-		s := proto.Size(x.Ok)
+	case *SLOStatusResponse_Error:
+		s := proto.Size(x.Error)
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
@@ -4586,150 +6439,225 @@ func _TopRoutesResponse_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type TopRoutesResponse_Ok struct {
-	Routes               []*RouteTable `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type SLOStatusResponse_Ok struct {
+	Slos                 []*SLOStatus `protobuf:"bytes,1,rep,name=slos,proto3" json:"slos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *TopRoutesResponse_Ok) Reset()         { *m = TopRoutesResponse_Ok{} }
-func (m *TopRoutesResponse_Ok) String() string { return proto.CompactTextString(m) }
-func (*TopRoutesResponse_Ok) ProtoMessage()    {}
-func (*TopRoutesResponse_Ok) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{31, 0}
+func (m *SLOStatusResponse_Ok) Reset()         { *m = SLOStatusResponse_Ok{} }
+func (m *SLOStatusResponse_Ok) String() string { return proto.CompactTextString(m) }
+func (*SLOStatusResponse_Ok) ProtoMessage()    {}
+func (*SLOStatusResponse_Ok) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{44, 0}
 }
-func (m *TopRoutesResponse_Ok) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TopRoutesResponse_Ok.Unmarshal(m, b)
+func (m *SLOStatusResponse_Ok) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatusResponse_Ok.Unmarshal(m, b)
 }
-func (m *TopRoutesResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TopRoutesResponse_Ok.Marshal(b, m, deterministic)
+func (m *SLOStatusResponse_Ok) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatusResponse_Ok.Marshal(b, m, deterministic)
 }
-func (dst *TopRoutesResponse_Ok) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TopRoutesResponse_Ok.Merge(dst, src)
+func (dst *SLOStatusResponse_Ok) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatusResponse_Ok.Merge(dst, src)
 }
-func (m *TopRoutesResponse_Ok) XXX_Size() int {
-	return xxx_messageInfo_TopRoutesResponse_Ok.Size(m)
+func (m *SLOStatusResponse_Ok) XXX_Size() int {
+	return xxx_messageInfo_SLOStatusResponse_Ok.Size(m)
 }
-func (m *TopRoutesResponse_Ok) XXX_DiscardUnknown() {
-	xxx_messageInfo_TopRoutesResponse_Ok.DiscardUnknown(m)
+func (m *SLOStatusResponse_Ok) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatusResponse_Ok.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TopRoutesResponse_Ok proto.InternalMessageInfo
+var xxx_messageInfo_SLOStatusResponse_Ok proto.InternalMessageInfo
 
-func (m *TopRoutesResponse_Ok) GetRoutes() []*RouteTable {
+func (m *SLOStatusResponse_Ok) GetSlos() []*SLOStatus {
 	if m != nil {
-		return m.Routes
+		return m.Slos
 	}
 	return nil
 }
 
-type RouteTable struct {
-	Rows                 []*RouteTable_Row `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
-	Resource             string            `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type SLOStatus struct {
+	Name      string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string    `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Target    *Resource `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Route     string    `protobuf:"bytes,4,opt,name=route,proto3" json:"route,omitempty"`
+	// The ratio of good requests the objective promises (e.g. 0.999).
+	Objective float64 `protobuf:"fixed64,5,opt,name=objective,proto3" json:"objective,omitempty"`
+	// For latency objectives, requests completing within this threshold are
+	// good; 0 for success rate objectives.
+	LatencyThresholdMs uint32 `protobuf:"varint,6,opt,name=latency_threshold_ms,json=latencyThresholdMs,proto3" json:"latency_threshold_ms,omitempty"`
+	// The window the error budget is computed over (e.g. "30d").
+	Window string `protobuf:"bytes,7,opt,name=window,proto3" json:"window,omitempty"`
+	// Good and total request counts over the window.
+	GoodCount  uint64 `protobuf:"varint,8,opt,name=good_count,json=goodCount,proto3" json:"good_count,omitempty"`
+	TotalCount uint64 `protobuf:"varint,9,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// The fraction of the window's error budget that is left: 1 if untouched,
+	// 0 or less once it has been spent.
+	ErrorBudgetRemaining float64 `protobuf:"fixed64,10,opt,name=error_budget_remaining,json=errorBudgetRemaining,proto3" json:"error_budget_remaining,omitempty"`
+	// Ordered as the objective's burn rate windows.
+	BurnRates []*SLOStatus_BurnRate `protobuf:"bytes,11,rep,name=burn_rates,json=burnRates,proto3" json:"burn_rates,omitempty"`
+	// Set if the objective could not be evaluated.
+	Error                string   `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RouteTable) Reset()         { *m = RouteTable{} }
-func (m *RouteTable) String() string { return proto.CompactTextString(m) }
-func (*RouteTable) ProtoMessage()    {}
-func (*RouteTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{32}
+func (m *SLOStatus) Reset()         { *m = SLOStatus{} }
+func (m *SLOStatus) String() string { return proto.CompactTextString(m) }
+func (*SLOStatus) ProtoMessage()    {}
+func (*SLOStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{45}
 }
-func (m *RouteTable) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RouteTable.Unmarshal(m, b)
+func (m *SLOStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatus.Unmarshal(m, b)
 }
-func (m *RouteTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RouteTable.Marshal(b, m, deterministic)
+func (m *SLOStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatus.Marshal(b, m, deterministic)
 }
-func (dst *RouteTable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RouteTable.Merge(dst, src)
+func (dst *SLOStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatus.Merge(dst, src)
 }
-func (m *RouteTable) XXX_Size() int {
-	return xxx_messageInfo_RouteTable.Size(m)
+func (m *SLOStatus) XXX_Size() int {
+	return xxx_messageInfo_SLOStatus.Size(m)
 }
-func (m *RouteTable) XXX_DiscardUnknown() {
-	xxx_messageInfo_RouteTable.DiscardUnknown(m)
+func (m *SLOStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RouteTable proto.InternalMessageInfo
+var xxx_messageInfo_SLOStatus proto.InternalMessageInfo
 
-func (m *RouteTable) GetRows() []*RouteTable_Row {
+func (m *SLOStatus) GetName() string {
 	if m != nil {
-		return m.Rows
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *RouteTable) GetResource() string {
+func (m *SLOStatus) GetNamespace() string {
 	if m != nil {
-		return m.Resource
+		return m.Namespace
 	}
 	return ""
 }
 
-type RouteTable_Row struct {
-	Route                string      `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
-	TimeWindow           string      `protobuf:"bytes,2,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
-	Authority            string      `protobuf:"bytes,6,opt,name=authority,proto3" json:"authority,omitempty"`
-	Stats                *BasicStats `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *SLOStatus) GetTarget() *Resource {
+	if m != nil {
+		return m.Target
+	}
+	return nil
 }
 
-func (m *RouteTable_Row) Reset()         { *m = RouteTable_Row{} }
-func (m *RouteTable_Row) String() string { return proto.CompactTextString(m) }
-func (*RouteTable_Row) ProtoMessage()    {}
-func (*RouteTable_Row) Descriptor() ([]byte, []int) {
-	return fileDescriptor_public_e3e3347d5819a629, []int{32, 0}
+func (m *SLOStatus) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
 }
-func (m *RouteTable_Row) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RouteTable_Row.Unmarshal(m, b)
+
+func (m *SLOStatus) GetObjective() float64 {
+	if m != nil {
+		return m.Objective
+	}
+	return 0
 }
-func (m *RouteTable_Row) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RouteTable_Row.Marshal(b, m, deterministic)
+
+func (m *SLOStatus) GetLatencyThresholdMs() uint32 {
+	if m != nil {
+		return m.LatencyThresholdMs
+	}
+	return 0
 }
-func (dst *RouteTable_Row) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RouteTable_Row.Merge(dst, src)
+
+func (m *SLOStatus) GetWindow() string {
+	if m != nil {
+		return m.Window
+	}
+	return ""
 }
-func (m *RouteTable_Row) XXX_Size() int {
-	return xxx_messageInfo_RouteTable_Row.Size(m)
+
+func (m *SLOStatus) GetGoodCount() uint64 {
+	if m != nil {
+		return m.GoodCount
+	}
+	return 0
 }
-func (m *RouteTable_Row) XXX_DiscardUnknown() {
-	xxx_messageInfo_RouteTable_Row.DiscardUnknown(m)
+
+func (m *SLOStatus) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_RouteTable_Row proto.InternalMessageInfo
+func (m *SLOStatus) GetErrorBudgetRemaining() float64 {
+	if m != nil {
+		return m.ErrorBudgetRemaining
+	}
+	return 0
+}
 
-func (m *RouteTable_Row) GetRoute() string {
+func (m *SLOStatus) GetBurnRates() []*SLOStatus_BurnRate {
 	if m != nil {
-		return m.Route
+		return m.BurnRates
 	}
-	return ""
+	return nil
 }
 
-func (m *RouteTable_Row) GetTimeWindow() string {
+func (m *SLOStatus) GetError() string {
 	if m != nil {
-		return m.TimeWindow
+		return m.Error
 	}
 	return ""
 }
 
-func (m *RouteTable_Row) GetAuthority() string {
+type SLOStatus_BurnRate struct {
+	Window string `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// How fast the error budget is spent over the window, relative to the
+	// rate that would spend it exactly over the objective's window.
+	Rate                 float64  `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SLOStatus_BurnRate) Reset()         { *m = SLOStatus_BurnRate{} }
+func (m *SLOStatus_BurnRate) String() string { return proto.CompactTextString(m) }
+func (*SLOStatus_BurnRate) ProtoMessage()    {}
+func (*SLOStatus_BurnRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_public_070233ff61e27ff7, []int{45, 0}
+}
+func (m *SLOStatus_BurnRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SLOStatus_BurnRate.Unmarshal(m, b)
+}
+func (m *SLOStatus_BurnRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SLOStatus_BurnRate.Marshal(b, m, deterministic)
+}
+func (dst *SLOStatus_BurnRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SLOStatus_BurnRate.Merge(dst, src)
+}
+func (m *SLOStatus_BurnRate) XXX_Size() int {
+	return xxx_messageInfo_SLOStatus_BurnRate.Size(m)
+}
+func (m *SLOStatus_BurnRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SLOStatus_BurnRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SLOStatus_BurnRate proto.InternalMessageInfo
+
+func (m *SLOStatus_BurnRate) GetWindow() string {
 	if m != nil {
-		return m.Authority
+		return m.Window
 	}
 	return ""
 }
 
-func (m *RouteTable_Row) GetStats() *BasicStats {
+func (m *SLOStatus_BurnRate) GetRate() float64 {
 	if m != nil {
-		return m.Stats
+		return m.Rate
 	}
-	return nil
+	return 0
 }
 
 func init() {
@@ -4746,6 +6674,8 @@ func init() {
 	proto.RegisterType((*TapByResourceRequest_Match)(nil), "linkerd2.public.TapByResourceRequest.Match")
 	proto.RegisterType((*TapByResourceRequest_Match_Seq)(nil), "linkerd2.public.TapByResourceRequest.Match.Seq")
 	proto.RegisterType((*TapByResourceRequest_Match_Http)(nil), "linkerd2.public.TapByResourceRequest.Match.Http")
+	proto.RegisterType((*TapByResourceRequest_Match_Response)(nil), "linkerd2.public.TapByResourceRequest.Match.Response")
+	proto.RegisterType((*TapByResourceRequest_Match_Response_StatusRange)(nil), "linkerd2.public.TapByResourceRequest.Match.Response.StatusRange")
 	proto.RegisterType((*HttpMethod)(nil), "linkerd2.public.HttpMethod")
 	proto.RegisterType((*Scheme)(nil), "linkerd2.public.Scheme")
 	proto.RegisterType((*IPAddress)(nil), "linkerd2.public.IPAddress")
@@ -4757,27 +6687,44 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "linkerd2.public.TapEvent.EndpointMeta.LabelsEntry")
 	proto.RegisterType((*TapEvent_RouteMeta)(nil), "linkerd2.public.TapEvent.RouteMeta")
 	proto.RegisterMapType((map[string]string)(nil), "linkerd2.public.TapEvent.RouteMeta.LabelsEntry")
+	proto.RegisterType((*TapEvent_Sampling)(nil), "linkerd2.public.TapEvent.Sampling")
 	proto.RegisterType((*TapEvent_Http)(nil), "linkerd2.public.TapEvent.Http")
 	proto.RegisterType((*TapEvent_Http_StreamId)(nil), "linkerd2.public.TapEvent.Http.StreamId")
 	proto.RegisterType((*TapEvent_Http_RequestInit)(nil), "linkerd2.public.TapEvent.Http.RequestInit")
 	proto.RegisterType((*TapEvent_Http_ResponseInit)(nil), "linkerd2.public.TapEvent.Http.ResponseInit")
 	proto.RegisterType((*TapEvent_Http_ResponseEnd)(nil), "linkerd2.public.TapEvent.Http.ResponseEnd")
+	proto.RegisterType((*TapRecord)(nil), "linkerd2.public.TapRecord")
 	proto.RegisterType((*ApiError)(nil), "linkerd2.public.ApiError")
 	proto.RegisterType((*PodErrors)(nil), "linkerd2.public.PodErrors")
 	proto.RegisterType((*PodErrors_PodError)(nil), "linkerd2.public.PodErrors.PodError")
 	proto.RegisterType((*PodErrors_PodError_ContainerError)(nil), "linkerd2.public.PodErrors.PodError.ContainerError")
 	proto.RegisterType((*Resource)(nil), "linkerd2.public.Resource")
 	proto.RegisterType((*ResourceSelection)(nil), "linkerd2.public.ResourceSelection")
+	proto.RegisterType((*LabelSelector)(nil), "linkerd2.public.LabelSelector")
+	proto.RegisterMapType((map[string]string)(nil), "linkerd2.public.LabelSelector.MatchLabelsEntry")
+	proto.RegisterType((*LabelSelectorRequirement)(nil), "linkerd2.public.LabelSelectorRequirement")
 	proto.RegisterType((*ResourceError)(nil), "linkerd2.public.ResourceError")
 	proto.RegisterType((*StatSummaryRequest)(nil), "linkerd2.public.StatSummaryRequest")
+	proto.RegisterType((*WatchStatSummaryRequest)(nil), "linkerd2.public.WatchStatSummaryRequest")
 	proto.RegisterType((*StatSummaryResponse)(nil), "linkerd2.public.StatSummaryResponse")
 	proto.RegisterType((*StatSummaryResponse_Ok)(nil), "linkerd2.public.StatSummaryResponse.Ok")
 	proto.RegisterType((*BasicStats)(nil), "linkerd2.public.BasicStats")
+	proto.RegisterType((*LatencyHistogram)(nil), "linkerd2.public.LatencyHistogram")
+	proto.RegisterType((*LatencyHistogram_Bucket)(nil), "linkerd2.public.LatencyHistogram.Bucket")
+	proto.RegisterType((*StatusBreakdown)(nil), "linkerd2.public.StatusBreakdown")
+	proto.RegisterMapType((map[string]uint64)(nil), "linkerd2.public.StatusBreakdown.GrpcStatusCodesEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "linkerd2.public.StatusBreakdown.HttpStatusClassesEntry")
 	proto.RegisterType((*TcpStats)(nil), "linkerd2.public.TcpStats")
 	proto.RegisterType((*StatTable)(nil), "linkerd2.public.StatTable")
 	proto.RegisterType((*StatTable_PodGroup)(nil), "linkerd2.public.StatTable.PodGroup")
 	proto.RegisterType((*StatTable_PodGroup_Row)(nil), "linkerd2.public.StatTable.PodGroup.Row")
 	proto.RegisterMapType((map[string]*PodErrors)(nil), "linkerd2.public.StatTable.PodGroup.Row.ErrorsByPodEntry")
+	proto.RegisterType((*TrafficSplitStats)(nil), "linkerd2.public.TrafficSplitStats")
+	proto.RegisterType((*StatTimeSeriesRequest)(nil), "linkerd2.public.StatTimeSeriesRequest")
+	proto.RegisterType((*StatTimeSeriesResponse)(nil), "linkerd2.public.StatTimeSeriesResponse")
+	proto.RegisterType((*StatTimeSeriesResponse_Ok)(nil), "linkerd2.public.StatTimeSeriesResponse.Ok")
+	proto.RegisterType((*StatTimeSeries)(nil), "linkerd2.public.StatTimeSeries")
+	proto.RegisterType((*StatTimeSeries_Point)(nil), "linkerd2.public.StatTimeSeries.Point")
 	proto.RegisterType((*EdgesRequest)(nil), "linkerd2.public.EdgesRequest")
 	proto.RegisterType((*EdgesResponse)(nil), "linkerd2.public.EdgesResponse")
 	proto.RegisterType((*EdgesResponse_Ok)(nil), "linkerd2.public.EdgesResponse.Ok")
@@ -4787,9 +6734,16 @@ func init() {
 	proto.RegisterType((*TopRoutesResponse_Ok)(nil), "linkerd2.public.TopRoutesResponse.Ok")
 	proto.RegisterType((*RouteTable)(nil), "linkerd2.public.RouteTable")
 	proto.RegisterType((*RouteTable_Row)(nil), "linkerd2.public.RouteTable.Row")
+	proto.RegisterType((*SLOStatusRequest)(nil), "linkerd2.public.SLOStatusRequest")
+	proto.RegisterType((*SLOStatusResponse)(nil), "linkerd2.public.SLOStatusResponse")
+	proto.RegisterType((*SLOStatusResponse_Ok)(nil), "linkerd2.public.SLOStatusResponse.Ok")
+	proto.RegisterType((*SLOStatus)(nil), "linkerd2.public.SLOStatus")
+	proto.RegisterType((*SLOStatus_BurnRate)(nil), "linkerd2.public.SLOStatus.BurnRate")
+	proto.RegisterEnum("linkerd2.public.ListPodsRequest_Condition", ListPodsRequest_Condition_name, ListPodsRequest_Condition_value)
 	proto.RegisterEnum("linkerd2.public.HttpMethod_Registered", HttpMethod_Registered_name, HttpMethod_Registered_value)
 	proto.RegisterEnum("linkerd2.public.Scheme_Registered", Scheme_Registered_name, Scheme_Registered_value)
 	proto.RegisterEnum("linkerd2.public.TapEvent_ProxyDirection", TapEvent_ProxyDirection_name, TapEvent_ProxyDirection_value)
+	proto.RegisterEnum("linkerd2.public.LabelSelectorRequirement_Operator", LabelSelectorRequirement_Operator_name, LabelSelectorRequirement_Operator_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4805,8 +6759,12 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ApiClient interface {
 	StatSummary(ctx context.Context, in *StatSummaryRequest, opts ...grpc.CallOption) (*StatSummaryResponse, error)
+	// Pushes a refreshed StatSummaryResponse at the requested interval.
+	WatchStatSummary(ctx context.Context, in *WatchStatSummaryRequest, opts ...grpc.CallOption) (Api_WatchStatSummaryClient, error)
+	StatTimeSeries(ctx context.Context, in *StatTimeSeriesRequest, opts ...grpc.CallOption) (*StatTimeSeriesResponse, error)
 	Edges(ctx context.Context, in *EdgesRequest, opts ...grpc.CallOption) (*EdgesResponse, error)
 	TopRoutes(ctx context.Context, in *TopRoutesRequest, opts ...grpc.CallOption) (*TopRoutesResponse, error)
+	SLOStatus(ctx context.Context, in *SLOStatusRequest, opts ...grpc.CallOption) (*SLOStatusResponse, error)
 	ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error)
 	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
 	// Superceded by `TapByResource`.
@@ -4835,6 +6793,47 @@ func (c *apiClient) StatSummary(ctx context.Context, in *StatSummaryRequest, opt
 	return out, nil
 }
 
+func (c *apiClient) WatchStatSummary(ctx context.Context, in *WatchStatSummaryRequest, opts ...grpc.CallOption) (Api_WatchStatSummaryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[0], "/linkerd2.public.Api/WatchStatSummary", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiWatchStatSummaryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_WatchStatSummaryClient interface {
+	Recv() (*StatSummaryResponse, error)
+	grpc.ClientStream
+}
+
+type apiWatchStatSummaryClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiWatchStatSummaryClient) Recv() (*StatSummaryResponse, error) {
+	m := new(StatSummaryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) StatTimeSeries(ctx context.Context, in *StatTimeSeriesRequest, opts ...grpc.CallOption) (*StatTimeSeriesResponse, error) {
+	out := new(StatTimeSeriesResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/StatTimeSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiClient) Edges(ctx context.Context, in *EdgesRequest, opts ...grpc.CallOption) (*EdgesResponse, error) {
 	out := new(EdgesResponse)
 	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/Edges", in, out, opts...)
@@ -4853,6 +6852,15 @@ func (c *apiClient) TopRoutes(ctx context.Context, in *TopRoutesRequest, opts ..
 	return out, nil
 }
 
+func (c *apiClient) SLOStatus(ctx context.Context, in *SLOStatusRequest, opts ...grpc.CallOption) (*SLOStatusResponse, error) {
+	out := new(SLOStatusResponse)
+	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/SLOStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiClient) ListPods(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error) {
 	out := new(ListPodsResponse)
 	err := c.cc.Invoke(ctx, "/linkerd2.public.Api/ListPods", in, out, opts...)
@@ -4873,7 +6881,7 @@ func (c *apiClient) ListServices(ctx context.Context, in *ListServicesRequest, o
 
 // Deprecated: Do not use.
 func (c *apiClient) Tap(ctx context.Context, in *TapRequest, opts ...grpc.CallOption) (Api_TapClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[0], "/linkerd2.public.Api/Tap", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[1], "/linkerd2.public.Api/Tap", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4905,7 +6913,7 @@ func (x *apiTapClient) Recv() (*TapEvent, error) {
 }
 
 func (c *apiClient) TapByResource(ctx context.Context, in *TapByResourceRequest, opts ...grpc.CallOption) (Api_TapByResourceClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[1], "/linkerd2.public.Api/TapByResource", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[2], "/linkerd2.public.Api/TapByResource", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4966,8 +6974,12 @@ func (c *apiClient) Config(ctx context.Context, in *Empty, opts ...grpc.CallOpti
 // ApiServer is the server API for Api service.
 type ApiServer interface {
 	StatSummary(context.Context, *StatSummaryRequest) (*StatSummaryResponse, error)
+	// Pushes a refreshed StatSummaryResponse at the requested interval.
+	WatchStatSummary(*WatchStatSummaryRequest, Api_WatchStatSummaryServer) error
+	StatTimeSeries(context.Context, *StatTimeSeriesRequest) (*StatTimeSeriesResponse, error)
 	Edges(context.Context, *EdgesRequest) (*EdgesResponse, error)
 	TopRoutes(context.Context, *TopRoutesRequest) (*TopRoutesResponse, error)
+	SLOStatus(context.Context, *SLOStatusRequest) (*SLOStatusResponse, error)
 	ListPods(context.Context, *ListPodsRequest) (*ListPodsResponse, error)
 	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
 	// Superceded by `TapByResource`.
@@ -5001,6 +7013,45 @@ func _Api_StatSummary_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_WatchStatSummary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatSummaryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).WatchStatSummary(m, &apiWatchStatSummaryServer{stream})
+}
+
+type Api_WatchStatSummaryServer interface {
+	Send(*StatSummaryResponse) error
+	grpc.ServerStream
+}
+
+type apiWatchStatSummaryServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiWatchStatSummaryServer) Send(m *StatSummaryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_StatTimeSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatTimeSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).StatTimeSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.public.Api/StatTimeSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).StatTimeSeries(ctx, req.(*StatTimeSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Api_Edges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EdgesRequest)
 	if err := dec(in); err != nil {
@@ -5037,6 +7088,24 @@ func _Api_TopRoutes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_SLOStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLOStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SLOStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkerd2.public.Api/SLOStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SLOStatus(ctx, req.(*SLOStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Api_ListPods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPodsRequest)
 	if err := dec(in); err != nil {
@@ -5177,6 +7246,10 @@ var _Api_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StatSummary",
 			Handler:    _Api_StatSummary_Handler,
 		},
+		{
+			MethodName: "StatTimeSeries",
+			Handler:    _Api_StatTimeSeries_Handler,
+		},
 		{
 			MethodName: "Edges",
 			Handler:    _Api_Edges_Handler,
@@ -5185,6 +7258,10 @@ var _Api_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TopRoutes",
 			Handler:    _Api_TopRoutes_Handler,
 		},
+		{
+			MethodName: "SLOStatus",
+			Handler:    _Api_SLOStatus_Handler,
+		},
 		{
 			MethodName: "ListPods",
 			Handler:    _Api_ListPods_Handler,
@@ -5207,6 +7284,11 @@ var _Api_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStatSummary",
+			Handler:       _Api_WatchStatSummary_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Tap",
 			Handler:       _Api_Tap_Handler,
@@ -5221,201 +7303,281 @@ var _Api_serviceDesc = grpc.ServiceDesc{
 	Metadata: "public.proto",
 }
 
-func init() { proto.RegisterFile("public.proto", fileDescriptor_public_e3e3347d5819a629) }
-
-var fileDescriptor_public_e3e3347d5819a629 = []byte{
-	// 3077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x3b, 0x70, 0x23, 0xc7,
-	0xd1, 0xc6, 0xe2, 0x8d, 0x06, 0x40, 0xe2, 0xe6, 0xa8, 0xfb, 0x21, 0x48, 0x3a, 0xdd, 0xed, 0x3d,
-	0xc4, 0xff, 0xee, 0xff, 0x41, 0x1e, 0xa9, 0x3b, 0x1d, 0x75, 0xf2, 0x83, 0x20, 0xa1, 0x23, 0x6c,
-	0x1e, 0x09, 0x2d, 0x70, 0x56, 0x95, 0x4a, 0x2e, 0xd4, 0x12, 0x3b, 0x04, 0xd7, 0x5c, 0xec, 0xec,
-	0xed, 0x0e, 0x48, 0x21, 0x75, 0xe4, 0x2a, 0x97, 0xcb, 0x91, 0x63, 0x87, 0x2e, 0xbb, 0x9c, 0x38,
-	0x71, 0xa2, 0xcc, 0xa9, 0x9d, 0xba, 0x9c, 0xd9, 0x99, 0x13, 0x95, 0x33, 0x47, 0x0e, 0x5c, 0xae,
-	0x79, 0x2d, 0x76, 0x09, 0x80, 0x8f, 0x93, 0x03, 0x3b, 0xc2, 0x74, 0xcf, 0xd7, 0x3d, 0xdd, 0x33,
-	0x3d, 0xdd, 0x33, 0x83, 0x85, 0x92, 0x37, 0x3a, 0x70, 0xec, 0x7e, 0xdd, 0xf3, 0x09, 0x25, 0x68,
-	0xd1, 0xb1, 0xdd, 0x63, 0xec, 0x5b, 0x6b, 0x75, 0xc1, 0xae, 0xdd, 0x1c, 0x10, 0x32, 0x70, 0xf0,
-	0x0a, 0xef, 0x3e, 0x18, 0x1d, 0xae, 0x58, 0x23, 0xdf, 0xa4, 0x36, 0x71, 0x85, 0x40, 0xad, 0xda,
-	0x27, 0xc3, 0x21, 0x71, 0x57, 0x8e, 0xb0, 0xe9, 0xd0, 0xa3, 0xfe, 0x11, 0xee, 0x1f, 0xcb, 0x9e,
-	0xeb, 0x7d, 0xe2, 0x1e, 0xda, 0x83, 0x15, 0xf1, 0x23, 0x98, 0x7a, 0x0e, 0x32, 0xcd, 0xa1, 0x47,
-	0xc7, 0xfa, 0x2b, 0x28, 0x7e, 0x0f, 0xfb, 0x81, 0x4d, 0xdc, 0x96, 0x7b, 0x48, 0xd0, 0xdb, 0x50,
-	0x18, 0x10, 0xc9, 0xa8, 0x6a, 0xb7, 0xb4, 0xe5, 0x82, 0x31, 0x61, 0xb0, 0xde, 0x83, 0x91, 0xed,
-	0x58, 0xdb, 0x26, 0xc5, 0xd5, 0xa4, 0xe8, 0x0d, 0x19, 0xe8, 0x3e, 0x2c, 0xf8, 0xd8, 0xc1, 0x66,
-	0x80, 0x95, 0x82, 0x14, 0x87, 0x9c, 0xe1, 0xea, 0xeb, 0x70, 0x7d, 0xd7, 0x0e, 0x68, 0x07, 0xfb,
-	0x27, 0x76, 0x1f, 0x07, 0x06, 0x7e, 0x35, 0xc2, 0x01, 0x65, 0xca, 0x5d, 0x73, 0x88, 0x03, 0xcf,
-	0xec, 0x63, 0x35, 0x74, 0xc8, 0xd0, 0x77, 0x61, 0x29, 0x2e, 0x14, 0x78, 0xc4, 0x0d, 0x30, 0x7a,
-	0x1f, 0xf2, 0x81, 0xe4, 0x55, 0xb5, 0x5b, 0xa9, 0xe5, 0xe2, 0x5a, 0xb5, 0x7e, 0x66, 0xee, 0xea,
-	0x52, 0xc8, 0x08, 0x91, 0xfa, 0x33, 0xc8, 0x49, 0x26, 0x42, 0x90, 0x66, 0xa3, 0xc8, 0x11, 0x79,
-	0x3b, 0x6e, 0x4a, 0xf2, 0xac, 0x29, 0x01, 0x2c, 0x32, 0x53, 0xda, 0xc4, 0x0a, 0x6d, 0xbf, 0x35,
-	0x65, 0x7b, 0x23, 0x59, 0xd5, 0x22, 0x42, 0xe8, 0x9b, 0xcc, 0x4e, 0x07, 0xf7, 0x29, 0xf1, 0xb9,
-	0xc6, 0xe2, 0x9a, 0x3e, 0x65, 0xa7, 0x81, 0x03, 0x32, 0xf2, 0xfb, 0xb8, 0xc3, 0x81, 0x36, 0x71,
-	0x8d, 0x50, 0x46, 0xff, 0x08, 0x2a, 0x93, 0x41, 0xa5, 0xef, 0xcb, 0x90, 0xf6, 0x88, 0xa5, 0xfc,
-	0x5e, 0x9a, 0xd2, 0xd7, 0x26, 0x96, 0xc1, 0x11, 0xfa, 0x3f, 0xd2, 0x90, 0x6a, 0x13, 0x6b, 0xa6,
-	0xb3, 0x4b, 0x90, 0xf1, 0x88, 0xd5, 0x6a, 0x4b, 0x47, 0x05, 0x81, 0x6e, 0x01, 0x58, 0xd8, 0x73,
-	0xc8, 0x78, 0x88, 0x5d, 0x2a, 0x16, 0x72, 0x27, 0x61, 0x44, 0x78, 0xe8, 0x36, 0x14, 0x7d, 0xec,
-	0x39, 0x76, 0xdf, 0xec, 0x05, 0x98, 0x56, 0x41, 0x41, 0x24, 0xb3, 0x83, 0x29, 0xfa, 0x00, 0x6e,
-	0x48, 0x8a, 0x79, 0xd3, 0xeb, 0x13, 0x97, 0xfa, 0xc4, 0x71, 0xb0, 0x5f, 0x2d, 0x4a, 0xf4, 0x1b,
-	0x91, 0xfe, 0xad, 0xb0, 0x1b, 0xdd, 0x81, 0x52, 0x40, 0x4d, 0x8a, 0x0f, 0x47, 0x0e, 0x57, 0x5e,
-	0x92, 0xf0, 0xa2, 0xe2, 0x32, 0xed, 0xef, 0x02, 0x58, 0x26, 0x1e, 0x12, 0x97, 0x43, 0xca, 0x12,
-	0x52, 0x10, 0x3c, 0x06, 0x40, 0x90, 0xfa, 0x01, 0x39, 0xa8, 0x2e, 0xc8, 0x1e, 0x46, 0xa0, 0x1b,
-	0x90, 0x65, 0x3a, 0x46, 0x41, 0x35, 0xcd, 0xdd, 0x95, 0x14, 0x9b, 0x05, 0xd3, 0xb2, 0xb0, 0x55,
-	0xcd, 0xdc, 0xd2, 0x96, 0xf3, 0x86, 0x20, 0xd0, 0x16, 0x2c, 0x06, 0xb6, 0xdb, 0xc7, 0xbb, 0x66,
-	0x40, 0x0d, 0xec, 0x11, 0x9f, 0x56, 0xb3, 0x7c, 0xf1, 0xde, 0xac, 0x8b, 0xfd, 0x58, 0x57, 0xfb,
-	0xb1, 0xbe, 0x2d, 0xf7, 0xa3, 0x71, 0x56, 0x02, 0xad, 0xc2, 0xf5, 0x89, 0xe7, 0x7b, 0x61, 0x98,
-	0xe4, 0xf8, 0xf8, 0xb3, 0xba, 0x90, 0x0e, 0x25, 0xc9, 0x6e, 0x3b, 0xa6, 0x8b, 0xab, 0x79, 0x6e,
-	0x53, 0x8c, 0x87, 0x1e, 0x41, 0x76, 0xe4, 0x51, 0x7b, 0x88, 0xab, 0x85, 0x8b, 0x2c, 0x92, 0x40,
-	0x74, 0x13, 0xc0, 0xf3, 0xc9, 0x17, 0x63, 0x03, 0x9b, 0xd6, 0xb8, 0xba, 0xc8, 0x95, 0x46, 0x38,
-	0x6c, 0x58, 0x4e, 0xa9, 0xed, 0x5b, 0xe1, 0x16, 0xc6, 0x78, 0x68, 0x19, 0x16, 0x7d, 0x19, 0xa6,
-	0x0a, 0x76, 0x8d, 0xc3, 0xce, 0xb2, 0x1b, 0x39, 0xc8, 0x90, 0x53, 0x17, 0xfb, 0xfa, 0xaf, 0x92,
-	0x00, 0x5d, 0xd3, 0x53, 0x7b, 0x05, 0x41, 0xca, 0x23, 0x96, 0x08, 0x41, 0xb6, 0x2a, 0x1e, 0xb1,
-	0xce, 0x44, 0x5b, 0x72, 0x46, 0xb4, 0xdd, 0x80, 0xec, 0xd0, 0xfc, 0xc2, 0xf0, 0x02, 0x1e, 0x8b,
-	0x49, 0x43, 0x52, 0x8c, 0x4f, 0x49, 0x9b, 0x2d, 0x0c, 0x5b, 0xcf, 0xb2, 0x21, 0x29, 0x16, 0xe9,
-	0x94, 0xb4, 0xda, 0x7c, 0x39, 0x0b, 0x06, 0x6f, 0xa3, 0x1a, 0xe4, 0x0f, 0x7d, 0x32, 0x6c, 0xab,
-	0x65, 0x2c, 0x1b, 0x21, 0xcd, 0xf4, 0xb0, 0x76, 0xab, 0x2d, 0xd7, 0x45, 0x52, 0x3c, 0x5e, 0xfa,
-	0x47, 0x78, 0x28, 0x16, 0x81, 0xc5, 0x0b, 0xa7, 0xb8, 0x3d, 0x98, 0x1e, 0x11, 0x8b, 0x4f, 0x7f,
-	0xc1, 0x90, 0x14, 0x4b, 0x1d, 0xe6, 0x88, 0x1e, 0x11, 0xdf, 0xa6, 0x63, 0xb1, 0x27, 0x8c, 0x09,
-	0x83, 0x59, 0xe5, 0x99, 0xf4, 0x48, 0x84, 0xbf, 0xc1, 0xdb, 0x1f, 0x26, 0xab, 0x5a, 0x23, 0x0f,
-	0x59, 0x6a, 0xfa, 0x03, 0x4c, 0xf5, 0xbf, 0x66, 0x60, 0xa9, 0x6b, 0x7a, 0x8d, 0xb1, 0x4a, 0x06,
-	0x6a, 0xda, 0x3e, 0x54, 0x10, 0x3e, 0x73, 0x97, 0x4b, 0x1f, 0x52, 0x02, 0x6d, 0x42, 0x66, 0x68,
-	0xd2, 0xfe, 0x91, 0xcc, 0x3c, 0x0f, 0xa7, 0x44, 0x67, 0x8d, 0x58, 0x7f, 0xc1, 0x44, 0x0c, 0x21,
-	0x39, 0x6f, 0xfe, 0x6b, 0xbf, 0x4d, 0x43, 0x86, 0x03, 0xd1, 0x16, 0xa4, 0x4c, 0xc7, 0x91, 0xd6,
-	0xad, 0x5c, 0x61, 0x88, 0x7a, 0x07, 0xbf, 0x62, 0x81, 0x60, 0x3a, 0x0e, 0x57, 0xe2, 0x8e, 0xa5,
-	0x9d, 0xaf, 0xa5, 0xc4, 0x1d, 0xa3, 0x6f, 0x41, 0xca, 0x25, 0x22, 0x69, 0x5d, 0xcd, 0x59, 0xa6,
-	0xc0, 0x25, 0x14, 0xed, 0x40, 0xc9, 0xc2, 0x01, 0xb5, 0x5d, 0xbe, 0x7f, 0x44, 0xaa, 0xb8, 0xd4,
-	0x8c, 0xef, 0x24, 0x8c, 0x98, 0x24, 0xfa, 0x18, 0xd2, 0x47, 0x94, 0x7a, 0x3c, 0x0c, 0x8b, 0x6b,
-	0xab, 0x57, 0x71, 0x68, 0x87, 0x52, 0x6f, 0x27, 0x61, 0x70, 0xf9, 0xda, 0x2e, 0xa4, 0x3a, 0xf8,
-	0x15, 0x6a, 0x42, 0x8e, 0x2f, 0x47, 0x58, 0xec, 0xae, 0xb4, 0x94, 0x4a, 0xb6, 0x36, 0x86, 0x34,
-	0xd3, 0x8e, 0xaa, 0x61, 0x70, 0xab, 0xdd, 0xa8, 0xc2, 0xbb, 0x1a, 0x86, 0xb7, 0xda, 0x8c, 0x2a,
-	0xc0, 0x6f, 0x46, 0x03, 0x5c, 0xd5, 0x85, 0x48, 0x88, 0x2f, 0xc9, 0x10, 0x4f, 0xcb, 0x2e, 0x4e,
-	0xb1, 0x64, 0xc0, 0x07, 0x0f, 0x1b, 0xfa, 0xdf, 0x35, 0x00, 0x66, 0xc4, 0x0b, 0xa1, 0x76, 0x07,
-	0xc0, 0xc7, 0x03, 0x3b, 0xa0, 0xd8, 0xc7, 0x22, 0x39, 0x2c, 0xac, 0xdd, 0x9f, 0x72, 0x6e, 0x22,
-	0x50, 0x37, 0x42, 0xb4, 0x28, 0x3a, 0x8a, 0x42, 0x77, 0xa1, 0x34, 0x72, 0x23, 0xba, 0x94, 0x03,
-	0x31, 0xae, 0xee, 0x02, 0x4c, 0x34, 0xa0, 0x1c, 0xa4, 0x9e, 0x37, 0xbb, 0x95, 0x04, 0xca, 0x43,
-	0xba, 0xbd, 0xdf, 0xe9, 0x56, 0x34, 0xc6, 0x6a, 0xbf, 0xec, 0x56, 0x92, 0x08, 0x20, 0xbb, 0xdd,
-	0xdc, 0x6d, 0x76, 0x9b, 0x95, 0x14, 0x2a, 0x40, 0xa6, 0xbd, 0xd9, 0xdd, 0xda, 0xa9, 0xa4, 0x51,
-	0x11, 0x72, 0xfb, 0xed, 0x6e, 0x6b, 0x7f, 0xaf, 0x53, 0xc9, 0x30, 0x62, 0x6b, 0x7f, 0x6f, 0xaf,
-	0xb9, 0xd5, 0xad, 0x64, 0x99, 0x8e, 0x9d, 0xe6, 0xe6, 0x76, 0x25, 0xc7, 0xe0, 0x5d, 0x63, 0x73,
-	0xab, 0x59, 0xc9, 0x37, 0xb2, 0x90, 0xa6, 0x63, 0x0f, 0xeb, 0x3f, 0xd7, 0x20, 0xdb, 0x11, 0x73,
-	0xbc, 0x3d, 0xc3, 0xe5, 0xe9, 0x18, 0x13, 0xe0, 0xaf, 0xeb, 0xee, 0xed, 0x98, 0xbb, 0xcc, 0xc2,
-	0x6e, 0xb7, 0x5d, 0x49, 0x30, 0x0b, 0x59, 0xab, 0x53, 0xd1, 0x42, 0x0b, 0xbb, 0x50, 0x68, 0xb5,
-	0x37, 0x2d, 0xcb, 0xc7, 0x01, 0x2b, 0x8b, 0x69, 0xdb, 0x3b, 0x79, 0x9f, 0x5b, 0x97, 0x63, 0xab,
-	0xc9, 0x28, 0xf4, 0x90, 0x73, 0x9f, 0xc8, 0x6d, 0xfa, 0xc6, 0x94, 0xcd, 0xad, 0xf6, 0xc9, 0x13,
-	0x09, 0x7e, 0xd2, 0x48, 0x43, 0xd2, 0xf6, 0xf4, 0x55, 0x48, 0x33, 0x2e, 0xab, 0xb3, 0x87, 0xb6,
-	0x1f, 0x88, 0x2c, 0x96, 0x35, 0x04, 0xc1, 0xf2, 0xa2, 0x63, 0x06, 0x22, 0xf3, 0x67, 0x0d, 0xde,
-	0xd6, 0x77, 0x01, 0xba, 0x7d, 0x4f, 0x19, 0xf2, 0x80, 0x69, 0x91, 0xc9, 0xa5, 0x36, 0x63, 0x40,
-	0x89, 0x33, 0x92, 0xb6, 0xc7, 0xb3, 0x2c, 0xcb, 0xf1, 0x49, 0x9e, 0xe3, 0x79, 0x5b, 0xb7, 0x20,
-	0xd5, 0x24, 0x4c, 0x4d, 0x65, 0xe0, 0x7b, 0xfd, 0x9e, 0xa8, 0xfa, 0xbd, 0x3e, 0xb1, 0x44, 0xec,
-	0x97, 0x77, 0x12, 0xc6, 0x02, 0xeb, 0xe9, 0xf0, 0x8e, 0x2d, 0x62, 0x61, 0x86, 0xf5, 0x71, 0x80,
-	0x69, 0x0f, 0xfb, 0x3e, 0xf1, 0x05, 0x36, 0xa9, 0xb0, 0xbc, 0xa7, 0xc9, 0x3a, 0x18, 0xb6, 0x91,
-	0x81, 0x14, 0x76, 0x2d, 0xfd, 0x8f, 0x0b, 0x90, 0xef, 0x9a, 0x5e, 0xf3, 0x84, 0x95, 0xac, 0x75,
-	0xc8, 0x8a, 0x5d, 0x28, 0xcd, 0x7e, 0x6b, 0x7a, 0xaf, 0x86, 0xfe, 0x19, 0x12, 0x8a, 0x9e, 0x43,
-	0x51, 0xb4, 0x7a, 0x43, 0x4c, 0x4d, 0x99, 0x37, 0xee, 0xcf, 0xda, 0xe5, 0x7c, 0x90, 0x7a, 0xd3,
-	0xb5, 0x3c, 0x62, 0xbb, 0xf4, 0x05, 0xa6, 0xa6, 0x01, 0x42, 0x94, 0xb5, 0xd1, 0x37, 0xa0, 0x18,
-	0xc9, 0x44, 0x72, 0xa9, 0xce, 0x35, 0x21, 0x8a, 0x47, 0x9f, 0x40, 0x25, 0x42, 0x0a, 0x63, 0xd2,
-	0x57, 0x32, 0x66, 0x31, 0x22, 0xcf, 0x2d, 0x6a, 0x00, 0xf8, 0x64, 0x44, 0xa5, 0x67, 0x39, 0xae,
-	0xec, 0xce, 0x7c, 0x65, 0x06, 0xc3, 0x72, 0x4d, 0x05, 0x5f, 0x35, 0xd1, 0x27, 0xb0, 0xc8, 0x8f,
-	0x23, 0x3d, 0xcb, 0xf6, 0x45, 0xca, 0xe5, 0x95, 0x7c, 0x61, 0x6d, 0x79, 0xbe, 0xa2, 0x36, 0x13,
-	0xd8, 0x56, 0x78, 0x63, 0xc1, 0x8b, 0xd1, 0xe8, 0x7d, 0x99, 0xa2, 0x45, 0xb9, 0xb8, 0x39, 0x5f,
-	0x4f, 0x2c, 0x21, 0xff, 0x4c, 0x83, 0x52, 0xd4, 0x5d, 0xf4, 0x1d, 0xc8, 0x3a, 0xe6, 0x01, 0x76,
-	0x54, 0x66, 0x5e, 0xbb, 0xdc, 0x34, 0xd5, 0x77, 0xb9, 0x50, 0xd3, 0xa5, 0xfe, 0xd8, 0x90, 0x1a,
-	0x6a, 0x1b, 0x50, 0x8c, 0xb0, 0x51, 0x05, 0x52, 0xc7, 0x78, 0x2c, 0x0f, 0xed, 0xac, 0xc9, 0x76,
-	0xd1, 0x89, 0xe9, 0x8c, 0xd4, 0xe5, 0x44, 0x10, 0x1f, 0x26, 0x9f, 0x6a, 0xb5, 0x9f, 0x6a, 0x50,
-	0x08, 0x67, 0x0e, 0x3d, 0x3f, 0x63, 0xd4, 0xca, 0x25, 0xa6, 0xfb, 0xdf, 0x6d, 0xd1, 0x3f, 0x73,
-	0xb2, 0xda, 0xec, 0x43, 0xc9, 0x17, 0xf5, 0xa8, 0x67, 0xbb, 0xb6, 0x3a, 0xc7, 0x3c, 0x38, 0x7f,
-	0xc2, 0xeb, 0xb2, 0x84, 0xb5, 0x5c, 0x9b, 0xb2, 0x0b, 0x80, 0x3f, 0x21, 0x91, 0x01, 0x65, 0x5f,
-	0xde, 0x85, 0x84, 0xc6, 0x73, 0x8e, 0x37, 0x31, 0x8d, 0x42, 0x46, 0xaa, 0x2c, 0xf9, 0x11, 0x5a,
-	0x18, 0x29, 0x75, 0x62, 0xd7, 0x92, 0x51, 0xf1, 0xe0, 0x92, 0x2a, 0x9b, 0xae, 0x25, 0x8c, 0x0c,
-	0xc9, 0xda, 0x13, 0xc8, 0x77, 0xa8, 0x8f, 0xcd, 0x61, 0x8b, 0x5f, 0xbf, 0x0e, 0xcc, 0x40, 0x66,
-	0x1c, 0x83, 0xb7, 0xc5, 0x85, 0x84, 0xf5, 0x73, 0xeb, 0xd3, 0x86, 0xa4, 0x6a, 0x7f, 0xd6, 0xa0,
-	0x18, 0xf1, 0x1d, 0x7d, 0x00, 0x49, 0xdb, 0x92, 0x73, 0xf6, 0xde, 0x05, 0xe6, 0xa8, 0x01, 0x8d,
-	0xa4, 0x6d, 0xb1, 0x34, 0x14, 0x29, 0xe5, 0xb3, 0x72, 0xc0, 0xa4, 0xaa, 0x86, 0x55, 0x7e, 0x25,
-	0x3c, 0x19, 0x88, 0x09, 0xf8, 0x9f, 0x39, 0x75, 0x29, 0x3c, 0x30, 0xc4, 0xce, 0xbd, 0xe9, 0x79,
-	0xe7, 0xde, 0xcc, 0xe4, 0xdc, 0x5b, 0xfb, 0x8d, 0x06, 0xa5, 0xe8, 0x52, 0xbc, 0xbe, 0x87, 0xcf,
-	0x01, 0xf1, 0x3b, 0x57, 0x2f, 0x16, 0x5e, 0xc9, 0x8b, 0xae, 0x45, 0x15, 0x2e, 0x14, 0x9d, 0xe3,
-	0x77, 0xa1, 0xc8, 0x36, 0xb7, 0xac, 0x0e, 0xdc, 0xf5, 0xb2, 0x01, 0x8c, 0x25, 0xca, 0x42, 0xed,
-	0x97, 0x49, 0xb6, 0x28, 0xe1, 0xe2, 0xfe, 0x07, 0x98, 0xdc, 0x82, 0xeb, 0x4a, 0x51, 0x74, 0x27,
-	0xa4, 0x2e, 0xd2, 0x74, 0x4d, 0x6a, 0x8a, 0xcc, 0xff, 0x3d, 0x58, 0x08, 0x95, 0x1c, 0x8c, 0x29,
-	0x16, 0xe7, 0xde, 0xb4, 0x11, 0x6e, 0xb2, 0x06, 0x63, 0xa2, 0xfb, 0x90, 0xc2, 0x24, 0x90, 0x95,
-	0x69, 0xfa, 0xd1, 0xa1, 0x49, 0x02, 0x83, 0x01, 0xd8, 0x49, 0x0f, 0x33, 0xef, 0xf5, 0xa7, 0xb0,
-	0x10, 0x4f, 0xc1, 0xec, 0xb8, 0xf4, 0x72, 0xef, 0xbb, 0x7b, 0xfb, 0x9f, 0xee, 0x55, 0x12, 0x8c,
-	0x68, 0xed, 0x35, 0xf6, 0x5f, 0xee, 0x6d, 0x57, 0x34, 0x54, 0x82, 0xfc, 0xfe, 0xcb, 0xae, 0xa0,
-	0x92, 0x13, 0x15, 0xb7, 0x20, 0xbf, 0xe9, 0xd9, 0xbc, 0xdc, 0xb2, 0x4c, 0xc3, 0x0b, 0xb2, 0xcc,
-	0x3e, 0x82, 0x60, 0x97, 0xcc, 0x42, 0x9b, 0x58, 0x1c, 0x12, 0xa0, 0x67, 0x90, 0xe5, 0x6c, 0x95,
-	0xf7, 0xee, 0xcc, 0x7a, 0x1b, 0x11, 0xd8, 0xb0, 0x65, 0x48, 0x91, 0xda, 0x5f, 0x34, 0xc8, 0x2b,
-	0x26, 0x32, 0xa0, 0xc0, 0xae, 0xdd, 0xa6, 0xed, 0x62, 0x5f, 0x2e, 0xf4, 0xda, 0x25, 0x94, 0xd5,
-	0xb7, 0x94, 0x10, 0x27, 0xd9, 0x11, 0x39, 0x54, 0x53, 0x3b, 0x81, 0x85, 0x78, 0x37, 0xaa, 0x42,
-	0x6e, 0x88, 0x83, 0xc0, 0x1c, 0xa8, 0xa7, 0x19, 0x45, 0xb2, 0x7d, 0x35, 0x19, 0x5f, 0x3e, 0x45,
-	0x85, 0x0c, 0x36, 0x17, 0xf6, 0x90, 0x49, 0x89, 0x97, 0x36, 0x41, 0xb0, 0x94, 0xe2, 0x63, 0x33,
-	0x20, 0xae, 0x7a, 0xe3, 0x10, 0x14, 0x9f, 0x4e, 0x3e, 0x59, 0x6d, 0xc8, 0xab, 0x1b, 0xc2, 0xf9,
-	0xcf, 0x6e, 0xfc, 0x1a, 0x3d, 0xf6, 0x54, 0x56, 0xe7, 0xed, 0xf0, 0x11, 0x29, 0x35, 0x79, 0x44,
-	0xd2, 0x5f, 0xc1, 0xb5, 0xa9, 0xcb, 0x10, 0x7a, 0x0c, 0x79, 0xf5, 0x28, 0x20, 0xa7, 0xee, 0xcd,
-	0xb9, 0x57, 0x28, 0x23, 0x84, 0xb2, 0x38, 0xe4, 0x55, 0xa7, 0x17, 0x7b, 0x30, 0x2b, 0x18, 0x65,
-	0xce, 0xed, 0xa8, 0x17, 0xb1, 0xcf, 0xa1, 0xac, 0x84, 0xc5, 0x24, 0xbe, 0xe6, 0x70, 0x61, 0x3c,
-	0x25, 0xa3, 0xf1, 0xf4, 0x55, 0x12, 0x10, 0xdb, 0xf4, 0x9d, 0xd1, 0x70, 0x68, 0xfa, 0x63, 0x75,
-	0x0b, 0x8f, 0x3e, 0xe3, 0x69, 0x57, 0x7f, 0xc6, 0x63, 0x19, 0x86, 0xda, 0x43, 0xdc, 0x3b, 0xb5,
-	0x5d, 0x8b, 0x9c, 0xca, 0x21, 0x81, 0xb1, 0x3e, 0xe5, 0x1c, 0xf4, 0x7f, 0x90, 0x76, 0x89, 0xab,
-	0xd2, 0xee, 0x8d, 0xe9, 0xed, 0x35, 0xf4, 0xe8, 0x98, 0x9d, 0x42, 0x18, 0x0a, 0x7d, 0x04, 0x45,
-	0x4a, 0x7a, 0xa1, 0xd7, 0xe9, 0x0b, 0xbc, 0x66, 0x57, 0x07, 0x4a, 0xc2, 0xa5, 0xff, 0x36, 0x94,
-	0x0f, 0x7d, 0x32, 0x9c, 0xc8, 0x67, 0x2e, 0x96, 0x2f, 0x31, 0x89, 0x50, 0xc3, 0x3b, 0x00, 0xc1,
-	0xb1, 0x2d, 0x12, 0x66, 0xc0, 0x4f, 0x62, 0x79, 0xa3, 0xc0, 0x38, 0x6c, 0xea, 0x02, 0xf4, 0x16,
-	0x14, 0x68, 0x5f, 0xf5, 0xe6, 0x78, 0x6f, 0x9e, 0xf6, 0x45, 0x67, 0x03, 0x20, 0x4f, 0x46, 0xf4,
-	0x80, 0x8c, 0x5c, 0x4b, 0xff, 0x93, 0x06, 0xd7, 0x63, 0xb3, 0x2d, 0x5f, 0x38, 0x37, 0x20, 0x49,
-	0x8e, 0xe7, 0xe6, 0xd7, 0x19, 0x12, 0xf5, 0xfd, 0xe3, 0x9d, 0x84, 0x91, 0x24, 0xc7, 0xe8, 0x49,
-	0x74, 0x59, 0x67, 0x9d, 0xeb, 0x62, 0xc1, 0xb3, 0x93, 0x90, 0x0b, 0x5f, 0xdb, 0x84, 0xe4, 0xfe,
-	0x31, 0x7a, 0x06, 0xfc, 0xa9, 0xb1, 0x47, 0xcd, 0x03, 0x27, 0xbc, 0x6c, 0xd7, 0x66, 0x5a, 0xd0,
-	0x65, 0x10, 0x03, 0x02, 0xd5, 0xe4, 0x9e, 0xa9, 0x94, 0xa9, 0xff, 0x3a, 0x09, 0xd0, 0x30, 0x03,
-	0xbb, 0x2f, 0x66, 0xe4, 0x0e, 0x94, 0x83, 0x51, 0xbf, 0x8f, 0x03, 0x76, 0xf7, 0x18, 0xb9, 0xe2,
-	0x10, 0x94, 0x36, 0x4a, 0x92, 0xb9, 0xc5, 0x78, 0x0c, 0x74, 0x68, 0xda, 0xce, 0xc8, 0xc7, 0x12,
-	0x24, 0x4e, 0x06, 0x25, 0xc9, 0x14, 0xa0, 0xbb, 0x6c, 0x97, 0x50, 0xec, 0xf6, 0xc7, 0xbd, 0x61,
-	0xd0, 0xf3, 0x1e, 0xaf, 0xf2, 0x90, 0x49, 0x1b, 0x25, 0xc9, 0x7d, 0x11, 0xb4, 0x1f, 0xaf, 0x9e,
-	0x45, 0x6d, 0x3c, 0x96, 0x39, 0x3d, 0x82, 0xda, 0x78, 0x3c, 0x85, 0xda, 0xe0, 0x91, 0x10, 0x47,
-	0x6d, 0xa0, 0x55, 0x58, 0x32, 0xfb, 0x74, 0x64, 0x3a, 0xbd, 0xb8, 0x0b, 0x59, 0x8e, 0x45, 0xa2,
-	0xaf, 0x13, 0x75, 0x64, 0x22, 0x11, 0xf7, 0x27, 0x17, 0x95, 0xf8, 0x38, 0xe2, 0x95, 0xfe, 0x63,
-	0x0d, 0xf2, 0x5d, 0x19, 0x21, 0xe8, 0x7f, 0xa1, 0x42, 0x3c, 0xcc, 0xdf, 0x8d, 0x5d, 0xb1, 0x93,
-	0x02, 0x39, 0x5f, 0x8b, 0x8c, 0xbf, 0x35, 0x61, 0xa3, 0x65, 0x76, 0x57, 0x33, 0x2d, 0x51, 0xb7,
-	0x7a, 0x94, 0x50, 0xd3, 0x91, 0xb3, 0xb6, 0xc0, 0xf8, 0xbc, 0x72, 0x75, 0x19, 0x17, 0x3d, 0x80,
-	0x6b, 0xa7, 0xbe, 0x4d, 0x71, 0x0c, 0x2a, 0xa6, 0x6e, 0x91, 0x77, 0x4c, 0xb0, 0xfa, 0x2f, 0x32,
-	0x50, 0x08, 0x97, 0x18, 0x35, 0xa0, 0xe0, 0x11, 0xab, 0x37, 0xf0, 0xc9, 0x48, 0xdd, 0x44, 0xef,
-	0xcc, 0x8f, 0x08, 0x56, 0x0a, 0x9e, 0x33, 0xe8, 0x4e, 0xc2, 0xc8, 0x7b, 0xb2, 0x5d, 0xfb, 0x43,
-	0x9a, 0xd7, 0x16, 0x4e, 0xa0, 0x67, 0x90, 0xf6, 0xc9, 0xa9, 0x8a, 0xae, 0xf7, 0x2e, 0xa1, 0xab,
-	0x6e, 0x90, 0x53, 0x83, 0x0b, 0xd5, 0x7e, 0x98, 0x86, 0x94, 0x41, 0x4e, 0x5f, 0x37, 0xeb, 0x5d,
-	0x98, 0x88, 0x96, 0xa1, 0x32, 0xc4, 0xc1, 0x11, 0xb6, 0x7a, 0xcc, 0x69, 0xb1, 0x6e, 0x62, 0x9a,
-	0x16, 0x04, 0xbf, 0x4d, 0x2c, 0xb1, 0xca, 0x0f, 0xe0, 0x9a, 0x3f, 0x72, 0x5d, 0xdb, 0x1d, 0x44,
-	0xa0, 0x22, 0xcc, 0x16, 0x65, 0x47, 0x88, 0x5d, 0x86, 0x0a, 0x0b, 0x85, 0x98, 0x56, 0x11, 0x3f,
-	0x0b, 0x82, 0x1f, 0x22, 0x1f, 0x41, 0x46, 0xe4, 0x8d, 0xcc, 0x9c, 0x53, 0xeb, 0x64, 0x57, 0x19,
-	0x02, 0x89, 0x9e, 0x44, 0xd3, 0x4d, 0x7e, 0xce, 0x5c, 0xa8, 0xe8, 0x9a, 0x64, 0x22, 0xf4, 0x39,
-	0x94, 0x45, 0xe9, 0xef, 0x1d, 0x8c, 0x99, 0x5d, 0xd5, 0x1c, 0x5f, 0x90, 0xa7, 0x97, 0x5c, 0x90,
-	0xba, 0xa8, 0xfd, 0x8d, 0x31, 0x2b, 0xfe, 0xfc, 0xd6, 0x54, 0xc4, 0x13, 0x4e, 0xed, 0x33, 0xa8,
-	0x9c, 0x05, 0xcc, 0xb8, 0x3f, 0xad, 0x46, 0xef, 0x4f, 0xb3, 0x52, 0x4d, 0x78, 0xc6, 0x88, 0xdc,
-	0xad, 0x58, 0x45, 0xe7, 0x19, 0x4a, 0xdf, 0x83, 0x52, 0xd3, 0x1a, 0x4c, 0xfe, 0x4c, 0xfb, 0x9a,
-	0x75, 0x4a, 0xff, 0x52, 0x83, 0xb2, 0x54, 0x28, 0x53, 0xf1, 0x7a, 0x24, 0x15, 0xdf, 0x9e, 0x2e,
-	0x4b, 0x51, 0xec, 0xd7, 0x4f, 0xc2, 0x8f, 0x78, 0x12, 0x7e, 0x08, 0x19, 0xcc, 0xf4, 0xca, 0x0d,
-	0xf2, 0xc6, 0xcc, 0x51, 0x0d, 0x81, 0x89, 0x25, 0xdd, 0xdf, 0x69, 0x90, 0x66, 0x7d, 0xe8, 0x21,
-	0xa4, 0x02, 0xbf, 0x7f, 0xf1, 0xbe, 0x60, 0x28, 0x06, 0xb6, 0x82, 0xc9, 0x21, 0x7c, 0x3e, 0xd8,
-	0x0a, 0x28, 0x2b, 0x6d, 0x7d, 0xc7, 0xc6, 0x2e, 0xed, 0xd9, 0x96, 0x3c, 0x09, 0xe5, 0x05, 0xa3,
-	0x65, 0xb1, 0xce, 0x00, 0xfb, 0x27, 0xd8, 0x67, 0x9d, 0xe2, 0x0c, 0x96, 0x17, 0x8c, 0x96, 0x85,
-	0xee, 0xc3, 0xa2, 0x4b, 0x7a, 0xb6, 0x85, 0x5d, 0x6a, 0x53, 0x96, 0x70, 0x07, 0xf2, 0x5a, 0x54,
-	0x76, 0x49, 0x4b, 0x72, 0x5f, 0x04, 0x03, 0xfd, 0x2b, 0x0d, 0x2a, 0x5d, 0xe2, 0xf1, 0x7b, 0x79,
-	0xf0, 0xdf, 0x71, 0xfe, 0xc8, 0x5d, 0xe9, 0xfc, 0x11, 0x3b, 0x01, 0xfc, 0x5e, 0x83, 0x6b, 0x11,
-	0x6f, 0x65, 0xd0, 0xbd, 0x66, 0xfc, 0xb0, 0x7b, 0x19, 0x39, 0x96, 0x3e, 0xdc, 0x9b, 0x4e, 0x01,
-	0x67, 0xc7, 0x09, 0x03, 0xb6, 0xb6, 0xc1, 0x03, 0x6f, 0x1d, 0xb2, 0xfc, 0xc9, 0x49, 0x45, 0xde,
-	0x74, 0xf2, 0xe1, 0xf2, 0xa2, 0xf2, 0x4b, 0x68, 0x2c, 0x00, 0xff, 0xa6, 0x01, 0x4c, 0x20, 0x68,
-	0x3d, 0x96, 0xe8, 0xdf, 0x3d, 0x47, 0xdb, 0x24, 0xc1, 0xa3, 0x5a, 0x24, 0xb1, 0x8b, 0x75, 0x0a,
-	0xe9, 0xda, 0x4f, 0x34, 0x91, 0xfc, 0x97, 0x20, 0xc3, 0x47, 0x57, 0x77, 0x21, 0x4e, 0x5c, 0xbc,
-	0xc8, 0xb1, 0xcb, 0x7a, 0xf6, 0xec, 0x65, 0xfd, 0xea, 0x99, 0x77, 0xed, 0xcb, 0x2c, 0xa4, 0x36,
-	0x3d, 0x1b, 0x7d, 0x06, 0xc5, 0xc8, 0xa1, 0x0c, 0xdd, 0x39, 0xff, 0xc8, 0xc6, 0x43, 0xba, 0x76,
-	0xf7, 0x32, 0xe7, 0x3a, 0x3d, 0x81, 0x76, 0x20, 0xc3, 0xb3, 0x0c, 0x7a, 0x67, 0x5e, 0xf6, 0x11,
-	0xfa, 0x6e, 0x9e, 0x9f, 0x9c, 0xf4, 0x04, 0xea, 0x42, 0x21, 0x0c, 0x01, 0x74, 0xfb, 0xbc, 0xf0,
-	0x10, 0x1a, 0xf5, 0x8b, 0x23, 0x48, 0x4f, 0xa0, 0x4f, 0x20, 0xaf, 0xfe, 0xa1, 0x47, 0xb7, 0xa6,
-	0x24, 0xce, 0x7c, 0x31, 0x50, 0xbb, 0x7d, 0x0e, 0x22, 0x54, 0xf9, 0x7d, 0x28, 0x45, 0x3f, 0x7a,
-	0x40, 0x77, 0x67, 0x0a, 0x9d, 0xf9, 0x90, 0xa2, 0x76, 0xef, 0x02, 0x54, 0xa8, 0x7e, 0x1b, 0x52,
-	0x5d, 0xd3, 0x43, 0x6f, 0xcd, 0x7a, 0xb8, 0x50, 0xca, 0xde, 0x9c, 0xfb, 0xaa, 0xa1, 0xa7, 0x7e,
-	0x94, 0xd4, 0x56, 0x35, 0xf4, 0x12, 0xca, 0xb1, 0xff, 0x9c, 0xd0, 0xbd, 0x4b, 0xfd, 0x27, 0x75,
-	0x9e, 0xe6, 0xc4, 0xaa, 0x86, 0x36, 0x21, 0xa7, 0xfe, 0x73, 0x9e, 0x93, 0x85, 0x6a, 0x6f, 0x4f,
-	0xf1, 0x23, 0x9f, 0xb2, 0xe8, 0x09, 0xe4, 0x40, 0xa1, 0x83, 0x9d, 0xc3, 0xad, 0x23, 0xdc, 0x3f,
-	0x46, 0xff, 0x3f, 0x01, 0x8b, 0x4f, 0x65, 0xea, 0xd1, 0x4f, 0x65, 0x42, 0x9c, 0xb2, 0xae, 0x7e,
-	0x59, 0x78, 0x38, 0x9b, 0x4f, 0x21, 0xbb, 0xc5, 0x3f, 0xb1, 0x99, 0x6b, 0xef, 0x52, 0x54, 0x27,
-	0xff, 0x18, 0x67, 0xd3, 0x71, 0xf4, 0x44, 0x63, 0xfd, 0xb3, 0x47, 0x03, 0x9b, 0x1e, 0x8d, 0x0e,
-	0xd8, 0x50, 0x2b, 0x12, 0xa3, 0x7e, 0xd7, 0x56, 0x26, 0x5f, 0x08, 0xac, 0x0c, 0xb0, 0xbb, 0x22,
-	0x54, 0x1e, 0x64, 0xf9, 0x9b, 0xce, 0xfa, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x90, 0x49, 0x8c,
-	0x1d, 0x38, 0x24, 0x00, 0x00,
+func init() { proto.RegisterFile("public.proto", fileDescriptor_public_070233ff61e27ff7) }
+
+var fileDescriptor_public_070233ff61e27ff7 = []byte{
+	// 4361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7b, 0x4b, 0x6c, 0x23, 0x59,
+	0x57, 0x70, 0xca, 0x6f, 0x1f, 0x3b, 0x89, 0x73, 0x3b, 0xd3, 0x9f, 0x7f, 0xcf, 0xa3, 0xbb, 0x6b,
+	0x66, 0x7a, 0x32, 0x3d, 0x3f, 0x4e, 0x4f, 0xfa, 0x31, 0xd3, 0xf3, 0x62, 0xe2, 0xc4, 0xd3, 0x09,
+	0x93, 0x4e, 0x3c, 0x65, 0xf7, 0x37, 0x30, 0xfa, 0x3e, 0x59, 0x15, 0xd7, 0x8d, 0x53, 0x5f, 0xca,
+	0x75, 0xab, 0xab, 0xca, 0x49, 0x67, 0x8d, 0x84, 0x40, 0x88, 0xc7, 0x06, 0x89, 0x1d, 0x0b, 0x16,
+	0xe8, 0x63, 0x87, 0x58, 0xb2, 0x44, 0x62, 0xc3, 0x16, 0x09, 0x90, 0xd0, 0xc7, 0x12, 0x09, 0x09,
+	0x89, 0x05, 0x62, 0x01, 0x08, 0xa1, 0x73, 0x1f, 0xe5, 0x2a, 0x3f, 0x62, 0xa7, 0x07, 0x10, 0x48,
+	0xac, 0x7c, 0xcf, 0xb9, 0xe7, 0x9c, 0xfb, 0x3a, 0xaf, 0x7b, 0x6e, 0x19, 0xca, 0xde, 0xf0, 0xd8,
+	0xb1, 0x7b, 0x75, 0xcf, 0x67, 0x21, 0x23, 0xab, 0x8e, 0xed, 0x9e, 0x51, 0xdf, 0xda, 0xaa, 0x0b,
+	0x74, 0xed, 0xad, 0x3e, 0x63, 0x7d, 0x87, 0x6e, 0xf2, 0xee, 0xe3, 0xe1, 0xc9, 0xa6, 0x35, 0xf4,
+	0xcd, 0xd0, 0x66, 0xae, 0x60, 0xa8, 0xdd, 0x1a, 0xef, 0x0f, 0xed, 0x01, 0x0d, 0x42, 0x73, 0xe0,
+	0x49, 0x82, 0x6a, 0x8f, 0x0d, 0x06, 0xcc, 0xdd, 0x3c, 0xa5, 0xa6, 0x13, 0x9e, 0xf6, 0x4e, 0x69,
+	0xef, 0x4c, 0xf6, 0xdc, 0xe8, 0x31, 0xf7, 0xc4, 0xee, 0x6f, 0x8a, 0x1f, 0x81, 0xd4, 0xf3, 0x90,
+	0x6d, 0x0e, 0xbc, 0xf0, 0x52, 0x7f, 0x01, 0xa5, 0x1f, 0x52, 0x3f, 0xb0, 0x99, 0xbb, 0xef, 0x9e,
+	0x30, 0xf2, 0x06, 0x14, 0xfb, 0x4c, 0x22, 0xaa, 0xda, 0x6d, 0x6d, 0xa3, 0x68, 0x8c, 0x10, 0xd8,
+	0x7b, 0x3c, 0xb4, 0x1d, 0x6b, 0xd7, 0x0c, 0x69, 0x35, 0x25, 0x7a, 0x23, 0x04, 0xb9, 0x0b, 0x2b,
+	0x3e, 0x75, 0xa8, 0x19, 0x50, 0x25, 0x20, 0xcd, 0x49, 0xc6, 0xb0, 0xfa, 0x03, 0xb8, 0x71, 0x60,
+	0x07, 0x61, 0x9b, 0xfa, 0xe7, 0x76, 0x8f, 0x06, 0x06, 0x7d, 0x31, 0xa4, 0x41, 0x88, 0xc2, 0x5d,
+	0x73, 0x40, 0x03, 0xcf, 0xec, 0x51, 0x35, 0x74, 0x84, 0xd0, 0x0f, 0x60, 0x3d, 0xc9, 0x14, 0x78,
+	0xcc, 0x0d, 0x28, 0x79, 0x08, 0x85, 0x40, 0xe2, 0xaa, 0xda, 0xed, 0xf4, 0x46, 0x69, 0xab, 0x5a,
+	0x1f, 0xdb, 0xdc, 0xba, 0x64, 0x32, 0x22, 0x4a, 0xfd, 0x53, 0xc8, 0x4b, 0x24, 0x21, 0x90, 0xc1,
+	0x51, 0xe4, 0x88, 0xbc, 0x9d, 0x9c, 0x4a, 0x6a, 0x7c, 0x2a, 0xbf, 0x96, 0x86, 0x55, 0x9c, 0x4b,
+	0x8b, 0x59, 0xd1, 0xe4, 0x6f, 0x4f, 0x4c, 0xbe, 0x91, 0xaa, 0x6a, 0x31, 0x2e, 0xf2, 0x05, 0x4e,
+	0xd4, 0xa1, 0xbd, 0x90, 0xf9, 0x5c, 0x64, 0x69, 0x4b, 0x9f, 0x98, 0xa8, 0x41, 0x03, 0x36, 0xf4,
+	0x7b, 0xb4, 0xcd, 0x09, 0x6d, 0xe6, 0x1a, 0x11, 0x0f, 0x59, 0x87, 0xac, 0x63, 0x0f, 0xec, 0x90,
+	0x6f, 0xea, 0xb2, 0x21, 0x00, 0xf2, 0x26, 0x80, 0x67, 0xf6, 0x69, 0x37, 0x64, 0x67, 0xd4, 0xad,
+	0x66, 0xc4, 0x54, 0x11, 0xd3, 0x41, 0x04, 0x69, 0x40, 0x6e, 0x40, 0x83, 0x53, 0x6a, 0x55, 0xb3,
+	0xb7, 0xb5, 0x8d, 0x95, 0xad, 0x7b, 0x13, 0x43, 0x8e, 0x2d, 0xa4, 0xbe, 0xc3, 0x5c, 0xcb, 0xe6,
+	0x43, 0x4b, 0x4e, 0xf2, 0x36, 0x2c, 0x7b, 0x3e, 0x7b, 0x79, 0xd9, 0x3d, 0x97, 0xa7, 0x9a, 0xe3,
+	0xa3, 0x94, 0x39, 0x52, 0x69, 0xc6, 0xd7, 0x50, 0x12, 0x44, 0x3e, 0x35, 0xad, 0xcb, 0x6a, 0xfe,
+	0xda, 0xa3, 0x01, 0x67, 0x37, 0x90, 0x5b, 0x7f, 0x1f, 0x8a, 0x51, 0x07, 0xc9, 0x43, 0x7a, 0xfb,
+	0xf0, 0x97, 0x2a, 0x4b, 0xa4, 0x00, 0x99, 0x8e, 0xf1, 0xbc, 0x59, 0xd1, 0x48, 0x11, 0xb2, 0x5f,
+	0x6d, 0x1f, 0xb4, 0x9b, 0x95, 0x94, 0x6e, 0x41, 0x65, 0x24, 0x53, 0xaa, 0xc4, 0x06, 0x64, 0x3c,
+	0x66, 0x29, 0x75, 0x58, 0x9f, 0x98, 0x44, 0x8b, 0x59, 0x06, 0xa7, 0x20, 0x77, 0x61, 0xd5, 0xa5,
+	0x2f, 0xc3, 0x6e, 0x6c, 0x0b, 0xc5, 0x69, 0x2f, 0x23, 0xba, 0xa5, 0xb6, 0x51, 0xff, 0x97, 0x0c,
+	0xa4, 0x5b, 0xcc, 0x9a, 0xaa, 0x2b, 0xeb, 0x90, 0xf5, 0x98, 0xb5, 0xdf, 0x92, 0x9c, 0x02, 0x20,
+	0xb7, 0x01, 0x2c, 0xea, 0x39, 0xec, 0x72, 0x40, 0x5d, 0x71, 0x64, 0xc5, 0xbd, 0x25, 0x23, 0x86,
+	0x23, 0x77, 0xa0, 0xe4, 0x53, 0xcf, 0xb1, 0x7b, 0x66, 0x37, 0xa0, 0x61, 0x15, 0x14, 0x89, 0x44,
+	0xb6, 0x69, 0x48, 0x3e, 0x82, 0x9b, 0x12, 0xc2, 0x9d, 0xe8, 0xf6, 0x98, 0x1b, 0xfa, 0xcc, 0x71,
+	0xa8, 0x5f, 0x2d, 0x49, 0xea, 0xd7, 0x62, 0xfd, 0x3b, 0x51, 0x37, 0x79, 0x1b, 0xca, 0x41, 0x68,
+	0x86, 0xf4, 0x64, 0xe8, 0x70, 0xe1, 0x65, 0x49, 0x5e, 0x52, 0x58, 0x94, 0x7e, 0x0b, 0xc0, 0x32,
+	0xe9, 0x80, 0xb9, 0x9c, 0x64, 0x59, 0x92, 0x14, 0x05, 0x0e, 0x09, 0x08, 0xa4, 0x7f, 0xc2, 0x8e,
+	0xab, 0x2b, 0xb2, 0x07, 0x01, 0x72, 0x13, 0x72, 0x28, 0x63, 0x18, 0x48, 0x5d, 0x93, 0x10, 0xee,
+	0x82, 0x69, 0x59, 0x52, 0xcf, 0x0a, 0x86, 0x00, 0xc8, 0x0e, 0xac, 0x06, 0xb6, 0xdb, 0xa3, 0x07,
+	0x66, 0x10, 0x1a, 0xd4, 0x63, 0x7e, 0xc8, 0x95, 0xa7, 0xb4, 0xf5, 0xff, 0xea, 0xc2, 0x9f, 0xd5,
+	0x95, 0x3f, 0xab, 0xef, 0x4a, 0x7f, 0x67, 0x8c, 0x73, 0x90, 0xfb, 0x70, 0x63, 0xb4, 0xf2, 0xc3,
+	0xc8, 0xc8, 0xf2, 0x7c, 0xfc, 0x69, 0x5d, 0x44, 0x87, 0xb2, 0x44, 0xb7, 0x1c, 0xd3, 0xa5, 0xd5,
+	0x02, 0x9f, 0x53, 0x02, 0x47, 0x3e, 0x84, 0xdc, 0xd0, 0x43, 0x27, 0x5a, 0x2d, 0xce, 0x9b, 0x91,
+	0x24, 0x24, 0x6f, 0x41, 0x4c, 0x49, 0xab, 0xab, 0x5c, 0x68, 0x0c, 0x83, 0xc3, 0xc6, 0x6d, 0xa2,
+	0x5a, 0x99, 0x62, 0x27, 0x1b, 0xb0, 0xea, 0x4b, 0x23, 0x57, 0x64, 0x6b, 0x9c, 0x6c, 0x1c, 0xdd,
+	0xc8, 0x43, 0x96, 0x5d, 0xb8, 0xd4, 0xd7, 0xff, 0x30, 0x05, 0xd0, 0x31, 0x3d, 0xe5, 0x69, 0x08,
+	0xa4, 0x3d, 0x66, 0x55, 0x35, 0x75, 0x2a, 0x1e, 0xb3, 0xc6, 0xb4, 0x2d, 0x35, 0x45, 0xdb, 0x6e,
+	0x42, 0x6e, 0x60, 0xbe, 0x34, 0xbc, 0x80, 0xeb, 0x62, 0xca, 0x90, 0x10, 0xe2, 0x43, 0xd6, 0xc2,
+	0x83, 0xc9, 0x70, 0xb7, 0x22, 0x21, 0xd4, 0xf4, 0x90, 0xed, 0xb7, 0xf8, 0x71, 0x16, 0x0d, 0xde,
+	0x26, 0x35, 0x28, 0x9c, 0xf8, 0x6c, 0xd0, 0x52, 0xc7, 0xb8, 0x6c, 0x44, 0x30, 0xca, 0xc1, 0xf6,
+	0x7e, 0x4b, 0x9e, 0x8b, 0x84, 0x10, 0x1f, 0xf4, 0x4e, 0xe9, 0x40, 0x1c, 0x42, 0xd1, 0x90, 0x10,
+	0x9f, 0x0f, 0x0d, 0x4f, 0x99, 0xc5, 0xb7, 0xbf, 0x68, 0x48, 0x08, 0x3d, 0xaf, 0x39, 0x0c, 0x4f,
+	0x99, 0x6f, 0x87, 0x97, 0xc2, 0x26, 0x8c, 0x11, 0x02, 0x67, 0xe5, 0x99, 0xe1, 0xa9, 0x50, 0x7f,
+	0x83, 0xb7, 0x3f, 0x49, 0x55, 0xb5, 0x46, 0x01, 0x72, 0xa1, 0xe9, 0xf7, 0x69, 0xa8, 0xff, 0x41,
+	0x11, 0xd6, 0x3b, 0xa6, 0xd7, 0xb8, 0x54, 0xae, 0x54, 0x6d, 0xdb, 0x27, 0x8a, 0xa4, 0xaa, 0x2d,
+	0xec, 0x7c, 0x25, 0x07, 0xd9, 0x86, 0xec, 0xc0, 0x0c, 0x7b, 0xa7, 0xd2, 0x6f, 0x7f, 0x30, 0xc1,
+	0x3a, 0x6d, 0xc4, 0xfa, 0x33, 0x64, 0x31, 0x04, 0xe7, 0xcc, 0xfd, 0x7f, 0x0f, 0xf5, 0x01, 0xd5,
+	0xbc, 0x1b, 0x98, 0x03, 0xcf, 0xb1, 0xdd, 0x3e, 0x3f, 0x88, 0x82, 0xb1, 0x22, 0xd0, 0x6d, 0x89,
+	0x25, 0x9f, 0x41, 0x5e, 0xcc, 0x26, 0xa8, 0x66, 0x6f, 0xa7, 0x17, 0x5c, 0x80, 0x62, 0xa9, 0xfd,
+	0x76, 0x1e, 0xb2, 0x7c, 0x3e, 0x64, 0x07, 0xd2, 0xa6, 0xe3, 0xc8, 0x4d, 0xd8, 0xbc, 0xc6, 0x4a,
+	0xea, 0x6d, 0xfa, 0x02, 0xf5, 0xcd, 0x74, 0x1c, 0x2e, 0xc4, 0xbd, 0xac, 0xa6, 0x5e, 0x5d, 0x88,
+	0x7b, 0x49, 0x7e, 0x1e, 0xd2, 0x2e, 0x13, 0xbe, 0xf1, 0x7a, 0x7b, 0x8a, 0x02, 0x5c, 0x16, 0x92,
+	0x3d, 0x28, 0x5b, 0x34, 0x08, 0x6d, 0x97, 0x9b, 0xa9, 0xf0, 0x48, 0x0b, 0xed, 0xcb, 0xde, 0x92,
+	0x91, 0xe0, 0x24, 0x5f, 0x41, 0xe6, 0x34, 0x0c, 0x3d, 0xae, 0xed, 0xa5, 0xad, 0xfb, 0xd7, 0x59,
+	0xd0, 0x5e, 0x18, 0x7a, 0x7b, 0x4b, 0x06, 0xe7, 0x27, 0x06, 0x14, 0x7c, 0x19, 0x85, 0xa4, 0xa3,
+	0x7b, 0x78, 0x1d, 0x59, 0x2a, 0x82, 0xed, 0x2d, 0x19, 0x91, 0x9c, 0xda, 0x01, 0xa4, 0xdb, 0xf4,
+	0x05, 0x69, 0x42, 0x9e, 0x6b, 0x52, 0x94, 0xe6, 0x5c, 0x4b, 0x0b, 0x15, 0x6f, 0xed, 0x12, 0x32,
+	0x38, 0x63, 0x52, 0x8d, 0xec, 0x52, 0x39, 0x12, 0x09, 0x63, 0x8f, 0xb4, 0x4c, 0xe5, 0x47, 0x24,
+	0x4c, 0xde, 0x8a, 0xdb, 0xa6, 0x0a, 0x69, 0x23, 0x14, 0x59, 0x97, 0xd6, 0x99, 0x91, 0x5d, 0x1c,
+	0x42, 0x3f, 0xc6, 0x07, 0xaf, 0xfd, 0x72, 0x0a, 0x0a, 0x51, 0x8c, 0xfe, 0x2e, 0x8a, 0x23, 0x42,
+	0x13, 0xbf, 0x7c, 0x95, 0x7d, 0xaa, 0xb7, 0xb9, 0x08, 0xc3, 0x74, 0xfb, 0x94, 0xaf, 0x80, 0x83,
+	0x18, 0x59, 0xfb, 0xbe, 0xd7, 0xeb, 0xca, 0x01, 0x70, 0x19, 0xcb, 0xe8, 0x0e, 0x11, 0x29, 0x38,
+	0xc8, 0x67, 0x50, 0x0a, 0x1c, 0x76, 0x41, 0xfd, 0x6e, 0x78, 0x6a, 0xba, 0xd5, 0xf4, 0x9c, 0x10,
+	0x80, 0xdc, 0x82, 0xbe, 0x73, 0x6a, 0xba, 0xb5, 0x0f, 0xa1, 0x14, 0x1b, 0x99, 0x54, 0x20, 0x3d,
+	0xb0, 0x45, 0xb6, 0xbc, 0x6c, 0x60, 0x93, 0x63, 0xcc, 0x97, 0x62, 0x64, 0x03, 0x9b, 0xd1, 0x2e,
+	0x44, 0x0d, 0xfd, 0x9f, 0x34, 0x00, 0x3c, 0x8a, 0x67, 0x62, 0x73, 0xf7, 0x00, 0x7c, 0xda, 0xb7,
+	0x83, 0x90, 0xfa, 0x54, 0x78, 0xf7, 0x95, 0xad, 0xbb, 0x13, 0x9b, 0x32, 0x62, 0xa8, 0x1b, 0x11,
+	0xb5, 0xc8, 0x1a, 0x14, 0x44, 0xde, 0x81, 0xf2, 0xd0, 0x8d, 0xc9, 0x52, 0xc7, 0x98, 0xc0, 0xea,
+	0x2e, 0xc0, 0x48, 0x02, 0x26, 0x59, 0x4f, 0x9b, 0x1d, 0x91, 0x64, 0xb5, 0x8e, 0xda, 0x9d, 0x8a,
+	0x86, 0xa8, 0xd6, 0xf3, 0x4e, 0x25, 0x45, 0x00, 0x72, 0xbb, 0xcd, 0x83, 0x66, 0xa7, 0x59, 0x49,
+	0x63, 0xe6, 0xd5, 0xda, 0xee, 0xec, 0xec, 0x55, 0x32, 0xa4, 0x04, 0xf9, 0xa3, 0x56, 0x67, 0xff,
+	0xe8, 0xb0, 0x5d, 0xc9, 0x22, 0xb0, 0x73, 0x74, 0x78, 0xd8, 0xdc, 0xe9, 0x54, 0x72, 0x28, 0x63,
+	0xaf, 0xb9, 0xbd, 0x5b, 0xc9, 0x23, 0x79, 0xc7, 0xd8, 0xde, 0x69, 0x56, 0x0a, 0x8d, 0x1c, 0x64,
+	0xc2, 0x4b, 0x8f, 0xea, 0xbf, 0xa7, 0x41, 0xae, 0x2d, 0x34, 0x6d, 0x77, 0xca, 0x92, 0x27, 0xad,
+	0x57, 0x10, 0x7f, 0xdf, 0xe5, 0xde, 0x49, 0x2c, 0x17, 0x67, 0xd8, 0xe9, 0xb4, 0x2a, 0x4b, 0x38,
+	0x43, 0x6c, 0xb5, 0x2b, 0x5a, 0x34, 0xc3, 0x0e, 0x14, 0xf7, 0x5b, 0xdb, 0x96, 0xe5, 0xd3, 0x00,
+	0xf3, 0x9a, 0x8c, 0xed, 0x9d, 0x3f, 0xe4, 0xb3, 0xcb, 0xa3, 0x4e, 0x23, 0x44, 0x3e, 0xe0, 0xd8,
+	0xc7, 0xd2, 0x01, 0xbe, 0x36, 0x31, 0xe7, 0xfd, 0xd6, 0xf9, 0x63, 0x49, 0xfc, 0xb8, 0x91, 0x81,
+	0x94, 0xed, 0xe9, 0xf7, 0x21, 0x83, 0x58, 0x4c, 0x94, 0x4e, 0x6c, 0x3f, 0x10, 0x61, 0x28, 0x67,
+	0x08, 0x00, 0x03, 0x9b, 0x63, 0x06, 0x22, 0x74, 0xe7, 0x0c, 0xde, 0xd6, 0x0f, 0x00, 0x3a, 0x3d,
+	0x4f, 0x4d, 0xe4, 0x1e, 0x4a, 0x91, 0xc6, 0x52, 0x9b, 0x32, 0xa0, 0xa4, 0x33, 0x52, 0xb6, 0xc7,
+	0xc3, 0x24, 0xf3, 0x85, 0xb4, 0x65, 0x83, 0xb7, 0x75, 0x0b, 0xd2, 0x4d, 0x86, 0x62, 0x2a, 0x31,
+	0xdb, 0xe8, 0xf6, 0x98, 0x25, 0x3c, 0x00, 0x1a, 0xc8, 0xca, 0xc8, 0x40, 0x76, 0x98, 0x45, 0x91,
+	0xd6, 0xa7, 0x01, 0x0d, 0xbb, 0xd4, 0xf7, 0x99, 0x2f, 0x68, 0x95, 0x31, 0xad, 0xf0, 0x9e, 0x26,
+	0x76, 0x20, 0x6d, 0x23, 0x0b, 0x69, 0xea, 0x5a, 0xfa, 0x3f, 0x56, 0xa0, 0xd0, 0x31, 0xbd, 0xe6,
+	0x39, 0xe6, 0x1c, 0x0f, 0x20, 0x27, 0xac, 0x57, 0x4e, 0xfb, 0xf5, 0x49, 0x1b, 0x8f, 0xd6, 0x67,
+	0x48, 0x52, 0xf2, 0x14, 0x4a, 0xa2, 0xd5, 0x1d, 0xd0, 0xd0, 0x94, 0x1e, 0xf9, 0xee, 0x34, 0xef,
+	0xc0, 0x07, 0xa9, 0x37, 0x5d, 0xcb, 0x63, 0xb6, 0x1b, 0x3e, 0xa3, 0xa1, 0x69, 0x80, 0x60, 0xc5,
+	0x36, 0xf9, 0x1c, 0x4a, 0x31, 0x1f, 0x5f, 0x4d, 0xcd, 0x9f, 0x42, 0x9c, 0x9e, 0x7c, 0x03, 0x95,
+	0x18, 0x28, 0x26, 0x93, 0xb9, 0xd6, 0x64, 0x56, 0x63, 0xfc, 0x7c, 0x46, 0x0d, 0x00, 0x9f, 0x0d,
+	0x43, 0xb9, 0xb2, 0x3c, 0x17, 0xf6, 0xf6, 0x6c, 0x61, 0x06, 0xd2, 0x72, 0x49, 0x45, 0x5f, 0x35,
+	0xc9, 0x37, 0xb0, 0x2a, 0xee, 0x59, 0x96, 0xed, 0x8b, 0x60, 0xc6, 0x03, 0xcd, 0xca, 0xd6, 0xc6,
+	0x6c, 0x41, 0x2d, 0x64, 0xd8, 0x55, 0xf4, 0xc6, 0x8a, 0x97, 0x80, 0xc9, 0x43, 0x19, 0xfc, 0x84,
+	0x13, 0x7c, 0x6b, 0xb6, 0x9c, 0x44, 0xa8, 0xfb, 0x12, 0x0a, 0x51, 0xc6, 0x52, 0x98, 0x11, 0x78,
+	0x23, 0x4e, 0x95, 0xc5, 0x60, 0x60, 0x53, 0x5c, 0x98, 0x81, 0xcb, 0x8c, 0x0c, 0xa4, 0xfb, 0x9d,
+	0x15, 0xb8, 0x55, 0x22, 0x56, 0xfb, 0x1d, 0x0d, 0xca, 0xf1, 0x3d, 0x26, 0xbf, 0x00, 0x39, 0xc7,
+	0x3c, 0xa6, 0x8e, 0x0a, 0x8a, 0x5b, 0x8b, 0x9d, 0x4d, 0xfd, 0x80, 0x33, 0x35, 0xdd, 0xd0, 0xbf,
+	0x34, 0xa4, 0x84, 0xda, 0x13, 0x28, 0xc5, 0xd0, 0xe8, 0xc3, 0xcf, 0xe8, 0xa5, 0xbc, 0xea, 0x61,
+	0x13, 0x4d, 0xf7, 0xdc, 0x74, 0x86, 0xaa, 0x22, 0x20, 0x80, 0x4f, 0x52, 0x1f, 0x6b, 0xb5, 0xdf,
+	0xd2, 0xa0, 0x18, 0x1d, 0x17, 0x79, 0x3a, 0x36, 0xa9, 0xcd, 0x05, 0xce, 0xf8, 0x3f, 0x7b, 0x46,
+	0xbf, 0xaf, 0x41, 0x21, 0xca, 0x1d, 0x2b, 0xb1, 0x2b, 0x83, 0xb8, 0x30, 0x5c, 0x59, 0xe0, 0xc0,
+	0x93, 0xb9, 0xb0, 0x5d, 0x8b, 0x5d, 0xcc, 0x0d, 0x8c, 0x86, 0x24, 0xc4, 0xbb, 0x01, 0x3b, 0xc6,
+	0xf2, 0x0a, 0xb5, 0xb8, 0x99, 0x64, 0x8c, 0x08, 0x26, 0x55, 0xc8, 0x5b, 0x3e, 0xf3, 0x3c, 0x79,
+	0x3b, 0xcc, 0x18, 0x0a, 0xac, 0xfd, 0x7b, 0x5e, 0xa6, 0x23, 0x47, 0x50, 0xf6, 0x45, 0x88, 0xef,
+	0xda, 0xae, 0xad, 0x72, 0xf4, 0x7b, 0x57, 0xeb, 0x62, 0x5d, 0x66, 0x05, 0xfb, 0xae, 0x1d, 0xe2,
+	0xe5, 0xd6, 0x1f, 0x81, 0xc4, 0x80, 0x65, 0x95, 0x41, 0x09, 0x89, 0x57, 0xa4, 0xee, 0x09, 0x89,
+	0x82, 0x47, 0x8a, 0x2c, 0xfb, 0x31, 0x58, 0x4c, 0x52, 0xca, 0xa4, 0xae, 0x55, 0x4d, 0x2f, 0x38,
+	0x49, 0xc1, 0xd2, 0x74, 0x2d, 0x31, 0xc9, 0x08, 0xac, 0x3d, 0x86, 0x42, 0x3b, 0xf4, 0xa9, 0x39,
+	0xd8, 0xe7, 0xa5, 0x85, 0x63, 0x33, 0x90, 0xce, 0xd8, 0xe0, 0x6d, 0x71, 0xd9, 0xc6, 0x7e, 0x3e,
+	0xfb, 0x8c, 0x21, 0xa1, 0xda, 0xcf, 0x34, 0x28, 0xc5, 0xd6, 0x4e, 0x3e, 0x82, 0x94, 0x6d, 0xc9,
+	0x3d, 0x7b, 0x6f, 0xce, 0x74, 0xd4, 0x80, 0x46, 0xca, 0xb6, 0xd0, 0x43, 0xc7, 0x72, 0xbd, 0x69,
+	0xee, 0x71, 0x94, 0x70, 0x44, 0x69, 0xe0, 0x66, 0x94, 0x3a, 0x8a, 0x0d, 0xf8, 0xc1, 0x8c, 0x90,
+	0x1d, 0x65, 0x94, 0x89, 0x3b, 0x5d, 0x66, 0xd6, 0x9d, 0x2e, 0x3b, 0xba, 0xd3, 0xd5, 0xfe, 0x48,
+	0x83, 0x72, 0xfc, 0x28, 0x5e, 0x7d, 0x85, 0x4f, 0x81, 0xf0, 0x7a, 0x42, 0x37, 0xa1, 0x5e, 0xa9,
+	0x79, 0x6a, 0x5d, 0xe1, 0x4c, 0xf1, 0x3d, 0xbe, 0x05, 0x25, 0xf4, 0x7b, 0x2a, 0xa9, 0x14, 0x45,
+	0x38, 0x40, 0x94, 0x88, 0x98, 0xb5, 0x9f, 0xa6, 0xa0, 0xa4, 0xe6, 0xdc, 0x74, 0xad, 0xff, 0x01,
+	0x53, 0xde, 0x87, 0x1b, 0x4a, 0x50, 0xdc, 0x12, 0xe6, 0xda, 0xf4, 0x9a, 0x94, 0x14, 0xdb, 0xff,
+	0x77, 0xb1, 0xb4, 0x2b, 0x85, 0x1c, 0x5f, 0x86, 0x34, 0x90, 0x46, 0x1e, 0x19, 0x59, 0x03, 0x91,
+	0xe4, 0x2e, 0xa4, 0x29, 0x0b, 0x64, 0xd0, 0x9e, 0x2c, 0xbc, 0x35, 0x59, 0x60, 0x20, 0x01, 0x26,
+	0xc1, 0x14, 0x57, 0xaf, 0x7f, 0x0c, 0x2b, 0xc9, 0xe8, 0x84, 0x99, 0xe4, 0xf3, 0xc3, 0xaf, 0x0f,
+	0x8f, 0xbe, 0x3d, 0xac, 0x2c, 0x21, 0xb0, 0x7f, 0xd8, 0x38, 0x7a, 0x7e, 0xb8, 0x5b, 0xd1, 0x48,
+	0x19, 0x0a, 0x47, 0xcf, 0x3b, 0x02, 0x4a, 0x8d, 0x44, 0x9c, 0x43, 0x91, 0x57, 0x47, 0x7a, 0xcc,
+	0xb7, 0xc8, 0xc7, 0x50, 0x8c, 0x0a, 0xe3, 0x51, 0xb2, 0x34, 0xbe, 0xd0, 0x8e, 0xa2, 0x30, 0x46,
+	0xc4, 0x64, 0x53, 0xca, 0x8b, 0x36, 0x7a, 0xd6, 0x89, 0x19, 0x72, 0xdc, 0xdb, 0x50, 0xd8, 0xf6,
+	0x6c, 0x9e, 0x01, 0xa1, 0x1f, 0xe6, 0x39, 0x92, 0x74, 0xb1, 0x02, 0xc0, 0xc2, 0x4d, 0xb1, 0xc5,
+	0x2c, 0x4e, 0x12, 0x90, 0x4f, 0x21, 0xc7, 0xd1, 0x2a, 0x2a, 0xbc, 0x3d, 0xad, 0x2e, 0x29, 0x68,
+	0xa3, 0x96, 0x21, 0x59, 0x6a, 0x7f, 0xab, 0x41, 0x41, 0x21, 0x89, 0x01, 0x45, 0x2c, 0x65, 0x99,
+	0xb6, 0x4b, 0x7d, 0xb9, 0xc8, 0xad, 0x05, 0x84, 0xd5, 0x77, 0x14, 0x13, 0x07, 0xf1, 0xee, 0x16,
+	0x89, 0xa9, 0x9d, 0xc3, 0x4a, 0xb2, 0x1b, 0xbd, 0xf6, 0x80, 0x06, 0x81, 0xd9, 0x57, 0xe5, 0x4e,
+	0x05, 0xa2, 0x3d, 0x8f, 0xc6, 0x97, 0xc1, 0x23, 0x42, 0xe0, 0x5e, 0xd8, 0x03, 0xe4, 0x12, 0xc5,
+	0x7f, 0x01, 0xa0, 0x2b, 0xf3, 0xa9, 0x19, 0x30, 0x55, 0xa3, 0x96, 0x10, 0x3f, 0x46, 0xbe, 0x59,
+	0x2d, 0x28, 0xa8, 0x70, 0x7f, 0xf5, 0x4b, 0x00, 0x2f, 0x4d, 0x5d, 0x7a, 0x2a, 0x6c, 0xf1, 0x76,
+	0x54, 0x98, 0x4d, 0x8f, 0x0a, 0xb3, 0xfa, 0x4f, 0x35, 0x58, 0x9b, 0xb8, 0xfa, 0x93, 0x47, 0xfc,
+	0x8a, 0x1e, 0x4f, 0x4b, 0xaf, 0xc8, 0x3b, 0x22, 0x52, 0x34, 0x00, 0x1e, 0x94, 0xbb, 0x89, 0x1a,
+	0x7e, 0xd1, 0x58, 0xe6, 0xd8, 0xb6, 0x44, 0x92, 0xc7, 0x51, 0xe8, 0x9f, 0x95, 0x4d, 0x1d, 0xc4,
+	0xe9, 0x55, 0xa4, 0xd7, 0xff, 0x55, 0x83, 0xe5, 0x44, 0x0f, 0x31, 0xa0, 0xcc, 0xef, 0x89, 0xdd,
+	0x39, 0xa9, 0x44, 0x82, 0x4b, 0xdc, 0x8f, 0xe3, 0xa9, 0x44, 0x69, 0x30, 0xc2, 0x90, 0x1f, 0xc2,
+	0x9a, 0x90, 0x49, 0x5f, 0x7a, 0x98, 0xf1, 0xf2, 0xaa, 0x49, 0x8a, 0x0b, 0x7e, 0x7f, 0xce, 0x44,
+	0xe9, 0x8b, 0xa1, 0xed, 0x53, 0x2c, 0x25, 0x1a, 0x15, 0x2e, 0xa3, 0x39, 0x12, 0x51, 0xfb, 0x02,
+	0x2a, 0xe3, 0x03, 0x5f, 0x27, 0x59, 0xd1, 0xff, 0x42, 0x83, 0xea, 0xac, 0xe1, 0xa6, 0x08, 0x3a,
+	0x84, 0x02, 0xf3, 0xa8, 0x6f, 0xaa, 0x53, 0x58, 0x99, 0xa2, 0xfe, 0xb3, 0xc4, 0xd5, 0x8f, 0x24,
+	0xa7, 0x11, 0xc9, 0x40, 0xdd, 0xe4, 0x73, 0xc1, 0x43, 0x4b, 0xa3, 0x6e, 0x0a, 0x48, 0xff, 0x02,
+	0x0a, 0x8a, 0x9a, 0xe4, 0x20, 0xb5, 0x8f, 0x1e, 0x09, 0x20, 0x77, 0x78, 0xd4, 0xe9, 0xee, 0x1f,
+	0x56, 0x34, 0x6c, 0x37, 0x7f, 0x71, 0xbf, 0xdd, 0x69, 0x57, 0x52, 0x84, 0xc0, 0xca, 0xee, 0x51,
+	0xb3, 0xdd, 0xc5, 0x4e, 0x8e, 0xac, 0xa4, 0xf5, 0x1f, 0xc1, 0xb2, 0xd2, 0x24, 0x61, 0x52, 0xaf,
+	0xa8, 0x7b, 0x91, 0x77, 0x49, 0xc5, 0xbd, 0xcb, 0x9f, 0xa6, 0x81, 0x60, 0xe8, 0x69, 0x0f, 0x07,
+	0x03, 0xd3, 0xbf, 0x54, 0x75, 0xce, 0xf8, 0x33, 0x93, 0xf6, 0x0a, 0xcf, 0x4c, 0xb7, 0xa0, 0x84,
+	0x4e, 0xb1, 0x2b, 0x13, 0x40, 0x31, 0x24, 0x20, 0xea, 0x5b, 0x8e, 0x21, 0xff, 0x1f, 0x32, 0x2e,
+	0x73, 0x55, 0xf0, 0xbf, 0x39, 0xe9, 0xe4, 0xf1, 0x59, 0x11, 0xaf, 0x09, 0x48, 0x85, 0x85, 0x96,
+	0x90, 0x75, 0xa3, 0x55, 0x67, 0xe6, 0xac, 0x1a, 0xef, 0xf6, 0x21, 0x53, 0x10, 0xf9, 0x12, 0x96,
+	0xb1, 0x8e, 0x3c, 0xe2, 0xcf, 0xce, 0xe7, 0x2f, 0x23, 0x47, 0x24, 0xe1, 0x4d, 0x80, 0xe0, 0xcc,
+	0x16, 0x61, 0x3b, 0xe0, 0x57, 0xa5, 0x82, 0x51, 0x44, 0x0c, 0x6e, 0x5d, 0x40, 0x5e, 0x87, 0x62,
+	0xd8, 0x53, 0xbd, 0x79, 0xde, 0x5b, 0x08, 0x7b, 0xb2, 0xf3, 0x03, 0x58, 0x73, 0xcc, 0x90, 0xba,
+	0xbd, 0xcb, 0xee, 0xa9, 0x1d, 0x84, 0xac, 0xef, 0x9b, 0x03, 0xf9, 0x96, 0x50, 0x91, 0x1d, 0x7b,
+	0x0a, 0x4f, 0xde, 0x87, 0x8a, 0xbc, 0x53, 0x1f, 0xfb, 0xd4, 0x3c, 0xb3, 0xd8, 0x85, 0xcb, 0x4b,
+	0xdb, 0x05, 0x63, 0x55, 0xe0, 0x1b, 0x0a, 0xdd, 0x00, 0x28, 0xb0, 0x61, 0x78, 0xcc, 0x86, 0xae,
+	0xa5, 0x87, 0xf0, 0x83, 0x6f, 0xd1, 0x74, 0xa6, 0x9c, 0xe4, 0xe7, 0x90, 0x97, 0x19, 0x80, 0x3c,
+	0xc8, 0xc9, 0x88, 0x31, 0xc9, 0x65, 0x28, 0x1e, 0xcc, 0xc8, 0x6d, 0x37, 0xa4, 0xfe, 0xb9, 0xe9,
+	0xc8, 0x53, 0x8c, 0x60, 0xfd, 0x2f, 0x35, 0xb8, 0x91, 0xe0, 0x95, 0x55, 0xb9, 0x27, 0x90, 0x62,
+	0x67, 0x33, 0x73, 0x96, 0x29, 0x1c, 0xf5, 0xa3, 0xb3, 0xbd, 0x25, 0x23, 0xc5, 0xce, 0xc8, 0xe3,
+	0xb8, 0x92, 0x4e, 0x73, 0x7c, 0x09, 0x53, 0xd8, 0x5b, 0x92, 0x6a, 0x5c, 0xdb, 0x86, 0xd4, 0xd1,
+	0x19, 0xf9, 0x14, 0xf8, 0xd3, 0x54, 0x37, 0x34, 0x8f, 0x9d, 0xa8, 0xc2, 0x59, 0x9b, 0x3a, 0x83,
+	0x0e, 0x92, 0x18, 0x10, 0xa8, 0x66, 0x80, 0xfb, 0xa9, 0xd2, 0x10, 0xfd, 0xdf, 0x52, 0x00, 0x0d,
+	0x33, 0xb0, 0x7b, 0xe2, 0x08, 0xdf, 0x86, 0xe5, 0x60, 0xd8, 0xeb, 0xd1, 0x00, 0x4b, 0x1d, 0x43,
+	0x57, 0xec, 0x64, 0xc6, 0x28, 0x4b, 0xe4, 0x0e, 0xe2, 0x90, 0xe8, 0xc4, 0xb4, 0x9d, 0xa1, 0x4f,
+	0x25, 0x91, 0xc8, 0xb6, 0xcb, 0x12, 0x29, 0x88, 0xde, 0x81, 0x15, 0x79, 0xe6, 0xdd, 0x41, 0xd0,
+	0xf5, 0x1e, 0xdd, 0xe7, 0x06, 0x90, 0x31, 0xca, 0x12, 0xfb, 0x2c, 0x68, 0x3d, 0xba, 0x3f, 0x4e,
+	0xf5, 0xe4, 0x51, 0x35, 0x33, 0x4e, 0xf5, 0xe4, 0xd1, 0x04, 0xd5, 0x93, 0x6a, 0x76, 0x82, 0xea,
+	0x09, 0xb9, 0x0f, 0xeb, 0x66, 0x2f, 0x1c, 0x9a, 0x4e, 0x37, 0xb9, 0x84, 0x1c, 0xa7, 0x25, 0xa2,
+	0xaf, 0x1d, 0x5f, 0xc8, 0x88, 0x23, 0xb9, 0x9e, 0x7c, 0x9c, 0xe3, 0xab, 0xf8, 0xaa, 0x0e, 0x67,
+	0xa9, 0x78, 0x69, 0xeb, 0xce, 0x14, 0x9f, 0x9a, 0xd4, 0xf9, 0x49, 0x2b, 0xd0, 0x7f, 0x5d, 0x83,
+	0xca, 0x38, 0x19, 0x69, 0x40, 0xfe, 0x78, 0xd8, 0x3b, 0xa3, 0xa1, 0x3a, 0xd8, 0x8d, 0xb9, 0xa2,
+	0xeb, 0x0d, 0xce, 0x60, 0x28, 0xc6, 0xda, 0x03, 0xc8, 0x09, 0x14, 0xb9, 0x01, 0x59, 0x87, 0x76,
+	0x07, 0xa2, 0x70, 0xac, 0x19, 0x19, 0x87, 0x3e, 0xe3, 0xcf, 0x8f, 0xf1, 0xa3, 0x13, 0x80, 0xfe,
+	0x77, 0x29, 0x58, 0x6d, 0x27, 0x8d, 0x8f, 0xf4, 0xe1, 0x46, 0x2c, 0x8f, 0xef, 0xf6, 0x1c, 0x33,
+	0x08, 0x22, 0x8d, 0xfb, 0x68, 0xaa, 0xc6, 0xc5, 0xd8, 0x79, 0xba, 0x2e, 0x4b, 0x64, 0x82, 0x53,
+	0x84, 0xd9, 0xb5, 0xd3, 0x71, 0x3c, 0x31, 0x61, 0x6d, 0xbc, 0xd2, 0xa6, 0x82, 0xed, 0xa3, 0xb9,
+	0xc3, 0x3c, 0x4d, 0x54, 0xe2, 0xe4, 0x20, 0xab, 0xc9, 0xfa, 0x5c, 0x50, 0xdb, 0x85, 0x9b, 0xd3,
+	0xe7, 0x33, 0x2f, 0xfa, 0x66, 0xe2, 0xa5, 0x82, 0x06, 0xac, 0x4f, 0x1b, 0xee, 0x3a, 0x32, 0xf0,
+	0xdc, 0x0b, 0x1d, 0xe5, 0x37, 0xdf, 0x87, 0x0a, 0xf3, 0x28, 0x7f, 0xaf, 0x76, 0x45, 0x7c, 0x09,
+	0xa4, 0xdd, 0xad, 0x22, 0x7e, 0x67, 0x84, 0x26, 0x1b, 0x58, 0x62, 0x34, 0x2d, 0x71, 0xa7, 0xe8,
+	0x86, 0x2c, 0x94, 0xce, 0x2a, 0x83, 0x05, 0x46, 0xd3, 0xe2, 0xb7, 0x8a, 0x0e, 0x62, 0xc9, 0x3d,
+	0x58, 0xbb, 0xf0, 0xed, 0x90, 0x26, 0x48, 0x85, 0x09, 0xae, 0xf2, 0x8e, 0x11, 0xad, 0xfe, 0xc7,
+	0x39, 0x28, 0x46, 0xae, 0x82, 0x34, 0xa0, 0xe8, 0x31, 0xab, 0xdb, 0xf7, 0xd9, 0xd0, 0xbb, 0xd2,
+	0x93, 0x72, 0x72, 0x4c, 0x97, 0x9f, 0x22, 0x29, 0xd6, 0xaa, 0x3c, 0xd9, 0xae, 0xfd, 0x55, 0x96,
+	0xe7, 0xdf, 0x1c, 0x20, 0x9f, 0x42, 0xc6, 0x67, 0x17, 0x4a, 0x67, 0xde, 0x5b, 0x40, 0x56, 0xdd,
+	0x60, 0x17, 0x06, 0x67, 0xaa, 0xfd, 0x66, 0x16, 0xd2, 0x06, 0xbb, 0x78, 0xd5, 0x5c, 0x60, 0x6e,
+	0x78, 0xde, 0x80, 0x8a, 0xf8, 0x6e, 0xa3, 0x8b, 0x8b, 0x16, 0x46, 0x21, 0xb6, 0x69, 0x45, 0xe0,
+	0x5b, 0xcc, 0x12, 0xb6, 0x7f, 0x0f, 0xd6, 0xfc, 0xa1, 0xeb, 0xda, 0x6e, 0x3f, 0x46, 0x2a, 0xdc,
+	0xd5, 0xaa, 0xec, 0x88, 0x68, 0x37, 0xa0, 0x82, 0x2e, 0x25, 0x21, 0x55, 0xf8, 0xa1, 0x15, 0x81,
+	0x8f, 0x28, 0x3f, 0x84, 0xac, 0x88, 0xa6, 0xd9, 0x19, 0x15, 0x85, 0x91, 0x77, 0x36, 0x04, 0x25,
+	0x79, 0x1c, 0x0f, 0xc2, 0x85, 0x59, 0xd7, 0x2f, 0xa9, 0x5d, 0xb1, 0xf8, 0xfc, 0xf5, 0x8c, 0x90,
+	0x5b, 0xda, 0xba, 0x3d, 0xcf, 0xc0, 0x26, 0x82, 0x32, 0xf9, 0x1c, 0x0a, 0x61, 0x20, 0xe7, 0x00,
+	0xb3, 0xea, 0x99, 0xbe, 0x79, 0x72, 0x62, 0xf7, 0xda, 0x9e, 0x63, 0x87, 0x62, 0x32, 0xf9, 0x30,
+	0x10, 0x73, 0xf9, 0x11, 0x2c, 0x8b, 0xab, 0x5a, 0xf7, 0xf8, 0x12, 0xf7, 0xa8, 0x9a, 0xe7, 0xca,
+	0xf1, 0xf1, 0x82, 0xca, 0x51, 0x17, 0x77, 0xb5, 0xc6, 0x25, 0x5e, 0xd6, 0x44, 0xe2, 0x4e, 0x47,
+	0x98, 0xda, 0x77, 0x50, 0x19, 0x27, 0x98, 0x62, 0x9e, 0xf7, 0xe3, 0xe6, 0x39, 0x2d, 0x7c, 0x46,
+	0x77, 0xc2, 0x98, 0xe9, 0xe2, 0x0d, 0x8c, 0x47, 0x5d, 0xbd, 0x0d, 0x6b, 0x13, 0x0b, 0xc4, 0x8b,
+	0x95, 0xe9, 0xd1, 0x97, 0x72, 0x18, 0xde, 0x46, 0x9c, 0x43, 0xcd, 0x13, 0x75, 0x01, 0xc3, 0x36,
+	0xe6, 0xd0, 0x17, 0xd4, 0xee, 0x9f, 0xca, 0x6f, 0x5d, 0x0c, 0x09, 0xe9, 0x7f, 0x93, 0x82, 0xd7,
+	0xf8, 0x92, 0xed, 0x01, 0x6d, 0x53, 0xdf, 0xa6, 0xc1, 0xff, 0x25, 0xaa, 0x53, 0x13, 0xd5, 0x75,
+	0xc8, 0xfa, 0xf8, 0x9a, 0x28, 0xbf, 0xae, 0x12, 0x00, 0x6e, 0x75, 0x10, 0x52, 0x4f, 0x7e, 0x54,
+	0xc1, 0xdb, 0x89, 0xf4, 0xf1, 0xaf, 0x35, 0xb8, 0x39, 0xbe, 0xbd, 0x32, 0x97, 0xfb, 0x2c, 0x96,
+	0xcb, 0xdd, 0x9b, 0xae, 0x86, 0x13, 0x4c, 0xdf, 0x3f, 0x9d, 0xfb, 0x9c, 0xa7, 0x73, 0x1f, 0x41,
+	0x2e, 0xe0, 0x82, 0xa5, 0x8f, 0xbc, 0x35, 0x6f, 0x7c, 0x49, 0x9e, 0x48, 0xe5, 0x7e, 0x25, 0x05,
+	0x2b, 0x49, 0xb2, 0xff, 0x32, 0xa7, 0xf9, 0x39, 0xe4, 0xf8, 0xdb, 0x80, 0xb8, 0x01, 0x96, 0xb6,
+	0xde, 0x9d, 0x33, 0xdf, 0x7a, 0x0b, 0xa9, 0x0d, 0xc9, 0x54, 0xfb, 0x31, 0x64, 0x39, 0x82, 0xdc,
+	0x81, 0x72, 0x54, 0x51, 0x52, 0x29, 0x4a, 0xda, 0x28, 0x45, 0xb8, 0x67, 0xc1, 0xc8, 0x3f, 0xa6,
+	0x16, 0xf5, 0x8f, 0x3a, 0x83, 0x72, 0xd3, 0xea, 0xff, 0xf7, 0x59, 0x8e, 0xfe, 0x27, 0x1a, 0x2c,
+	0xcb, 0x11, 0xa5, 0x32, 0x3d, 0x88, 0x29, 0xd3, 0x64, 0x62, 0x98, 0xa0, 0xfd, 0xfe, 0x3a, 0xf4,
+	0x21, 0xd7, 0xa1, 0x0f, 0x20, 0x4b, 0xad, 0x7e, 0xa4, 0x42, 0xaf, 0x4d, 0x1d, 0xd5, 0x10, 0x34,
+	0x09, 0xbd, 0xf9, 0xfb, 0x14, 0x64, 0xb0, 0x8f, 0x7c, 0x00, 0xe9, 0xc0, 0xef, 0xcd, 0x57, 0x14,
+	0xa4, 0x42, 0x62, 0x2b, 0x98, 0x5d, 0xfd, 0x1b, 0x11, 0x5b, 0x41, 0x88, 0xd7, 0xc6, 0x9e, 0x63,
+	0x53, 0x37, 0xec, 0xda, 0x96, 0x74, 0x78, 0x05, 0x81, 0xd8, 0xb7, 0xb0, 0x93, 0x3f, 0x7c, 0xf8,
+	0xd8, 0x29, 0xaa, 0x5d, 0x05, 0x81, 0xd8, 0xb7, 0xf8, 0x17, 0x87, 0xac, 0x6b, 0x5b, 0xd4, 0x0d,
+	0xed, 0x10, 0xd3, 0xff, 0xbe, 0x2c, 0x7c, 0x2f, 0xbb, 0x6c, 0x5f, 0x62, 0x9f, 0x05, 0xfd, 0x91,
+	0x9a, 0xe4, 0x16, 0x0e, 0xa3, 0x63, 0xc7, 0x9a, 0x9f, 0xd0, 0xf2, 0x1a, 0x14, 0x78, 0x0d, 0xb4,
+	0xc7, 0x1c, 0xf9, 0x35, 0x56, 0x04, 0x27, 0x63, 0x70, 0x71, 0xe1, 0x18, 0xac, 0xff, 0x6e, 0x0a,
+	0x2a, 0x1d, 0xe6, 0xf1, 0x77, 0xac, 0xff, 0x25, 0xae, 0x3d, 0x7f, 0x3d, 0xd7, 0x7e, 0x9d, 0x2a,
+	0x40, 0xc2, 0x37, 0xff, 0xb9, 0x06, 0x6b, 0xb1, 0xad, 0x91, 0x96, 0xf4, 0x8a, 0x46, 0x81, 0xcf,
+	0x09, 0xec, 0x4c, 0x2e, 0x78, 0xd2, 0x3d, 0x4d, 0x8c, 0x13, 0x59, 0x61, 0xed, 0x09, 0xb7, 0xa6,
+	0x07, 0x90, 0xe3, 0x8f, 0xc8, 0xca, 0x9c, 0x26, 0x15, 0x8a, 0xf3, 0x8b, 0xcb, 0xb5, 0x24, 0x4d,
+	0x58, 0xd5, 0x3f, 0x68, 0x00, 0x23, 0x12, 0xf2, 0x20, 0x91, 0x03, 0xdf, 0xba, 0x42, 0xda, 0x28,
+	0xf7, 0x45, 0x05, 0x8c, 0x4e, 0x41, 0x96, 0x24, 0x14, 0x5c, 0xfb, 0x0d, 0x4d, 0xe4, 0xc5, 0x18,
+	0x07, 0x91, 0x57, 0x95, 0xd2, 0x39, 0x30, 0x5f, 0x23, 0x12, 0x6f, 0x4c, 0xb9, 0xf1, 0x37, 0xa6,
+	0xeb, 0x27, 0xa5, 0xfa, 0x2e, 0x54, 0xda, 0x07, 0x47, 0xf2, 0x33, 0x9f, 0x45, 0xbe, 0x50, 0x8f,
+	0x6a, 0xd0, 0xa9, 0x58, 0x0d, 0xfa, 0xcf, 0x34, 0x58, 0x8b, 0x89, 0x91, 0x3a, 0xf0, 0x51, 0xcc,
+	0x9b, 0x4e, 0x09, 0x35, 0xe3, 0xf4, 0xdf, 0xdf, 0xa3, 0x3e, 0xe4, 0x3a, 0x50, 0x87, 0x4c, 0xe0,
+	0xb0, 0x2b, 0xaa, 0x2b, 0xd1, 0xc0, 0x9c, 0x2e, 0x71, 0xfc, 0xff, 0x9c, 0x86, 0x62, 0xd4, 0x7f,
+	0xfd, 0x6f, 0xe6, 0x63, 0x8f, 0xfd, 0xe9, 0x05, 0x1f, 0xfb, 0x47, 0x9a, 0x90, 0x89, 0x6b, 0xc2,
+	0x1b, 0x50, 0x64, 0xc7, 0x3f, 0x41, 0x97, 0x71, 0x2e, 0xb2, 0x2c, 0xcd, 0x18, 0x21, 0xb0, 0x02,
+	0xa2, 0x8c, 0x35, 0x3c, 0xf5, 0x69, 0x70, 0xca, 0x1c, 0x0b, 0x03, 0xb1, 0xf8, 0x5c, 0x95, 0xc8,
+	0xbe, 0x8e, 0xea, 0x7a, 0xc6, 0x3f, 0x80, 0x4d, 0x38, 0x4c, 0x09, 0x61, 0xe1, 0xb0, 0xcf, 0xa2,
+	0xbb, 0x4e, 0x81, 0xdf, 0x75, 0x8a, 0x7d, 0xa6, 0xae, 0x39, 0xa8, 0x90, 0x78, 0xd7, 0x94, 0xfd,
+	0x45, 0xde, 0x0f, 0x1c, 0x25, 0x08, 0x1e, 0xc2, 0x4d, 0xf1, 0xd9, 0xcc, 0xf1, 0xd0, 0xea, 0xd3,
+	0xb0, 0xeb, 0xd3, 0x81, 0x69, 0xe3, 0x9d, 0x8a, 0xdf, 0x2e, 0x34, 0x63, 0x9d, 0xf7, 0x36, 0x78,
+	0xa7, 0xa1, 0xfa, 0xf0, 0x13, 0x91, 0xe3, 0xa1, 0xef, 0x76, 0x7d, 0x13, 0x4d, 0xb5, 0x34, 0xe3,
+	0xa1, 0x28, 0x3a, 0x88, 0x7a, 0x63, 0xe8, 0xbb, 0x86, 0x19, 0x52, 0xfc, 0x1b, 0x86, 0x68, 0x05,
+	0xa3, 0x72, 0x71, 0x39, 0x56, 0x2e, 0xc6, 0xb7, 0x66, 0x45, 0x1c, 0x5b, 0xb3, 0x96, 0x58, 0x33,
+	0x81, 0x8c, 0xaf, 0xfe, 0xd9, 0xa1, 0x19, 0xbc, 0xbd, 0xf5, 0xb3, 0x02, 0xa4, 0xb7, 0x3d, 0x9b,
+	0x7c, 0x07, 0xa5, 0x58, 0xfd, 0x8f, 0x2c, 0x52, 0x8b, 0xac, 0xbd, 0xb3, 0x48, 0x09, 0x51, 0x5f,
+	0x22, 0x27, 0x50, 0x19, 0x2f, 0x82, 0x92, 0xc9, 0x1a, 0xd1, 0x8c, 0x3a, 0xe9, 0xa2, 0xa3, 0xdc,
+	0xd7, 0x48, 0x6f, 0x22, 0xa1, 0xbc, 0x3b, 0x37, 0x31, 0x16, 0x63, 0xbc, 0xb7, 0x60, 0x02, 0xad,
+	0x2f, 0x91, 0x3d, 0xc8, 0xf2, 0x7c, 0x88, 0xbc, 0x39, 0x2b, 0x4f, 0x12, 0x22, 0xdf, 0xba, 0x3a,
+	0x8d, 0xd2, 0x97, 0x48, 0x07, 0x8a, 0x91, 0x5f, 0x27, 0x77, 0xae, 0xf2, 0xf9, 0x42, 0xa2, 0x3e,
+	0x3f, 0x2c, 0x08, 0xa9, 0x23, 0x43, 0xbe, 0x73, 0x95, 0xf7, 0x99, 0x25, 0x75, 0xc2, 0x41, 0xe9,
+	0x4b, 0xe4, 0x1b, 0x28, 0xa8, 0xff, 0x61, 0x90, 0xdb, 0xf3, 0xfe, 0xf6, 0x51, 0xbb, 0x73, 0x05,
+	0x45, 0x24, 0xf2, 0xc7, 0x50, 0x8e, 0xff, 0xe3, 0x87, 0xbc, 0x33, 0x95, 0x69, 0xec, 0x5f, 0x44,
+	0xb5, 0x77, 0xe7, 0x50, 0x45, 0xe2, 0x77, 0x21, 0xdd, 0x31, 0x3d, 0xf2, 0xfa, 0xb4, 0x87, 0x5e,
+	0x25, 0x6c, 0xf6, 0x2b, 0xb0, 0x9e, 0xfe, 0xd5, 0x94, 0x76, 0x5f, 0x23, 0xcf, 0x61, 0x39, 0xf1,
+	0xa1, 0x2a, 0x79, 0x77, 0xa1, 0x0f, 0x59, 0xaf, 0x92, 0x8c, 0x9a, 0xba, 0x0d, 0x79, 0xf5, 0x8f,
+	0x81, 0x19, 0xd9, 0x4d, 0xed, 0x8d, 0x09, 0x7c, 0xec, 0x7f, 0x5c, 0xfa, 0x12, 0x71, 0xa0, 0xd8,
+	0xa6, 0xce, 0xc9, 0x0e, 0xfe, 0x13, 0x8c, 0xfc, 0xdc, 0x88, 0x58, 0xfc, 0x4f, 0xac, 0x1e, 0xff,
+	0x9f, 0x58, 0x44, 0xa7, 0x66, 0x57, 0x5f, 0x94, 0x3c, 0xda, 0xcd, 0x8f, 0x21, 0xb7, 0xc3, 0xff,
+	0x5f, 0x36, 0x73, 0xbe, 0xeb, 0x71, 0x99, 0x48, 0x59, 0xdf, 0x76, 0x1c, 0x7d, 0xa9, 0xf1, 0xe0,
+	0xbb, 0x0f, 0xfb, 0x76, 0x78, 0x3a, 0x3c, 0xc6, 0xa1, 0x36, 0x25, 0x8d, 0xfa, 0xdd, 0xda, 0x1c,
+	0xfd, 0xbf, 0x63, 0xb3, 0x4f, 0xdd, 0x4d, 0x21, 0xf2, 0x38, 0xc7, 0x13, 0xd7, 0x07, 0xff, 0x31,
+	0x00, 0x9d, 0xc5, 0x47, 0x09, 0x56, 0x37, 0x00, 0x00,
 }
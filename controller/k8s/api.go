@@ -2,10 +2,11 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
+	tsv1alpha1 "github.com/deislabs/smi-sdk-go/pkg/apis/split/v1alpha1"
 	tsclient "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	ts "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/informers/externalversions"
 	tsinformers "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/informers/externalversions/split/v1alpha1"
@@ -13,6 +14,7 @@ import (
 	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	sp "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions"
 	spinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/serviceprofile/v1alpha1"
+	sloinformers "github.com/linkerd/linkerd2/controller/gen/client/informers/externalversions/slo/v1alpha1"
 	"github.com/linkerd/linkerd2/pkg/k8s"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -22,9 +24,11 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	arinformers "k8s.io/client-go/informers/admissionregistration/v1beta1"
 	appv1informers "k8s.io/client-go/informers/apps/v1"
@@ -51,6 +55,7 @@ const (
 	Pod
 	RC
 	RS
+	SLO
 	SP
 	SS
 	Svc
@@ -71,6 +76,7 @@ type API struct {
 	pod      coreinformers.PodInformer
 	rc       coreinformers.ReplicationControllerInformer
 	rs       appv1beta2informers.ReplicaSetInformer
+	slo      sloinformers.ServiceLevelObjectiveInformer
 	sp       spinformers.ServiceProfileInformer
 	ss       appv1informers.StatefulSetInformer
 	svc      coreinformers.ServiceInformer
@@ -80,6 +86,10 @@ type API struct {
 	sharedInformers   informers.SharedInformerFactory
 	spSharedInformers sp.SharedInformerFactory
 	tsSharedInformers ts.SharedInformerFactory
+
+	dynamicClient      dynamic.Interface
+	owners             *ownerCache
+	topLevelOwnerKinds map[string]struct{}
 }
 
 // InitializeAPI creates Kubernetes clients and returns an initialized API wrapper.
@@ -95,44 +105,65 @@ func InitializeAPI(kubeConfig string, resources ...APIResource) (*API, error) {
 		return nil, err
 	}
 
-	// check for need and access to ServiceProfiles
+	// check for need and access to ServiceProfiles and ServiceLevelObjectives,
+	// which share a clientset
 	var spClient *spclient.Clientset
 	for _, res := range resources {
-		if res == SP {
-			err := k8s.ServiceProfilesAccess(k8sClient)
-			if err != nil {
-				return nil, err
-			}
+		switch res {
+		case SP:
+			err = k8s.ServiceProfilesAccess(k8sClient)
+		case SLO:
+			err = k8s.ServiceLevelObjectivesAccess(k8sClient)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
 
+		if spClient == nil {
 			spClient, err = NewSpClientSet(kubeConfig)
 			if err != nil {
 				return nil, err
 			}
-
-			break
 		}
 	}
 
-	// TrafficSplits
+	// TrafficSplits are defined by SMI rather than Linkerd, so their CRD may
+	// not be installed; they are only watched if it is
 	var tsClient *tsclient.Clientset
-	for _, res := range resources {
-		if res == TS {
-			tsClient, err = NewTsClientSet(kubeConfig)
-			if err != nil {
-				return nil, err
-			}
-
+	for i, res := range resources {
+		if res != TS {
+			continue
+		}
+		if err := k8s.TrafficSplitsAccess(k8sClient); err != nil {
+			log.Warnf("Not watching TrafficSplits: %s", err)
+			resources = append(resources[:i:i], resources[i+1:]...)
 			break
 		}
+
+		tsClient, err = NewTsClientSet(kubeConfig)
+		if err != nil {
+			return nil, err
+		}
+		break
 	}
-	return NewAPI(k8sClient, spClient, tsClient, resources...), nil
+	dynamicClient, err := NewDynamicClient(kubeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAPI(k8sClient, spClient, tsClient, dynamicClient, resources...), nil
 }
 
-// NewAPI takes a Kubernetes client and returns an initialized API.
+// NewAPI takes a Kubernetes client and returns an initialized API. The
+// dynamic client is used to resolve pod owners of kinds that have no shared
+// informer, and may be nil.
 func NewAPI(
 	k8sClient kubernetes.Interface,
 	spClient spclient.Interface,
 	tsClient tsclient.Interface,
+	dynamicClient dynamic.Interface,
 	resources ...APIResource,
 ) *API {
 	sharedInformers := informers.NewSharedInformerFactory(k8sClient, 10*time.Minute)
@@ -153,7 +184,10 @@ func NewAPI(
 		sharedInformers:   sharedInformers,
 		spSharedInformers: spSharedInformers,
 		tsSharedInformers: tsSharedInformers,
+		dynamicClient:     dynamicClient,
+		owners:            newOwnerCache(),
 	}
+	api.SetTopLevelOwnerKinds(DefaultTopLevelOwnerKinds)
 
 	for _, resource := range resources {
 		switch resource {
@@ -187,6 +221,9 @@ func NewAPI(
 		case RS:
 			api.rs = sharedInformers.Apps().V1beta2().ReplicaSets()
 			api.syncChecks = append(api.syncChecks, api.rs.Informer().HasSynced)
+		case SLO:
+			api.slo = spSharedInformers.Slo().V1alpha1().ServiceLevelObjectives()
+			api.syncChecks = append(api.syncChecks, api.slo.Informer().HasSynced)
 		case SP:
 			api.sp = spSharedInformers.Linkerd().V1alpha1().ServiceProfiles()
 			api.syncChecks = append(api.syncChecks, api.sp.Informer().HasSynced)
@@ -310,6 +347,15 @@ func (api *API) SP() spinformers.ServiceProfileInformer {
 	return api.sp
 }
 
+// SLO provides access to a shared informer and lister for
+// ServiceLevelObjectives.
+func (api *API) SLO() sloinformers.ServiceLevelObjectiveInformer {
+	if api.slo == nil {
+		panic("SLO informer not configured")
+	}
+	return api.slo
+}
+
 // MWC provides access to a shared informer and lister for MutatingWebhookConfigurations.
 func (api *API) MWC() arinformers.MutatingWebhookConfigurationInformer {
 	if api.mwc == nil {
@@ -318,7 +364,7 @@ func (api *API) MWC() arinformers.MutatingWebhookConfigurationInformer {
 	return api.mwc
 }
 
-//Job provides access to a shared informer and lister for Jobs.
+// Job provides access to a shared informer and lister for Jobs.
 func (api *API) Job() batchv1informers.JobInformer {
 	if api.job == nil {
 		panic("Job informer not configured")
@@ -361,51 +407,33 @@ func (api *API) GetObjects(namespace, restype, name string) ([]runtime.Object, e
 		return api.getServices(namespace, name)
 	case k8s.StatefulSet:
 		return api.getStatefulsets(namespace, name)
+	case k8s.TrafficSplit:
+		return api.getTrafficSplits(namespace, name)
 	default:
 		// TODO: ReplicaSet
 		return nil, status.Errorf(codes.Unimplemented, "unimplemented resource type: %s", restype)
 	}
 }
 
-// GetOwnerKindAndName returns the pod owner's kind and name, using owner
-// references from the Kubernetes API. The kind is represented as the Kubernetes
-// singular resource type (e.g. deployment, daemonset, job, etc.).
-// If skipCache is false we use the shared informer cache; otherwise we hit the
-// Kubernetes API directly.
-func (api *API) GetOwnerKindAndName(pod *corev1.Pod, skipCache bool) (string, string) {
-	ownerRefs := pod.GetOwnerReferences()
-	if len(ownerRefs) == 0 {
-		// pod without a parent
-		return "pod", pod.Name
-	} else if len(ownerRefs) > 1 {
-		log.Debugf("unexpected owner reference count (%d): %+v", len(ownerRefs), ownerRefs)
-		return "pod", pod.Name
+// GetObjectsMatching returns the objects GetObjects returns for the given
+// namespace, type, and name, restricted to those whose labels match selector.
+func (api *API) GetObjectsMatching(namespace, restype, name string, selector labels.Selector) ([]runtime.Object, error) {
+	objects, err := api.GetObjects(namespace, restype, name)
+	if err != nil || selector.Empty() {
+		return objects, err
 	}
 
-	parent := ownerRefs[0]
-	if parent.Kind == "ReplicaSet" {
-		var rs *appsv1beta2.ReplicaSet
-		var err error
-		if skipCache {
-			rs, err = api.Client.AppsV1beta2().ReplicaSets(pod.Namespace).Get(parent.Name, metav1.GetOptions{})
-			if err != nil {
-				log.Warnf("failed to retrieve replicaset from indexer %s/%s: %s", pod.Namespace, parent.Name, err)
-			}
-		} else {
-			rs, err = api.RS().Lister().ReplicaSets(pod.Namespace).Get(parent.Name)
-			if err != nil {
-				log.Warnf("failed to retrieve replicaset from k8s %s/%s: %s", pod.Namespace, parent.Name, err)
-			}
+	matching := []runtime.Object{}
+	for _, obj := range objects {
+		metaObj, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
 		}
-
-		if err != nil || len(rs.GetOwnerReferences()) != 1 {
-			return strings.ToLower(parent.Kind), parent.Name
+		if selector.Matches(labels.Set(metaObj.GetLabels())) {
+			matching = append(matching, obj)
 		}
-		rsParent := rs.GetOwnerReferences()[0]
-		return strings.ToLower(rsParent.Kind), rsParent.Name
 	}
-
-	return strings.ToLower(parent.Kind), parent.Name
+	return matching, nil
 }
 
 // GetPodsFor returns all running and pending Pods associated with a given
@@ -512,6 +540,9 @@ func GetNameAndNamespaceOf(obj runtime.Object) (string, string, error) {
 	case *appsv1.StatefulSet:
 		return typed.Name, typed.Namespace, nil
 
+	case *tsv1alpha1.TrafficSplit:
+		return typed.Name, typed.Namespace, nil
+
 	case *corev1.Pod:
 		return typed.Name, typed.Namespace, nil
 
@@ -698,6 +729,36 @@ func (api *API) getStatefulsets(namespace, name string) ([]runtime.Object, error
 	return objects, nil
 }
 
+func (api *API) getTrafficSplits(namespace, name string) ([]runtime.Object, error) {
+	if api.ts == nil {
+		return nil, errors.New("TrafficSplit CRD not found")
+	}
+
+	var err error
+	var trafficSplits []*tsv1alpha1.TrafficSplit
+
+	if namespace == "" {
+		trafficSplits, err = api.TS().Lister().List(labels.Everything())
+	} else if name == "" {
+		trafficSplits, err = api.TS().Lister().TrafficSplits(namespace).List(labels.Everything())
+	} else {
+		var ts *tsv1alpha1.TrafficSplit
+		ts, err = api.TS().Lister().TrafficSplits(namespace).Get(name)
+		trafficSplits = []*tsv1alpha1.TrafficSplit{ts}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []runtime.Object{}
+	for _, ts := range trafficSplits {
+		objects = append(objects, ts)
+	}
+
+	return objects, nil
+}
+
 func (api *API) getJobs(namespace, name string) ([]runtime.Object, error) {
 	var err error
 	var jobs []*batchv1.Job
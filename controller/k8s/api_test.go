@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 )
 
 // newAPI constructs a mock controller/k8s.API object for testing
@@ -223,6 +224,35 @@ metadata:
   namespace: not-my-ns`,
 				},
 			},
+			{
+				err:       nil,
+				namespace: "my-ns",
+				resType:   k8s.TrafficSplit,
+				name:      "my-ts",
+				k8sResResults: []string{`
+apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: my-ts
+  namespace: my-ns
+spec:
+  service: my-svc
+  backends:
+  - service: my-svc-v1
+    weight: 900m
+  - service: my-svc-v2
+    weight: 100m`,
+				},
+				k8sResMisc: []string{`
+apiVersion: split.smi-spec.io/v1alpha1
+kind: TrafficSplit
+metadata:
+  name: my-ts
+  namespace: not-my-ns
+spec:
+  service: my-svc`,
+				},
+			},
 			{
 				err:       nil,
 				namespace: "",
@@ -595,6 +625,7 @@ func TestGetOwnerKindAndName(t *testing.T) {
 	for i, tt := range []struct {
 		expectedOwnerKind string
 		expectedOwnerName string
+		topLevelKinds     []string
 		podConfig         string
 		extraConfigs      []string
 	}{
@@ -675,6 +706,158 @@ metadata:
   name: vote-bot
   namespace: default`,
 		},
+		{
+			expectedOwnerKind: "cronjob",
+			expectedOwnerName: "backup",
+			podConfig: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: backup-1556582400-x7k2p
+  namespace: default
+  ownerReferences:
+  - apiVersion: batch/v1
+    kind: Job
+    name: backup-1556582400
+    controller: true`,
+			extraConfigs: []string{`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: backup-1556582400
+  namespace: default
+  ownerReferences:
+  - apiVersion: batch/v1beta1
+    kind: CronJob
+    name: backup
+    controller: true`, `
+apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: backup
+  namespace: default`,
+			},
+		},
+		{
+			expectedOwnerKind: "rollout",
+			expectedOwnerName: "web",
+			podConfig: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-6d8f9c7b5-q2vzl
+  namespace: default
+  ownerReferences:
+  - apiVersion: apps/v1beta2
+    kind: ReplicaSet
+    name: web-6d8f9c7b5
+    controller: true`,
+			extraConfigs: []string{`
+apiVersion: apps/v1beta2
+kind: ReplicaSet
+metadata:
+  name: web-6d8f9c7b5
+  namespace: default
+  ownerReferences:
+  - apiVersion: argoproj.io/v1alpha1
+    kind: Rollout
+    name: web
+    controller: true`, `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: web
+  namespace: default`,
+			},
+		},
+		{
+			expectedOwnerKind: "deployment",
+			expectedOwnerName: "etcd",
+			podConfig: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-7b9d5c6f4-mn8rt
+  namespace: default
+  ownerReferences:
+  - apiVersion: example.com/v1
+    kind: Backup
+    name: nightly
+  - apiVersion: apps/v1beta2
+    kind: ReplicaSet
+    name: etcd-7b9d5c6f4
+    controller: true`,
+			extraConfigs: []string{`
+apiVersion: apps/v1beta2
+kind: ReplicaSet
+metadata:
+  name: etcd-7b9d5c6f4
+  namespace: default
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: etcd
+    controller: true`, `
+apiVersion: apps/v1beta2
+kind: Deployment
+metadata:
+  name: etcd
+  namespace: default
+  ownerReferences:
+  - apiVersion: etcd.database.coreos.com/v1beta2
+    kind: EtcdCluster
+    name: example
+    controller: true`,
+			},
+		},
+		{
+			expectedOwnerKind: "etcdcluster",
+			expectedOwnerName: "example",
+			topLevelKinds:     []string{"etcdcluster"},
+			podConfig: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-7b9d5c6f4-mn8rt
+  namespace: default
+  ownerReferences:
+  - apiVersion: apps/v1beta2
+    kind: ReplicaSet
+    name: etcd-7b9d5c6f4
+    controller: true`,
+			extraConfigs: []string{`
+apiVersion: apps/v1beta2
+kind: ReplicaSet
+metadata:
+  name: etcd-7b9d5c6f4
+  namespace: default
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: Deployment
+    name: etcd
+    controller: true`, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: etcd
+  namespace: default
+  ownerReferences:
+  - apiVersion: etcd.database.coreos.com/v1beta2
+    kind: EtcdCluster
+    name: example
+    controller: true`, `
+apiVersion: etcd.database.coreos.com/v1beta2
+kind: EtcdCluster
+metadata:
+  name: example
+  namespace: default
+  ownerReferences:
+  - apiVersion: example.com/v1
+    kind: Platform
+    name: prod
+    controller: true`,
+			},
+		},
 	} {
 		tt := tt // pin
 		for _, enableInformers := range []bool{
@@ -687,6 +870,9 @@ metadata:
 				if err != nil {
 					t.Fatalf("newAPI error: %s", err)
 				}
+				if tt.topLevelKinds != nil {
+					api.SetTopLevelOwnerKinds(tt.topLevelKinds)
+				}
 
 				pod := objs[0].(*corev1.Pod)
 				ownerKind, ownerName := api.GetOwnerKindAndName(pod, !enableInformers)
@@ -703,6 +889,54 @@ metadata:
 	}
 }
 
+func TestGetOwnerKindAndNameCachesFailures(t *testing.T) {
+	api, objs, err := newAPI(true, []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web-7b9d5c6f4-mn8rt
+  namespace: default
+  ownerReferences:
+  - apiVersion: apps/v1beta2
+    kind: ReplicaSet
+    name: web-7b9d5c6f4
+    controller: true`,
+	}, `
+apiVersion: apps/v1beta2
+kind: ReplicaSet
+metadata:
+  name: web-7b9d5c6f4
+  namespace: default
+  ownerReferences:
+  - apiVersion: argoproj.io/v1alpha1
+    kind: Rollout
+    name: web
+    controller: true`,
+	)
+	if err != nil {
+		t.Fatalf("newAPI error: %s", err)
+	}
+
+	// the Rollout cannot be read, as if the control plane had no RBAC for it
+	pod := objs[0].(*corev1.Pod)
+	for i := 0; i < 3; i++ {
+		ownerKind, ownerName := api.GetOwnerKindAndName(pod, false)
+		if ownerKind != "rollout" || ownerName != "web" {
+			t.Fatalf("Expected owner to be [rollout/web], got [%s/%s]", ownerKind, ownerName)
+		}
+	}
+
+	gets := 0
+	for _, action := range api.dynamicClient.(*dynamicfake.FakeDynamicClient).Actions() {
+		if action.GetVerb() == "get" && action.GetResource().Resource == "rollouts" {
+			gets++
+		}
+	}
+	if gets != 1 {
+		t.Fatalf("Expected the Rollout to be fetched once, got %d", gets)
+	}
+}
+
 func TestGetServiceProfileFor(t *testing.T) {
 	for _, tt := range []struct {
 		expectedRouteNames []string
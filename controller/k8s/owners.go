@@ -0,0 +1,206 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkerd/linkerd2/pkg/k8s"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// maxOwnerDepth bounds how many ownerReferences are followed from a pod,
+	// guarding against reference cycles.
+	maxOwnerDepth = 8
+
+	// ownerCacheTTL is how long owner references fetched through the dynamic
+	// client, or the failure to fetch them, are reused, so that resolving the
+	// owners of many pods does not query the Kubernetes API for every one of
+	// them.
+	ownerCacheTTL = time.Minute
+)
+
+// DefaultTopLevelOwnerKinds are the kinds at which owner resolution stops
+// unless configured otherwise.
+var DefaultTopLevelOwnerKinds = k8s.DefaultTopLevelOwnerKinds
+
+type ownerKey struct {
+	namespace  string
+	apiVersion string
+	kind       string
+	name       string
+}
+
+type ownerCacheEntry struct {
+	refs    []metav1.OwnerReference
+	err     error
+	expires time.Time
+}
+
+// ownerCache holds the owner references of objects fetched through the
+// dynamic client, which are not backed by a shared informer.
+type ownerCache struct {
+	sync.Mutex
+	entries   map[ownerKey]ownerCacheEntry
+	lastSweep time.Time
+}
+
+func newOwnerCache() *ownerCache {
+	return &ownerCache{entries: make(map[ownerKey]ownerCacheEntry)}
+}
+
+func (c *ownerCache) get(key ownerKey) (ownerCacheEntry, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !time.Now().Before(entry.expires) {
+		return ownerCacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (c *ownerCache) set(key ownerKey, refs []metav1.OwnerReference, err error) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	c.entries[key] = ownerCacheEntry{refs: refs, err: err, expires: now.Add(ownerCacheTTL)}
+
+	// drop expired entries at most once per TTL, so the cache does not keep
+	// owners that have since been deleted
+	if now.Sub(c.lastSweep) >= ownerCacheTTL {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		c.lastSweep = now
+	}
+}
+
+// SetTopLevelOwnerKinds configures the kinds, as singular lower-case resource
+// types, at which GetOwnerKindAndName stops walking ownerReferences. It must
+// be called before the API is used.
+func (api *API) SetTopLevelOwnerKinds(kinds []string) {
+	api.topLevelOwnerKinds = make(map[string]struct{})
+	for _, kind := range kinds {
+		kind = strings.ToLower(strings.TrimSpace(kind))
+		if kind != "" {
+			api.topLevelOwnerKinds[kind] = struct{}{}
+		}
+	}
+}
+
+// GetOwnerKindAndName returns the pod owner's kind and name, using owner
+// references from the Kubernetes API. Controller references are followed up
+// to an object that has no owner, or whose kind is one of the configured
+// top-level kinds, so that e.g. pods of a Job created by a CronJob resolve to
+// the CronJob. Kinds without a shared informer are fetched through the
+// dynamic client. The kind is represented as the Kubernetes singular resource
+// type (e.g. deployment, daemonset, job, etc.).
+// If skipCache is false we use the shared informer cache; otherwise we hit the
+// Kubernetes API directly.
+func (api *API) GetOwnerKindAndName(pod *corev1.Pod, skipCache bool) (string, string) {
+	owner, ok := controllerRef(pod.GetOwnerReferences())
+	if !ok {
+		// pod without a parent
+		return k8s.Pod, pod.Name
+	}
+
+	for depth := 0; depth < maxOwnerDepth; depth++ {
+		kind := strings.ToLower(owner.Kind)
+		if _, ok := api.topLevelOwnerKinds[kind]; ok {
+			return kind, owner.Name
+		}
+
+		refs, err := api.getOwnerReferences(pod.Namespace, owner, skipCache)
+		if err != nil {
+			log.Debugf("failed to retrieve %s %s/%s: %s", kind, pod.Namespace, owner.Name, err)
+			return kind, owner.Name
+		}
+
+		parent, ok := controllerRef(refs)
+		if !ok {
+			return kind, owner.Name
+		}
+		owner = parent
+	}
+
+	log.Warnf("owners of pod %s/%s nested deeper than %d, stopping at %s %s", pod.Namespace, pod.Name, maxOwnerDepth, owner.Kind, owner.Name)
+	return strings.ToLower(owner.Kind), owner.Name
+}
+
+// getOwnerReferences returns the owner references of the object that ref
+// points to, read from the shared informer cache when there is one for its
+// kind, and otherwise through the dynamic client.
+func (api *API) getOwnerReferences(namespace string, ref metav1.OwnerReference, skipCache bool) ([]metav1.OwnerReference, error) {
+	if !skipCache {
+		switch {
+		case ref.Kind == "ReplicaSet" && api.rs != nil:
+			rs, err := api.RS().Lister().ReplicaSets(namespace).Get(ref.Name)
+			if err != nil {
+				return nil, err
+			}
+			return rs.GetOwnerReferences(), nil
+
+		case ref.Kind == "Job" && api.job != nil:
+			job, err := api.Job().Lister().Jobs(namespace).Get(ref.Name)
+			if err != nil {
+				return nil, err
+			}
+			return job.GetOwnerReferences(), nil
+		}
+	}
+
+	key := ownerKey{namespace: namespace, apiVersion: ref.APIVersion, kind: ref.Kind, name: ref.Name}
+	if !skipCache {
+		if entry, ok := api.owners.get(key); ok {
+			return entry.refs, entry.err
+		}
+	}
+
+	if api.dynamicClient == nil {
+		return nil, fmt.Errorf("no dynamic client configured")
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	gvr, _ := meta.UnsafeGuessKindToResource(gv.WithKind(ref.Kind))
+	obj, err := api.dynamicClient.Resource(gvr).Namespace(namespace).Get(ref.Name, metav1.GetOptions{})
+	if err != nil {
+		// failures, such as a kind the control plane may not read, are cached
+		// as well, so that they are not retried and logged for every pod
+		log.Warnf("failed to retrieve %s %s/%s: %s", ref.Kind, namespace, ref.Name, err)
+		api.owners.set(key, nil, err)
+		return nil, err
+	}
+
+	refs := obj.GetOwnerReferences()
+	api.owners.set(key, refs, nil)
+	return refs, nil
+}
+
+// controllerRef returns the reference to the managing controller among refs,
+// or the only reference if none is marked as the controller.
+func controllerRef(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			return ref, true
+		}
+	}
+	if len(refs) == 1 {
+		return refs[0], true
+	}
+	if len(refs) > 1 {
+		log.Debugf("unexpected owner reference count (%d): %+v", len(refs), refs)
+	}
+	return metav1.OwnerReference{}, false
+}
@@ -2,11 +2,32 @@ package k8s
 
 import (
 	"github.com/linkerd/linkerd2/pkg/k8s"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
 )
 
 // NewFakeAPI provides a mock Kubernetes API for testing.
 func NewFakeAPI(configs ...string) (*API, error) {
-	clientSet, _, spClientSet, tsClientSet, err := k8s.NewFakeClientSets(configs...)
+	k8sConfigs := []string{}
+	dynamicObjs := []runtime.Object{}
+	for _, config := range configs {
+		obj, err := toUnstructured(config)
+		if err != nil {
+			return nil, err
+		}
+		dynamicObjs = append(dynamicObjs, obj)
+
+		// kinds without a typed client, such as custom workloads, are only
+		// served by the dynamic client
+		if _, err := k8s.ToRuntimeObject(config); runtime.IsNotRegisteredError(err) {
+			continue
+		}
+		k8sConfigs = append(k8sConfigs, config)
+	}
+
+	clientSet, _, spClientSet, tsClientSet, err := k8s.NewFakeClientSets(k8sConfigs...)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +36,7 @@ func NewFakeAPI(configs ...string) (*API, error) {
 		clientSet,
 		spClientSet,
 		tsClientSet,
+		dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), dynamicObjs...),
 		CM,
 		Deploy,
 		DS,
@@ -25,9 +47,23 @@ func NewFakeAPI(configs ...string) (*API, error) {
 		Pod,
 		RC,
 		RS,
+		SLO,
 		SP,
 		SS,
 		Svc,
 		TS,
 	), nil
 }
+
+func toUnstructured(config string) (*unstructured.Unstructured, error) {
+	bytes, err := yaml.YAMLToJSON([]byte(config))
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(bytes); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,303 @@
+package tap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	apiUtil "github.com/linkerd/linkerd2/controller/api/util"
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"github.com/linkerd/linkerd2/pkg/tap"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/peer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// LogAuditSink logs audit entries through the tap server's logger.
+	LogAuditSink = "log"
+	// EventsAuditSink records audit entries as Kubernetes Events in the
+	// tapped namespace.
+	EventsAuditSink = "events"
+	// FileAuditSink appends audit entries as JSON lines to a file, given as
+	// "file=PATH".
+	FileAuditSink = "file"
+)
+
+const (
+	// AuditStarted marks the entry recorded when a tap session starts
+	// streaming events.
+	AuditStarted = "started"
+	// AuditEnded marks the entry recorded when a tap session ends.
+	AuditEnded = "ended"
+)
+
+// DefaultAuditSinks are the sinks that tap sessions are recorded to unless
+// the tap server is configured otherwise.
+var DefaultAuditSinks = []string{LogAuditSink, EventsAuditSink}
+
+// kinds are the Kubernetes kinds of the resource types that can be tapped.
+var kinds = map[string]string{
+	pkgK8s.DaemonSet:             "DaemonSet",
+	pkgK8s.Deployment:            "Deployment",
+	pkgK8s.Job:                   "Job",
+	pkgK8s.Namespace:             "Namespace",
+	pkgK8s.Pod:                   "Pod",
+	pkgK8s.ReplicaSet:            "ReplicaSet",
+	pkgK8s.ReplicationController: "ReplicationController",
+	pkgK8s.StatefulSet:           "StatefulSet",
+}
+
+// AuditEntry describes a tap session: who tapped what, for how long, and how
+// many events they were sent. Each session is recorded once when it starts
+// streaming, and again when it ends; sessions that fail before streaming are
+// only recorded when they end.
+type AuditEntry struct {
+	Time          time.Time       `json:"time"`
+	Phase         string          `json:"phase"`
+	User          string          `json:"user,omitempty"`
+	Groups        []string        `json:"groups,omitempty"`
+	Peer          string          `json:"peer,omitempty"`
+	Namespace     string          `json:"namespace,omitempty"`
+	Target        string          `json:"target"`
+	LabelSelector string          `json:"labelSelector,omitempty"`
+	Match         json.RawMessage `json:"match,omitempty"`
+	MaxRps        float32         `json:"maxRps"`
+	Pods          int             `json:"pods"`
+	Duration      string          `json:"duration"`
+	Events        uint64          `json:"events"`
+	Error         string          `json:"error,omitempty"`
+
+	// resources are the tapped resources, used to attach Kubernetes Events
+	// to them.
+	resources []*public.Resource
+}
+
+// String summarizes the entry in a sentence.
+func (e *AuditEntry) String() string {
+	user := e.User
+	if user == "" {
+		user = "unauthenticated caller"
+	}
+	if e.Peer != "" {
+		user = fmt.Sprintf("%s (%s)", user, e.Peer)
+	}
+	if e.Phase == AuditStarted {
+		return fmt.Sprintf("%s started tapping %s, on %d pods", user, e.Target, e.Pods)
+	}
+	s := fmt.Sprintf("%s tapped %s for %s, receiving %d events from %d pods", user, e.Target, e.Duration, e.Events, e.Pods)
+	if e.Error != "" {
+		s = fmt.Sprintf("%s: %s", s, e.Error)
+	}
+	return s
+}
+
+// AuditSink records the audit entries of tap sessions. Failures to record an
+// entry are logged rather than returned, so that they do not fail sessions.
+type AuditSink interface {
+	Record(entry *AuditEntry)
+}
+
+// NewAuditSink returns the sink described by spec, which is one of "log",
+// "events" or "file=PATH".
+func NewAuditSink(spec string, k8sClient kubernetes.Interface, controllerNamespace string) (AuditSink, error) {
+	spec = strings.TrimSpace(spec)
+	switch {
+	case spec == LogAuditSink:
+		return logSink{}, nil
+	case spec == EventsAuditSink:
+		return &eventSink{client: k8sClient, controllerNamespace: controllerNamespace}, nil
+	case strings.HasPrefix(spec, FileAuditSink+"="):
+		path := strings.TrimPrefix(spec, FileAuditSink+"=")
+		file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log: %s", err)
+		}
+		return &fileSink{encoder: json.NewEncoder(file)}, nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink \"%s\"; must be one of \"%s\", \"%s\" or \"%s=PATH\"", spec, LogAuditSink, EventsAuditSink, FileAuditSink)
+	}
+}
+
+type logSink struct{}
+
+func (logSink) Record(entry *AuditEntry) {
+	fields := log.Fields{
+		"user":      entry.User,
+		"peer":      entry.Peer,
+		"namespace": entry.Namespace,
+		"target":    entry.Target,
+		"match":     string(entry.Match),
+		"maxRps":    entry.MaxRps,
+		"pods":      entry.Pods,
+	}
+	if entry.LabelSelector != "" {
+		fields["labelSelector"] = entry.LabelSelector
+	}
+	if entry.Phase == AuditStarted {
+		log.WithFields(fields).Info("tap session started")
+		return
+	}
+
+	fields["duration"] = entry.Duration
+	fields["events"] = entry.Events
+	if entry.Error != "" {
+		fields["error"] = entry.Error
+	}
+	log.WithFields(fields).Info("tap session ended")
+}
+
+type fileSink struct {
+	sync.Mutex
+	encoder *json.Encoder
+}
+
+func (s *fileSink) Record(entry *AuditEntry) {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.encoder.Encode(entry); err != nil {
+		log.Errorf("failed to write tap audit entry: %s", err)
+	}
+}
+
+type eventSink struct {
+	client              kubernetes.Interface
+	controllerNamespace string
+}
+
+// Record creates an Event for each tapped resource, or for its namespace if
+// the target names no single resource.
+func (s *eventSink) Record(entry *AuditEntry) {
+	eventType, reason := corev1.EventTypeNormal, "Tapped"
+	if entry.Phase == AuditStarted {
+		reason = "TapStarted"
+	} else if entry.Error != "" {
+		eventType, reason = corev1.EventTypeWarning, "TapFailed"
+	}
+
+	resources := entry.resources
+	if len(resources) == 0 {
+		resources = []*public.Resource{nil}
+	}
+	for _, resource := range resources {
+		namespace := resourceNamespace(resource)
+		if namespace == "" {
+			namespace = s.controllerNamespace
+		}
+
+		involved := corev1.ObjectReference{Kind: kinds[pkgK8s.Namespace], Namespace: namespace, Name: namespace}
+		if kind, ok := kinds[resource.GetType()]; ok && resource.GetName() != "" && resource.GetType() != pkgK8s.Namespace {
+			involved = corev1.ObjectReference{Kind: kind, Namespace: namespace, Name: resource.GetName()}
+		}
+
+		now := metav1.NewTime(entry.Time)
+		_, err := s.client.CoreV1().Events(namespace).Create(&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "linkerd-tap-",
+				Namespace:    namespace,
+			},
+			InvolvedObject: involved,
+			Reason:         reason,
+			Message:        entry.String(),
+			Source:         corev1.EventSource{Component: "linkerd-tap"},
+			FirstTimestamp: now,
+			LastTimestamp:  now,
+			Count:          1,
+			Type:           eventType,
+		})
+		if err != nil {
+			log.Errorf("failed to create tap audit event: %s", err)
+		}
+	}
+}
+
+// auditSession tracks a tap session, to record it to the server's audit sinks
+// when it starts streaming and once it ends.
+type auditSession struct {
+	sinks []AuditSink
+	start time.Time
+	entry AuditEntry
+}
+
+func newAuditSession(ctx context.Context, sinks []AuditSink, req *public.TapByResourceRequest) *auditSession {
+	session := &auditSession{
+		sinks: sinks,
+		start: time.Now(),
+		entry: AuditEntry{
+			MaxRps: req.GetMaxRps(),
+		},
+	}
+
+	// targets are summarized together, as they share the label selector
+	names := []string{}
+	for i, target := range tap.Targets(req) {
+		resource := target.GetResource()
+		name := resource.GetType()
+		if resource.GetName() != "" {
+			name = fmt.Sprintf("%s/%s", resource.GetType(), resource.GetName())
+		}
+		names = append(names, name)
+		session.entry.resources = append(session.entry.resources, resource)
+
+		if i == 0 {
+			session.entry.Namespace = resourceNamespace(resource)
+			if selector, err := apiUtil.LabelSelectorFor(target); err == nil && !selector.Empty() {
+				session.entry.LabelSelector = selector.String()
+			}
+		} else if resourceNamespace(resource) != session.entry.Namespace {
+			session.entry.Namespace = ""
+		}
+	}
+	session.entry.Target = strings.Join(names, ",")
+
+	if p, ok := peer.FromContext(ctx); ok {
+		session.entry.Peer = p.Addr.String()
+	}
+	if req.GetMatch() != nil {
+		if match, err := (&jsonpb.Marshaler{}).MarshalToString(req.GetMatch()); err == nil {
+			session.entry.Match = json.RawMessage(match)
+		}
+	}
+	return session
+}
+
+// resourceNamespace returns the namespace a resource lives in, which for a
+// namespace is the namespace itself.
+func resourceNamespace(resource *public.Resource) string {
+	if resource.GetType() == pkgK8s.Namespace {
+		return resource.GetName()
+	}
+	return resource.GetNamespace()
+}
+
+// begin records the start of the session to every sink.
+func (s *auditSession) begin() {
+	s.entry.Time = time.Now()
+	s.entry.Phase = AuditStarted
+	s.record()
+}
+
+// end records the session, which ended with err, to every sink.
+func (s *auditSession) end(err error) {
+	s.entry.Time = time.Now()
+	s.entry.Phase = AuditEnded
+	s.entry.Duration = s.entry.Time.Sub(s.start).Round(time.Millisecond).String()
+	if err != nil {
+		s.entry.Error = err.Error()
+	}
+	s.record()
+}
+
+func (s *auditSession) record() {
+	for _, sink := range s.sinks {
+		sink.Record(&s.entry)
+	}
+}
@@ -0,0 +1,285 @@
+package tap
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/controller/k8s"
+	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"google.golang.org/grpc/metadata"
+	authnV1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type recordingSink struct {
+	sync.Mutex
+	entries []AuditEntry
+}
+
+func (s *recordingSink) Record(entry *AuditEntry) {
+	s.Lock()
+	defer s.Unlock()
+	s.entries = append(s.entries, *entry)
+}
+
+func TestAuditTapSessions(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI()
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+
+	sink := &recordingSink{}
+	server, listener, err := NewServer("localhost:0", 0, "controller-ns", k8sAPI, false, []AuditSink{sink})
+	if err != nil {
+		t.Fatalf("NewServer error: %s", err)
+	}
+
+	go func() { server.Serve(listener) }()
+	defer server.GracefulStop()
+
+	k8sAPI.Sync()
+
+	client, conn, err := NewClient(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	stream, err := client.TapByResource(ctx, &public.TapByResourceRequest{
+		Target: &public.ResourceSelection{
+			Resource: &public.Resource{
+				Namespace: "emojivoto",
+				Type:      pkgK8s.Deployment,
+				Name:      "web",
+			},
+			LabelSelector: "app=web",
+		},
+		MaxRps: 10,
+	})
+	if err != nil {
+		t.Fatalf("TapByResource failed: %v", err)
+	}
+	if _, err := stream.Recv(); err == nil || err == io.EOF {
+		t.Fatalf("Expected an error for a missing deployment, got %v", err)
+	}
+
+	sink.Lock()
+	defer sink.Unlock()
+	if len(sink.entries) != 1 {
+		t.Fatalf("Expected 1 audit entry, got %d", len(sink.entries))
+	}
+	entry := sink.entries[0]
+	if entry.Phase != AuditEnded {
+		t.Fatalf("Expected only an %s entry for a session that never streamed, got %+v", AuditEnded, entry)
+	}
+	if entry.Namespace != "emojivoto" || entry.Target != "deployment/web" || entry.LabelSelector != "app=web" || entry.MaxRps != 10 {
+		t.Fatalf("Unexpected target in audit entry: %+v", entry)
+	}
+	if entry.Peer == "" {
+		t.Fatalf("Expected the caller's address in audit entry: %+v", entry)
+	}
+	if !strings.Contains(entry.Error, "not found") {
+		t.Fatalf("Expected a not found error in audit entry: %+v", entry)
+	}
+}
+
+func TestAuditStreamingSessions(t *testing.T) {
+	k8sAPI, err := k8s.NewFakeAPI(`
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: emojivoto`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  namespace: emojivoto
+  labels:
+    linkerd.io/control-plane-ns: controller-ns
+status:
+  phase: Running
+  podIP: 127.0.0.1`,
+	)
+	if err != nil {
+		t.Fatalf("NewFakeAPI returned an error: %s", err)
+	}
+	k8sAPI.Client.(*fake.Clientset).PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		tr := action.(k8stesting.CreateAction).GetObject().(*authnV1.TokenReview)
+		if tr.Spec.Token == "tenant-token" {
+			tr.Status.Authenticated = true
+			tr.Status.User = authnV1.UserInfo{Username: "tenant"}
+		}
+		return true, tr, nil
+	})
+
+	// authorization is disabled, but callers are still identified
+	sink := &recordingSink{}
+	server, listener, err := NewServer("localhost:0", 0, "controller-ns", k8sAPI, false, []AuditSink{sink})
+	if err != nil {
+		t.Fatalf("NewServer error: %s", err)
+	}
+
+	go func() { server.Serve(listener) }()
+	defer server.GracefulStop()
+
+	k8sAPI.Sync()
+
+	client, conn, err := NewClient(listener.Addr().String())
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer tenant-token")
+
+	stream, err := client.TapByResource(ctx, &public.TapByResourceRequest{
+		Target: &public.ResourceSelection{
+			Resource: &public.Resource{Type: pkgK8s.Namespace, Name: "emojivoto"},
+		},
+		Match: &public.TapByResourceRequest_Match{
+			Match: &public.TapByResourceRequest_Match_All{
+				All: &public.TapByResourceRequest_Match_Seq{},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("TapByResource failed: %v", err)
+	}
+	stream.Recv()
+
+	// the session ends on the server after the caller gives up
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		sink.Lock()
+		n := len(sink.entries)
+		sink.Unlock()
+		if n >= 2 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	sink.Lock()
+	defer sink.Unlock()
+	if len(sink.entries) != 2 {
+		t.Fatalf("Expected 2 audit entries, got %+v", sink.entries)
+	}
+	for i, phase := range []string{AuditStarted, AuditEnded} {
+		entry := sink.entries[i]
+		if entry.Phase != phase || entry.User != "tenant" || entry.Pods != 1 {
+			t.Fatalf("Expected an %s entry for tenant tapping 1 pod, got %+v", phase, entry)
+		}
+	}
+}
+
+func TestAuditMultipleTargets(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sink := &recordingSink{}
+	session := newAuditSession(context.Background(), []AuditSink{sink, &eventSink{client: client, controllerNamespace: "linkerd"}},
+		&public.TapByResourceRequest{
+			Target: &public.ResourceSelection{Resource: &public.Resource{Namespace: "emojivoto", Type: pkgK8s.Deployment, Name: "web"}},
+			Targets: []*public.ResourceSelection{
+				{Resource: &public.Resource{Namespace: "books", Type: pkgK8s.Deployment, Name: "webapp"}},
+			},
+		})
+	session.end(nil)
+
+	entry := sink.entries[0]
+	if entry.Target != "deployment/web,deployment/webapp" || entry.Namespace != "" {
+		t.Fatalf("Unexpected targets in audit entry: %+v", entry)
+	}
+	for _, ns := range []string{"emojivoto", "books"} {
+		events, err := client.CoreV1().Events(ns).List(metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(events.Items) != 1 || events.Items[0].InvolvedObject.Kind != "Deployment" {
+			t.Fatalf("Expected 1 Deployment event in %s, got %+v", ns, events.Items)
+		}
+	}
+}
+
+func TestEventSink(t *testing.T) {
+	expectations := []struct {
+		resource *public.Resource
+		errMsg   string
+		kind     string
+		name     string
+		reason   string
+	}{
+		{
+			resource: &public.Resource{Namespace: "emojivoto", Type: pkgK8s.Deployment, Name: "web"},
+			kind:     "Deployment",
+			name:     "web",
+			reason:   "Tapped",
+		},
+		{
+			resource: &public.Resource{Namespace: "emojivoto", Type: pkgK8s.Deployment},
+			kind:     "Namespace",
+			name:     "emojivoto",
+			reason:   "Tapped",
+		},
+		{
+			resource: &public.Resource{Type: pkgK8s.Namespace, Name: "emojivoto"},
+			errMsg:   "rpc error: code = PermissionDenied desc = not authorized",
+			kind:     "Namespace",
+			name:     "emojivoto",
+			reason:   "TapFailed",
+		},
+	}
+
+	for _, exp := range expectations {
+		client := fake.NewSimpleClientset()
+		session := newAuditSession(context.Background(), []AuditSink{&eventSink{client: client, controllerNamespace: "linkerd"}},
+			&public.TapByResourceRequest{Target: &public.ResourceSelection{Resource: exp.resource}})
+		session.entry.User = "tenant"
+
+		var err error
+		if exp.errMsg != "" {
+			err = errors.New(exp.errMsg)
+		}
+		session.end(err)
+
+		events, err := client.CoreV1().Events("emojivoto").List(metav1.ListOptions{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if len(events.Items) != 1 {
+			t.Fatalf("Expected 1 event, got %d", len(events.Items))
+		}
+		event := events.Items[0]
+		if event.InvolvedObject.Kind != exp.kind || event.InvolvedObject.Name != exp.name || event.Reason != exp.reason {
+			t.Fatalf("Expected a %s event for %s/%s, got %+v", exp.reason, exp.kind, exp.name, event)
+		}
+		if !strings.HasPrefix(event.Message, "tenant tapped ") {
+			t.Fatalf("Expected the event message to name the caller, got %q", event.Message)
+		}
+	}
+}
+
+func TestNewAuditSink(t *testing.T) {
+	for _, spec := range []string{"log", " events ", "file=/dev/null"} {
+		if _, err := NewAuditSink(spec, fake.NewSimpleClientset(), "linkerd"); err != nil {
+			t.Fatalf("Unexpected error for \"%s\": %s", spec, err)
+		}
+	}
+	for _, spec := range []string{"syslog", "file"} {
+		if _, err := NewAuditSink(spec, fake.NewSimpleClientset(), "linkerd"); err == nil {
+			t.Fatalf("Expected an error for \"%s\"", spec)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package tap
+
+import (
+	"sync"
+	"time"
+)
+
+// rpsAllocator divides the maxRps of a tap between the pods being tapped.
+// Every pod is entitled to an even share of it. A pod that did not reach its
+// limit in its last window keeps the rate it observed, and the rest of its
+// share is lent to the pods that reached theirs, so that idle pods do not
+// starve busy ones without the pods exceeding maxRps between them.
+type rpsAllocator struct {
+	sync.Mutex
+	maxRps float32
+	usage  map[string]*podUsage
+}
+
+// podUsage is the rate at which a pod was observed in its last window.
+type podUsage struct {
+	rps       float32
+	saturated bool
+	updated   time.Time
+}
+
+func newRpsAllocator(maxRps float32, pods []string) *rpsAllocator {
+	// until they are observed, pods are assumed to need their whole share
+	now := time.Now()
+	usage := make(map[string]*podUsage)
+	for _, pod := range pods {
+		usage[pod] = &podUsage{saturated: true, updated: now}
+	}
+	return &rpsAllocator{maxRps: maxRps, usage: usage}
+}
+
+// limit returns the number of requests that pod may observe in a window of
+// the given length.
+func (a *rpsAllocator) limit(pod string, window time.Duration) uint32 {
+	a.Lock()
+	defer a.Unlock()
+
+	now := time.Now()
+	share := a.maxRps / float32(len(a.usage))
+	// kept returns the part of its share that a pod that did not reach its
+	// limit keeps for itself, which is at least one request per window
+	kept := func(usage *podUsage) float32 {
+		if now.Sub(usage.updated) > 2*window {
+			// pods that have not reported in a while are idle
+			return 0
+		}
+		rps := usage.rps
+		if min := 1 / float32(window.Seconds()); rps < min {
+			rps = min
+		}
+		if rps > share {
+			return share
+		}
+		return rps
+	}
+
+	rps := share
+	if usage, ok := a.usage[pod]; ok && !usage.saturated {
+		rps = kept(usage)
+	} else {
+		unused := float32(0)
+		saturated := 1
+		for name, usage := range a.usage {
+			if name == pod {
+				continue
+			}
+			if usage.saturated && now.Sub(usage.updated) <= 2*window {
+				saturated++
+			} else {
+				unused += share - kept(usage)
+			}
+		}
+		rps += unused / float32(saturated)
+	}
+
+	limit := uint32(rps * float32(window.Seconds()))
+	if limit < 1 {
+		limit = 1
+	}
+	return limit
+}
+
+// report records that pod observed the given number of requests in a window,
+// and whether this reached its limit.
+func (a *rpsAllocator) report(pod string, observed uint64, window time.Duration, saturated bool) {
+	a.Lock()
+	defer a.Unlock()
+
+	a.usage[pod] = &podUsage{
+		rps:       float32(observed) / float32(window.Seconds()),
+		saturated: saturated,
+		updated:   time.Now(),
+	}
+}
+
+// estimateDropped estimates how many requests were dropped between the time
+// a pod reached its limit and the end of its window, assuming they kept
+// arriving at the rate at which they were observed.
+func estimateDropped(observed uint64, windowStart, limitReached, windowEnd time.Time) uint64 {
+	elapsed := limitReached.Sub(windowStart)
+	remaining := windowEnd.Sub(limitReached)
+	if observed == 0 || elapsed <= 0 || remaining <= 0 {
+		return 0
+	}
+	return uint64(float64(observed) * remaining.Seconds() / elapsed.Seconds())
+}
@@ -0,0 +1,105 @@
+package tap
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRpsAllocator(t *testing.T) {
+	t.Run("Divides maxRps evenly between pods that have not been observed", func(t *testing.T) {
+		a := newRpsAllocator(100, []string{"ns/a", "ns/b", "ns/c", "ns/d"})
+		for _, pod := range []string{"ns/a", "ns/b", "ns/c", "ns/d"} {
+			if limit := a.limit(pod, time.Second); limit != 25 {
+				t.Fatalf("Expected a limit of 25 for %s, got %d", pod, limit)
+			}
+		}
+	})
+
+	t.Run("Lends unused budget to saturated pods", func(t *testing.T) {
+		a := newRpsAllocator(100, []string{"ns/a", "ns/b", "ns/c", "ns/d"})
+		a.report("ns/a", 25, time.Second, true)
+		a.report("ns/b", 25, time.Second, true)
+		a.report("ns/c", 5, time.Second, false)
+		a.report("ns/d", 15, time.Second, false)
+
+		// c and d left 20 and 10 rps unused, shared between a and b
+		if limit := a.limit("ns/a", time.Second); limit != 40 {
+			t.Fatalf("Expected a limit of 40, got %d", limit)
+		}
+		// c and d keep the rate they observed
+		if limit := a.limit("ns/c", time.Second); limit != 5 {
+			t.Fatalf("Expected a limit of 5, got %d", limit)
+		}
+		if limit := a.limit("ns/d", time.Second); limit != 15 {
+			t.Fatalf("Expected a limit of 15, got %d", limit)
+		}
+	})
+
+	t.Run("Never allows more than maxRps between pods", func(t *testing.T) {
+		pods := []string{"ns/a", "ns/b", "ns/c", "ns/d", "ns/e"}
+		reports := []struct {
+			observed  []uint64
+			saturated []bool
+		}{
+			{[]uint64{20, 20, 20, 20, 20}, []bool{true, true, true, true, true}},
+			{[]uint64{20, 0, 3, 19, 20}, []bool{true, false, false, false, true}},
+			{[]uint64{60, 1, 3, 19, 7}, []bool{true, false, false, false, false}},
+			{[]uint64{0, 0, 0, 0, 0}, []bool{false, false, false, false, false}},
+			{[]uint64{1, 1, 1, 1, 50}, []bool{true, true, true, true, true}},
+		}
+
+		for _, window := range []time.Duration{time.Second, 3 * time.Second} {
+			a := newRpsAllocator(100, pods)
+			for i, report := range reports {
+				for j, pod := range pods {
+					a.report(pod, report.observed[j]*uint64(window.Seconds()), window, report.saturated[j])
+				}
+
+				total := uint32(0)
+				for _, pod := range pods {
+					total += a.limit(pod, window)
+				}
+				if max := uint32(100 * window.Seconds()); total > max {
+					t.Fatalf("Expected at most %d requests per %s window after report %d, got %d", max, window, i, total)
+				}
+			}
+		}
+	})
+
+	t.Run("Treats pods that have not reported recently as idle", func(t *testing.T) {
+		a := newRpsAllocator(100, []string{"ns/a", "ns/b"})
+		a.usage["ns/b"].updated = time.Now().Add(-time.Minute)
+
+		if limit := a.limit("ns/a", time.Second); limit != 100 {
+			t.Fatalf("Expected a limit of 100, got %d", limit)
+		}
+	})
+
+	t.Run("Allows at least one request per window", func(t *testing.T) {
+		a := newRpsAllocator(1, []string{"ns/a", "ns/b", "ns/c"})
+		if limit := a.limit("ns/a", time.Second); limit != 1 {
+			t.Fatalf("Expected a limit of 1, got %d", limit)
+		}
+	})
+}
+
+func TestEstimateDropped(t *testing.T) {
+	start := time.Now()
+	expectations := []struct {
+		observed     uint64
+		limitReached time.Duration
+		expected     uint64
+	}{
+		{observed: 10, limitReached: 250 * time.Millisecond, expected: 30},
+		{observed: 10, limitReached: 500 * time.Millisecond, expected: 10},
+		{observed: 10, limitReached: time.Second, expected: 0},
+		{observed: 0, limitReached: 500 * time.Millisecond, expected: 0},
+	}
+
+	for _, exp := range expectations {
+		dropped := estimateDropped(exp.observed, start, start.Add(exp.limitReached), start.Add(time.Second))
+		if dropped != exp.expected {
+			t.Fatalf("Expected %d dropped requests for %d observed in %s, got %d", exp.expected, exp.observed, exp.limitReached, dropped)
+		}
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strings"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	httpPb "github.com/linkerd/linkerd2-proxy-api/go/http_types"
 	proxy "github.com/linkerd/linkerd2-proxy-api/go/tap"
 	apiUtil "github.com/linkerd/linkerd2/controller/api/util"
@@ -16,11 +18,14 @@ import (
 	"github.com/linkerd/linkerd2/pkg/addr"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
 	"github.com/linkerd/linkerd2/pkg/prometheus"
+	"github.com/linkerd/linkerd2/pkg/tap"
 	"github.com/linkerd/linkerd2/pkg/util"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	authnV1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
 )
@@ -33,6 +38,8 @@ type (
 		tapPort             uint
 		k8sAPI              *k8s.API
 		controllerNamespace string
+		enableAuthz         bool
+		auditSinks          []AuditSink
 	}
 )
 
@@ -45,68 +52,91 @@ func (s *server) Tap(req *public.TapRequest, stream pb.Tap_TapServer) error {
 }
 
 func (s *server) TapByResource(req *public.TapByResourceRequest, stream pb.Tap_TapByResourceServer) error {
+	session := newAuditSession(stream.Context(), s.auditSinks, req)
+	err := s.tapByResource(req, stream, session)
+	session.end(err)
+	return err
+}
+
+func (s *server) tapByResource(req *public.TapByResourceRequest, stream pb.Tap_TapByResourceServer, session *auditSession) error {
 	if req == nil {
 		return status.Error(codes.InvalidArgument, "TapByResource received nil TapByResourceRequest")
 	}
-	if req.Target == nil {
+	targets := tap.Targets(req)
+	if len(targets) == 0 {
 		return status.Error(codes.InvalidArgument, "TapByResource received nil target ResourceSelection")
 	}
+	// the caller is identified for the audit log whether or not authorization
+	// is enabled
+	user, err := s.authenticate(stream.Context())
+	if user != nil {
+		session.entry.User, session.entry.Groups = user.Username, user.Groups
+	}
+	if s.enableAuthz {
+		if err != nil {
+			return err
+		}
+		resources := make([]*public.Resource, len(targets))
+		for i, target := range targets {
+			resources[i] = target.GetResource()
+		}
+		if err := s.authorize(*user, resources); err != nil {
+			return err
+		}
+	} else if err != nil {
+		log.Debugf("Tapping as an unauthenticated caller: %s", err)
+	}
 	if req.MaxRps == 0.0 {
 		req.MaxRps = defaultMaxRps
 	}
 
-	objects, err := s.k8sAPI.GetObjects(req.Target.Resource.Namespace, req.Target.Resource.Type, req.Target.Resource.Name)
-	if err != nil {
-		return apiUtil.GRPCError(err)
-	}
-
+	// a pod selected by several targets is only tapped once, for the first
 	pods := []*corev1.Pod{}
-	foundDisabledPods := false
-	for _, object := range objects {
-		podsFor, err := s.k8sAPI.GetPodsFor(object, false)
+	podTargets := map[string]*public.Resource{}
+	for _, target := range targets {
+		podsFor, err := s.podsFor(target)
 		if err != nil {
-			return apiUtil.GRPCError(err)
+			return err
 		}
-
 		for _, pod := range podsFor {
-			if pkgK8s.IsMeshed(pod, s.controllerNamespace) {
-				if pkgK8s.IsTapDisabled(pod) {
-					foundDisabledPods = true
-				} else {
-					pods = append(pods, pod)
-				}
+			if _, ok := podTargets[podKey(pod)]; !ok {
+				podTargets[podKey(pod)] = target.GetResource()
+				pods = append(pods, pod)
 			}
 		}
 	}
 
-	if len(pods) == 0 {
-		resType := req.GetTarget().GetResource().GetType()
-		resName := req.GetTarget().GetResource().GetName()
-		if foundDisabledPods {
-			return status.Errorf(codes.NotFound,
-				"all pods found for %s/%s have tapping disabled", resType, resName)
-		}
-		return status.Errorf(codes.NotFound, "no pods found for %s/%s", resType, resName)
-	}
-
-	log.Infof("Tapping %d pods for target: %+v", len(pods), *req.Target.Resource)
+	log.Infof("Tapping %d pods for %d targets", len(pods), len(targets))
+	session.entry.Pods = len(pods)
 
 	events := make(chan *public.TapEvent)
 
-	// divide the rps evenly between all pods to tap
-	rpsPerPod := req.MaxRps / float32(len(pods))
-	if rpsPerPod < 1 {
-		rpsPerPod = 1
-	}
-
 	match, err := makeByResourceMatch(req.Match)
 	if err != nil {
 		return apiUtil.GRPCError(err)
 	}
 
+	// the session is on record before any event is sent, even if it never
+	// ends cleanly
+	session.begin()
+
+	// share the rps between all pods to tap
+	names := make([]string, len(pods))
+	for i, pod := range pods {
+		names[i] = podKey(pod)
+	}
+	allocator := newRpsAllocator(req.MaxRps, names)
+
 	for _, pod := range pods {
 		// initiate a tap on the pod
-		go s.tapProxy(stream.Context(), rpsPerPod, match, pod.Status.PodIP, events)
+		go s.tapProxy(stream.Context(), &podTap{
+			pod:            pod,
+			target:         podTargets[podKey(pod)],
+			match:          match,
+			filter:         tap.NewResponseFilter(req.Match),
+			allocator:      allocator,
+			reportSampling: req.GetReportSampling(),
+		}, events)
 	}
 
 	// read events from the taps and send them back
@@ -119,10 +149,56 @@ func (s *server) TapByResource(req *public.TapByResourceRequest, stream pb.Tap_T
 			if err != nil {
 				return apiUtil.GRPCError(err)
 			}
+			session.entry.Events++
 		}
 	}
 }
 
+// podsFor returns the meshed pods selected by target that can be tapped.
+func (s *server) podsFor(target *public.ResourceSelection) ([]*corev1.Pod, error) {
+	if target.GetResource() == nil {
+		return nil, status.Error(codes.InvalidArgument, "TapByResource received nil target Resource")
+	}
+	selector, err := apiUtil.LabelSelectorFor(target)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resource := target.GetResource()
+	objects, err := s.k8sAPI.GetObjectsMatching(resource.Namespace, resource.Type, resource.Name, selector)
+	if err != nil {
+		return nil, apiUtil.GRPCError(err)
+	}
+
+	pods := []*corev1.Pod{}
+	foundDisabledPods := false
+	for _, object := range objects {
+		podsFor, err := s.k8sAPI.GetPodsFor(object, false)
+		if err != nil {
+			return nil, apiUtil.GRPCError(err)
+		}
+
+		for _, pod := range podsFor {
+			if pkgK8s.IsMeshed(pod, s.controllerNamespace) {
+				if pkgK8s.IsTapDisabled(pod) {
+					foundDisabledPods = true
+				} else {
+					pods = append(pods, pod)
+				}
+			}
+		}
+	}
+
+	if len(pods) == 0 {
+		if foundDisabledPods {
+			return nil, status.Errorf(codes.NotFound,
+				"all pods found for %s/%s have tapping disabled", resource.Type, resource.Name)
+		}
+		return nil, status.Errorf(codes.NotFound, "no pods found for %s/%s", resource.Type, resource.Name)
+	}
+	return pods, nil
+}
+
 func makeByResourceMatch(match *public.TapByResourceRequest_Match) (*proxy.ObserveRequest_Match, error) {
 	// TODO: for now assume it's always a single, flat `All` match list
 	seq := match.GetAll()
@@ -135,6 +211,9 @@ func makeByResourceMatch(match *public.TapByResourceRequest_Match) (*proxy.Obser
 	for _, reqMatch := range seq.Matches {
 		switch typed := reqMatch.Match.(type) {
 		case *public.TapByResourceRequest_Match_Destinations:
+			if typed.Destinations.GetLabelSelector() != "" || typed.Destinations.GetLabels() != nil {
+				return nil, status.Error(codes.InvalidArgument, "label selectors are not supported for tap destinations")
+			}
 
 			for k, v := range destinationLabels(typed.Destinations.Resource) {
 				matches = append(matches, &proxy.ObserveRequest_Match{
@@ -147,6 +226,12 @@ func makeByResourceMatch(match *public.TapByResourceRequest_Match) (*proxy.Obser
 				})
 			}
 
+		case *public.TapByResourceRequest_Match_Response_:
+			// responses are matched by the tap server, as they end
+			if err := tap.ValidateResponseMatch(typed.Response); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
 		case *public.TapByResourceRequest_Match_Http_:
 
 			httpMatch := proxy.ObserveRequest_Match_Http{}
@@ -221,15 +306,30 @@ func destinationLabels(resource *public.Resource) map[string]string {
 	return dstLabels
 }
 
+// podTap holds the state of the tap of a single pod.
+type podTap struct {
+	pod            *corev1.Pod
+	target         *public.Resource
+	match          *proxy.ObserveRequest_Match
+	filter         *tap.ResponseFilter
+	allocator      *rpsAllocator
+	reportSampling bool
+}
+
 // Tap a pod.
 // This method will run continuously until an error is encountered or the
 // request is cancelled via the context.  Thus it should be called as a
 // go-routine.
-// To limit the rps to maxRps, this method calls Observe on the pod with a limit
-// of maxRps * 1s at most once per 1s window.  If this limit is reached in
-// less than 1s, we sleep until the end of the window before calling Observe
-// again.
-func (s *server) tapProxy(ctx context.Context, maxRps float32, match *proxy.ObserveRequest_Match, addr string, events chan *public.TapEvent) {
+// To limit the rps to the pod's share of maxRps, this method calls Observe on
+// the pod with a limit given by the allocator at most once per 1s window.  If
+// this limit is reached in less than 1s, we sleep until the end of the window
+// before calling Observe again, and the requests made in the meantime are
+// counted as dropped.
+// Events are only sent once released by filter, so that streams can be
+// selected by their response.
+func (s *server) tapProxy(ctx context.Context, podTap *podTap, events chan *public.TapEvent) {
+	addr := podTap.pod.Status.PodIP
+	name := podKey(podTap.pod)
 	tapAddr := fmt.Sprintf("%s:%d", addr, s.tapPort)
 	log.Infof("Establishing tap on %s", tapAddr)
 	conn, err := grpc.DialContext(ctx, tapAddr, grpc.WithInsecure())
@@ -240,19 +340,52 @@ func (s *server) tapProxy(ctx context.Context, maxRps float32, match *proxy.Obse
 	client := proxy.NewTapClient(conn)
 	defer conn.Close()
 
-	req := &proxy.ObserveRequest{
-		Limit: uint32(maxRps * float32(tapInterval.Seconds())),
-		Match: match,
+	send := func(event *public.TapEvent) bool {
+		select {
+		case <-ctx.Done():
+			log.Debugf("[%s] client terminated the stream", addr)
+			return false
+		default:
+			events <- event
+			return true
+		}
+	}
+
+	// endWindow reports the requests observed in a window, returning false if
+	// the client is gone.
+	endWindow := func(observed, dropped uint64, saturated bool) bool {
+		podTap.allocator.report(name, observed, tapInterval, saturated)
+		if !podTap.reportSampling || (observed == 0 && dropped == 0) {
+			return true
+		}
+		return send(&public.TapEvent{
+			Target: podTap.target,
+			Event: &public.TapEvent_Sampling_{
+				Sampling: &public.TapEvent_Sampling{
+					Pod:       podTap.pod.Name,
+					Namespace: podTap.pod.Namespace,
+					Window:    ptypes.DurationProto(tapInterval),
+					Observed:  observed,
+					Dropped:   dropped,
+				},
+			},
+		})
 	}
 
 	for { // Request loop
 		windowStart := time.Now()
 		windowEnd := windowStart.Add(tapInterval)
+		req := &proxy.ObserveRequest{
+			Limit: podTap.allocator.limit(name, tapInterval),
+			Match: podTap.match,
+		}
 		rsp, err := client.Observe(ctx, req)
 		if err != nil {
 			log.Error(err)
 			return
 		}
+
+		var requests, observed uint64
 		for { // Stream loop
 			event, err := rsp.Recv()
 			if err == io.EOF {
@@ -264,22 +397,49 @@ func (s *server) tapProxy(ctx context.Context, maxRps float32, match *proxy.Obse
 				return
 			}
 
+			if event.GetHttp().GetRequestInit() != nil {
+				// streams that stay open across windows report each of them
+				if now := time.Now(); !now.Before(windowEnd) {
+					if !endWindow(observed, 0, false) {
+						return
+					}
+					windowStart, windowEnd = now, now.Add(tapInterval)
+					observed = 0
+				}
+				requests++
+				observed++
+			}
+
 			translatedEvent := s.translateEvent(event)
+			translatedEvent.Target = podTap.target
 
-			select {
-			case <-ctx.Done():
-				log.Debugf("[%s] client terminated the stream", addr)
-				return
-			default:
-				events <- translatedEvent
+			for _, released := range podTap.filter.Filter(translatedEvent) {
+				if !send(released) {
+					return
+				}
 			}
 		}
+
+		var dropped uint64
+		saturated := requests >= uint64(req.Limit)
+		if saturated {
+			dropped = estimateDropped(observed, windowStart, time.Now(), windowEnd)
+		}
+		if !endWindow(observed, dropped, saturated) {
+			return
+		}
+
 		if time.Now().Before(windowEnd) {
 			time.Sleep(time.Until(windowEnd))
 		}
 	}
 }
 
+// podKey identifies a pod among the pods of a tap.
+func podKey(pod *corev1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
 func (s *server) translateEvent(orig *proxy.TapEvent) *public.TapEvent {
 	direction := func(orig proxy.TapEvent_ProxyDirection) public.TapEvent_ProxyDirection {
 		switch orig {
@@ -436,12 +596,49 @@ func (s *server) translateEvent(orig *proxy.TapEvent) *public.TapEvent {
 	return ev
 }
 
-// NewServer creates a new gRPC Tap server
+// authenticate returns the identity of the caller, whose Kubernetes bearer
+// token is forwarded by the public API.
+func (s *server) authenticate(ctx context.Context) (*authnV1.UserInfo, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+	if token == "" {
+		return nil, status.Error(codes.Unauthenticated, "a Kubernetes bearer token is required to tap")
+	}
+
+	user, err := pkgK8s.AuthenticateToken(s.k8sAPI.Client, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return user, nil
+}
+
+// authorize returns an error unless user may tap the namespace of every
+// target.
+func (s *server) authorize(user authnV1.UserInfo, targets []*public.Resource) error {
+	for _, target := range targets {
+		err := pkgK8s.SubjectResourceAuthz(s.k8sAPI.Client, user, resourceNamespace(target), "watch", pkgK8s.TapAuthzGroup, "", "pods", "")
+		if err != nil {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return nil
+}
+
+// NewServer creates a new gRPC Tap server. When enableAuthz is set, callers
+// must forward a Kubernetes bearer token that is authorized to tap the
+// namespace of every target. Every tap session is recorded to auditSinks when
+// it starts streaming and once it ends.
 func NewServer(
 	addr string,
 	tapPort uint,
 	controllerNamespace string,
 	k8sAPI *k8s.API,
+	enableAuthz bool,
+	auditSinks []AuditSink,
 ) (*grpc.Server, net.Listener, error) {
 	k8sAPI.Pod().Informer().AddIndexers(cache.Indexers{podIPIndex: indexPodByIP})
 
@@ -455,6 +652,8 @@ func NewServer(
 		tapPort:             tapPort,
 		k8sAPI:              k8sAPI,
 		controllerNamespace: controllerNamespace,
+		enableAuthz:         enableAuthz,
+		auditSinks:          auditSinks,
 	}
 	pb.RegisterTapServer(s, &srv)
 
@@ -8,6 +8,12 @@ import (
 	"github.com/linkerd/linkerd2/controller/gen/public"
 	"github.com/linkerd/linkerd2/controller/k8s"
 	pkgK8s "github.com/linkerd/linkerd2/pkg/k8s"
+	"google.golang.org/grpc/metadata"
+	authnV1 "k8s.io/api/authentication/v1"
+	authV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 type tapExpected struct {
@@ -57,6 +63,50 @@ status:
 					},
 				},
 			},
+			{
+				msg: "rpc error: code = InvalidArgument desc = invalid status range 503-500",
+				k8sRes: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: controller-ns
+  annotations:
+    linkerd.io/proxy-version: testinjectversion
+status:
+  phase: Running
+`,
+				},
+				req: public.TapByResourceRequest{
+					Target: &public.ResourceSelection{
+						Resource: &public.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+							Name:      "emojivoto-meshed",
+						},
+					},
+					Match: &public.TapByResourceRequest_Match{
+						Match: &public.TapByResourceRequest_Match_All{
+							All: &public.TapByResourceRequest_Match_Seq{
+								Matches: []*public.TapByResourceRequest_Match{
+									{
+										Match: &public.TapByResourceRequest_Match_Response_{
+											Response: &public.TapByResourceRequest_Match_Response{
+												Match: &public.TapByResourceRequest_Match_Response_Status{
+													Status: &public.TapByResourceRequest_Match_Response_StatusRange{Min: 503, Max: 500},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			{
 				msg: "rpc error: code = NotFound desc = no pods found for pod/emojivoto-not-meshed",
 				k8sRes: []string{`
@@ -179,6 +229,57 @@ status:
 					},
 				},
 			},
+			{
+				msg:    "rpc error: code = InvalidArgument desc = invalid label selector: for 'in', 'notin' operators, values set can't be empty",
+				k8sRes: []string{},
+				req: public.TapByResourceRequest{
+					Target: &public.ResourceSelection{
+						Resource: &public.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+						},
+						Labels: &public.LabelSelector{
+							MatchExpressions: []*public.LabelSelectorRequirement{
+								{Key: "app", Operator: public.LabelSelectorRequirement_IN},
+							},
+						},
+					},
+				},
+			},
+			{
+				msg: "rpc error: code = NotFound desc = no pods found for pod/",
+				k8sRes: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: controller-ns
+  annotations:
+    linkerd.io/proxy-version: testinjectversion
+status:
+  phase: Running
+`,
+				},
+				req: public.TapByResourceRequest{
+					Target: &public.ResourceSelection{
+						Resource: &public.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+						},
+						Labels: &public.LabelSelector{
+							MatchLabels: map[string]string{"app": "web-svc"},
+						},
+					},
+					Match: &public.TapByResourceRequest_Match{
+						Match: &public.TapByResourceRequest_Match_All{
+							All: &public.TapByResourceRequest_Match_Seq{},
+						},
+					},
+				},
+			},
 			{
 				// indicates we will accept EOF, in addition to the deadline exceeded message
 				eofOk: true,
@@ -214,6 +315,52 @@ status:
 					},
 				},
 			},
+			{
+				msg: "rpc error: code = NotFound desc = no pods found for pod/emojivoto-not-meshed",
+				k8sRes: []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+    linkerd.io/control-plane-ns: controller-ns
+  annotations:
+    linkerd.io/proxy-version: testinjectversion
+status:
+  phase: Running
+`, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: emojivoto-not-meshed
+  namespace: emojivoto
+  labels:
+    app: emoji-svc
+status:
+  phase: Running
+`,
+				},
+				req: public.TapByResourceRequest{
+					Target: &public.ResourceSelection{
+						Resource: &public.Resource{
+							Namespace: "emojivoto",
+							Type:      pkgK8s.Pod,
+							Name:      "emojivoto-meshed",
+						},
+					},
+					Targets: []*public.ResourceSelection{
+						{
+							Resource: &public.Resource{
+								Namespace: "emojivoto",
+								Type:      pkgK8s.Pod,
+								Name:      "emojivoto-not-meshed",
+							},
+						},
+					},
+				},
+			},
 		}
 
 		for _, exp := range expectations {
@@ -222,7 +369,7 @@ status:
 				t.Fatalf("NewFakeAPI returned an error: %s", err)
 			}
 
-			server, listener, err := NewServer("localhost:0", 0, "controller-ns", k8sAPI)
+			server, listener, err := NewServer("localhost:0", 0, "controller-ns", k8sAPI, false, nil)
 			if err != nil {
 				t.Fatalf("NewServer error: %s", err)
 			}
@@ -254,4 +401,96 @@ status:
 			}
 		}
 	})
+
+	t.Run("Authorizes callers when authz is enabled", func(t *testing.T) {
+		k8sAPI, err := k8s.NewFakeAPI()
+		if err != nil {
+			t.Fatalf("NewFakeAPI returned an error: %s", err)
+		}
+		k8sClient := k8sAPI.Client.(*fake.Clientset)
+		k8sClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			tr := action.(k8stesting.CreateAction).GetObject().(*authnV1.TokenReview)
+			if tr.Spec.Token == "tenant-token" {
+				tr.Status.Authenticated = true
+				tr.Status.User = authnV1.UserInfo{Username: "tenant"}
+			}
+			return true, tr, nil
+		})
+		k8sClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			sar := action.(k8stesting.CreateAction).GetObject().(*authV1.SubjectAccessReview)
+			attrs := sar.Spec.ResourceAttributes
+			sar.Status.Allowed = sar.Spec.User == "tenant" && attrs.Namespace == "emojivoto" &&
+				attrs.Verb == "watch" && attrs.Group == pkgK8s.TapAuthzGroup && attrs.Resource == "pods"
+			return true, sar, nil
+		})
+
+		server, listener, err := NewServer("localhost:0", 0, "controller-ns", k8sAPI, true, nil)
+		if err != nil {
+			t.Fatalf("NewServer error: %s", err)
+		}
+
+		go func() { server.Serve(listener) }()
+		defer server.GracefulStop()
+
+		k8sAPI.Sync()
+
+		client, conn, err := NewClient(listener.Addr().String())
+		if err != nil {
+			t.Fatalf("NewClient error: %v", err)
+		}
+		defer conn.Close()
+
+		expectations := []struct {
+			token     string
+			namespace string
+			msg       string
+		}{
+			{
+				namespace: "emojivoto",
+				msg:       "rpc error: code = Unauthenticated desc = a Kubernetes bearer token is required to tap",
+			},
+			{
+				token:     "forged-token",
+				namespace: "emojivoto",
+				msg:       "rpc error: code = Unauthenticated desc = not authenticated",
+			},
+			{
+				token:     "tenant-token",
+				namespace: "books",
+				msg:       "rpc error: code = PermissionDenied desc = not authorized to access pods.tap.linkerd.io",
+			},
+			{
+				// authorized, so the request fails later, on looking up the target
+				token:     "tenant-token",
+				namespace: "emojivoto",
+				msg:       "rpc error: code = NotFound desc = namespace \"emojivoto\" not found",
+			},
+		}
+
+		for _, exp := range expectations {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			if exp.token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+exp.token)
+			}
+
+			tapByResourceClient, err := client.TapByResource(ctx, &public.TapByResourceRequest{
+				Target: &public.ResourceSelection{
+					Resource: &public.Resource{
+						Namespace: exp.namespace,
+						Type:      pkgK8s.Namespace,
+						Name:      exp.namespace,
+					},
+				},
+			})
+			if err != nil {
+				t.Fatalf("TapByResource failed: %v", err)
+			}
+
+			_, err = tapByResourceClient.Recv()
+			if err == nil || err.Error() != exp.msg {
+				t.Fatalf("Expected error to be [%s], but was [%v]", exp.msg, err)
+			}
+		}
+	})
 }
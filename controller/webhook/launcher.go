@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -22,6 +23,7 @@ func Launch(APIResources []k8s.APIResource, metricsPort uint32, handler handlerF
 	metricsAddr := flag.String("metrics-addr", fmt.Sprintf(":%d", metricsPort), "address to serve scrapable metrics on")
 	addr := flag.String("addr", ":8443", "address to serve on")
 	kubeconfig := flag.String("kubeconfig", "", "path to kubeconfig")
+	topLevelOwnerKinds := flag.String("top-level-owner-kinds", strings.Join(k8s.DefaultTopLevelOwnerKinds, ","), "comma separated list of kinds at which pod owner resolution stops, even if they are owned by another object")
 	flags.ConfigureAndParse()
 
 	stop := make(chan os.Signal, 1)
@@ -32,6 +34,7 @@ func Launch(APIResources []k8s.APIResource, metricsPort uint32, handler handlerF
 	if err != nil {
 		log.Fatalf("failed to initialize Kubernetes API: %s", err)
 	}
+	k8sAPI.SetTopLevelOwnerKinds(strings.Split(*topLevelOwnerKinds, ","))
 
 	cred, err := tls.ReadPEMCreds(pkgk8s.MountPathTLSKeyPEM, pkgk8s.MountPathTLSCrtPEM)
 	if err != nil {
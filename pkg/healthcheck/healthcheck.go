@@ -117,6 +117,10 @@ var (
 	requestTimeout = 30 * time.Second
 )
 
+// dataPlanePodsPageSize is the number of pods the data plane checks request
+// from the public API at a time.
+const dataPlanePodsPageSize = 500
+
 type checker struct {
 	// description is the short description that's printed to the command line
 	// when the check is executed
@@ -898,7 +902,7 @@ func (hc *HealthChecker) checkCustomResourceDefinitions() error {
 		objects = append(objects, &item)
 	}
 
-	return checkResources("CustomResourceDefinitions", objects, []string{"serviceprofiles.linkerd.io"})
+	return checkResources("CustomResourceDefinitions", objects, []string{"serviceprofiles.linkerd.io", "servicelevelobjectives.slo.linkerd.io"})
 }
 
 func checkResources(resourceName string, objects []runtime.Object, expectedNames []string) error {
@@ -933,7 +937,10 @@ func checkResources(resourceName string, objects []runtime.Object, expectedNames
 }
 
 func (hc *HealthChecker) getDataPlanePods(ctx context.Context) ([]*pb.Pod, error) {
-	req := &pb.ListPodsRequest{}
+	req := &pb.ListPodsRequest{
+		Limit:  dataPlanePodsPageSize,
+		Meshed: pb.ListPodsRequest_TRUE,
+	}
 	if hc.DataPlaneNamespace != "" {
 		req.Selector = &pb.ResourceSelection{
 			Resource: &pb.Resource{
@@ -942,19 +949,24 @@ func (hc *HealthChecker) getDataPlanePods(ctx context.Context) ([]*pb.Pod, error
 		}
 	}
 
-	resp, err := hc.apiClient.ListPods(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-
 	pods := make([]*pb.Pod, 0)
-	for _, pod := range resp.GetPods() {
-		if pod.ControllerNamespace == hc.ControlPlaneNamespace {
-			pods = append(pods, pod)
+	for {
+		resp, err := hc.apiClient.ListPods(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pod := range resp.GetPods() {
+			if pod.ControllerNamespace == hc.ControlPlaneNamespace {
+				pods = append(pods, pod)
+			}
 		}
-	}
 
-	return pods, nil
+		if resp.GetNextPageToken() == "" {
+			return pods, nil
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
 }
 
 func (hc *HealthChecker) checkCanCreate(namespace, group, version, resource string) error {
@@ -647,7 +647,7 @@ metadata:
 				"linkerd-config control plane ClusterRoles exist",
 				"linkerd-config control plane ClusterRoleBindings exist",
 				"linkerd-config control plane ServiceAccounts exist",
-				"linkerd-config control plane CustomResourceDefinitions exist: missing CustomResourceDefinitions: serviceprofiles.linkerd.io",
+				"linkerd-config control plane CustomResourceDefinitions exist: missing CustomResourceDefinitions: servicelevelobjectives.slo.linkerd.io, serviceprofiles.linkerd.io",
 			},
 		},
 		{
@@ -790,6 +790,12 @@ apiVersion: apiextensions.k8s.io/v1beta1
 kind: CustomResourceDefinition
 metadata:
   name: serviceprofiles.linkerd.io
+`,
+				`
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: servicelevelobjectives.slo.linkerd.io
 `,
 			},
 			[]string{
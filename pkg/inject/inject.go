@@ -387,6 +387,11 @@ func (conf *ResourceConfig) parse(bytes []byte) error {
 				conf.pod.labels[k8s.ProxyDaemonSetLabel] = name
 			case k8s.StatefulSet:
 				conf.pod.labels[k8s.ProxyStatefulSetLabel] = name
+			case k8s.Pod:
+			default:
+				// other owners, such as CronJobs or custom workloads, are labeled
+				// with their own kind
+				conf.pod.labels[k8s.ProxyOwnerLabel(kind)] = name
 			}
 		}
 
@@ -4,11 +4,17 @@ import (
 	"errors"
 	"fmt"
 
+	authnV1 "k8s.io/api/authentication/v1"
 	authV1 "k8s.io/api/authorization/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes"
 )
 
+// TapAuthzGroup is the API group in which callers must be granted "watch" on
+// "pods" to tap a namespace. No such API is served; RBAC rules grant access to
+// it all the same.
+const TapAuthzGroup = "tap.linkerd.io"
+
 // ResourceAuthz checks whether a given Kubernetes client is authorized to
 // perform a given action.
 func ResourceAuthz(
@@ -36,7 +42,80 @@ func ResourceAuthz(
 		return err
 	}
 
-	if result.Status.Allowed {
+	return authzResult(result.Status, group, resource)
+}
+
+// SubjectResourceAuthz checks whether the user described by userInfo is
+// authorized to perform a given action. Unlike ResourceAuthz, it checks on
+// behalf of someone other than the client's own identity, so servers can use
+// it to authorize their callers.
+func SubjectResourceAuthz(
+	k8sClient kubernetes.Interface,
+	userInfo authnV1.UserInfo,
+	namespace, verb, group, version, resource, name string,
+) error {
+	extra := make(map[string]authV1.ExtraValue, len(userInfo.Extra))
+	for k, v := range userInfo.Extra {
+		extra[k] = authV1.ExtraValue(v)
+	}
+
+	sar := &authV1.SubjectAccessReview{
+		Spec: authV1.SubjectAccessReviewSpec{
+			ResourceAttributes: &authV1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     group,
+				Version:   version,
+				Resource:  resource,
+				Name:      name,
+			},
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			Extra:  extra,
+			UID:    userInfo.UID,
+		},
+	}
+
+	result, err := k8sClient.
+		AuthorizationV1().
+		SubjectAccessReviews().
+		Create(sar)
+	if err != nil {
+		return err
+	}
+
+	return authzResult(result.Status, group, resource)
+}
+
+// AuthenticateToken validates a bearer token through a TokenReview and
+// returns the identity it belongs to.
+func AuthenticateToken(k8sClient kubernetes.Interface, token string) (*authnV1.UserInfo, error) {
+	tr := &authnV1.TokenReview{
+		Spec: authnV1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+
+	result, err := k8sClient.
+		AuthenticationV1().
+		TokenReviews().
+		Create(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Status.Authenticated {
+		if len(result.Status.Error) > 0 {
+			return nil, fmt.Errorf("not authenticated: %s", result.Status.Error)
+		}
+		return nil, errors.New("not authenticated")
+	}
+
+	return &result.Status.User, nil
+}
+
+func authzResult(status authV1.SubjectAccessReviewStatus, group, resource string) error {
+	if status.Allowed {
 		return nil
 	}
 
@@ -44,8 +123,8 @@ func ResourceAuthz(
 		Group: group,
 		Kind:  resource,
 	}
-	if len(result.Status.Reason) > 0 {
-		return fmt.Errorf("not authorized to access %s: %s", gk, result.Status.Reason)
+	if len(status.Reason) > 0 {
+		return fmt.Errorf("not authorized to access %s: %s", gk, status.Reason)
 	}
 	return fmt.Errorf("not authorized to access %s", gk)
 }
@@ -69,6 +148,45 @@ func ServiceProfilesAccess(k8sClient kubernetes.Interface) error {
 	return errors.New("ServiceProfile CRD not found")
 }
 
+// ServiceLevelObjectivesAccess checks whether the ServiceLevelObjective CRD
+// is installed on the cluster and the client is authorized to access
+// ServiceLevelObjectives.
+func ServiceLevelObjectivesAccess(k8sClient kubernetes.Interface) error {
+	res, err := k8sClient.Discovery().ServerResourcesForGroupVersion(ServiceLevelObjectiveAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	if res.GroupVersion == ServiceLevelObjectiveAPIVersion {
+		for _, apiRes := range res.APIResources {
+			if apiRes.Kind == ServiceLevelObjectiveKind {
+				return ResourceAuthz(k8sClient, "", "list", "slo.linkerd.io", "", "servicelevelobjectives", "")
+			}
+		}
+	}
+
+	return errors.New("ServiceLevelObjective CRD not found")
+}
+
+// TrafficSplitsAccess checks whether the SMI TrafficSplit CRD is installed on
+// the cluster and the client is authorized to access TrafficSplits.
+func TrafficSplitsAccess(k8sClient kubernetes.Interface) error {
+	res, err := k8sClient.Discovery().ServerResourcesForGroupVersion(TrafficSplitAPIVersion)
+	if err != nil {
+		return err
+	}
+
+	if res.GroupVersion == TrafficSplitAPIVersion {
+		for _, apiRes := range res.APIResources {
+			if apiRes.Kind == TrafficSplitKind {
+				return ResourceAuthz(k8sClient, "", "list", "split.smi-spec.io", "", "trafficsplits", "")
+			}
+		}
+	}
+
+	return errors.New("TrafficSplit CRD not found")
+}
+
 // ClusterAccess verifies whether k8sClient is authorized to access all pods in
 // all namespaces in the cluster.
 func ClusterAccess(k8sClient kubernetes.Interface) error {
@@ -5,6 +5,12 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+
+	authnV1 "k8s.io/api/authentication/v1"
+	authV1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 func TestResourceAuthz(t *testing.T) {
@@ -60,6 +66,69 @@ subjects:
 	}
 }
 
+func TestSubjectResourceAuthz(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	k8sClient.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authV1.SubjectAccessReview)
+		attrs := sar.Spec.ResourceAttributes
+		sar.Status.Allowed = sar.Spec.User == "alice" &&
+			attrs.Namespace == "emojivoto" && attrs.Verb == "list" && attrs.Resource == "pods"
+		if !sar.Status.Allowed {
+			sar.Status.Reason = "no RBAC policy matched"
+		}
+		return true, sar, nil
+	})
+
+	alice := authnV1.UserInfo{Username: "alice", Groups: []string{"tenants"}}
+
+	tests := []struct {
+		user      authnV1.UserInfo
+		namespace string
+		err       error
+	}{
+		{alice, "emojivoto", nil},
+		{alice, "books", errors.New("not authorized to access pods: no RBAC policy matched")},
+		{authnV1.UserInfo{Username: "bob"}, "emojivoto", errors.New("not authorized to access pods: no RBAC policy matched")},
+	}
+
+	for i, test := range tests {
+		test := test // pin
+		t.Run(fmt.Sprintf("%d: returns expected authorization", i), func(t *testing.T) {
+			err := SubjectResourceAuthz(k8sClient, test.user, test.namespace, "list", "", "", "pods", "")
+			if !reflect.DeepEqual(err, test.err) {
+				t.Fatalf("Unexpected error (Expected: %s, Got: %s)", test.err, err)
+			}
+		})
+	}
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	k8sClient.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		tr := action.(k8stesting.CreateAction).GetObject().(*authnV1.TokenReview)
+		if tr.Spec.Token == "alice-token" {
+			tr.Status.Authenticated = true
+			tr.Status.User = authnV1.UserInfo{Username: "alice"}
+		} else {
+			tr.Status.Error = "invalid bearer token"
+		}
+		return true, tr, nil
+	})
+
+	user, err := AuthenticateToken(k8sClient, "alice-token")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if user.Username != "alice" {
+		t.Fatalf("Expected user alice, got %s", user.Username)
+	}
+
+	_, err = AuthenticateToken(k8sClient, "forged-token")
+	if !reflect.DeepEqual(err, errors.New("not authenticated: invalid bearer token")) {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
 func TestServiceProfilesAccess(t *testing.T) {
 	fakeResources := []string{`
 kind: APIResourceList
@@ -7,6 +7,7 @@ import (
 
 	tsclient "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	tsfake "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned/fake"
+	tsscheme "github.com/deislabs/smi-sdk-go/pkg/gen/client/split/clientset/versioned/scheme"
 	spclient "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned"
 	spfake "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/fake"
 	spscheme "github.com/linkerd/linkerd2/controller/gen/client/clientset/versioned/scheme"
@@ -70,7 +71,7 @@ func NewFakeClientSets(configs ...string) (kubernetes.Interface, apiextensionscl
 			apiextObjs = append(apiextObjs, obj)
 		case "apiresourcelist":
 			discoveryObjs = append(discoveryObjs, obj)
-		case ServiceProfile:
+		case ServiceProfile, "servicelevelobjective":
 			spObjs = append(spObjs, obj)
 		case TrafficSplit:
 			tsObjs = append(tsObjs, obj)
@@ -145,6 +146,7 @@ func newFakeClientSetsFromManifests(readers []io.Reader) (kubernetes.Interface,
 func ToRuntimeObject(config string) (runtime.Object, error) {
 	apiextensionsv1beta1.AddToScheme(scheme.Scheme)
 	spscheme.AddToScheme(scheme.Scheme)
+	tsscheme.AddToScheme(scheme.Scheme)
 	decode := scheme.Codecs.UniversalDeserializer().Decode
 	obj, _, err := decode([]byte(config), nil, nil)
 	return obj, err
@@ -26,6 +26,12 @@ const (
 	ServiceProfileAPIVersion = "linkerd.io/v1alpha1"
 	ServiceProfileKind       = "ServiceProfile"
 
+	ServiceLevelObjectiveAPIVersion = "slo.linkerd.io/v1alpha1"
+	ServiceLevelObjectiveKind       = "ServiceLevelObjective"
+
+	TrafficSplitAPIVersion = "split.smi-spec.io/v1alpha1"
+	TrafficSplitKind       = "TrafficSplit"
+
 	// special case k8s job label, to not conflict with Prometheus' job label
 	l5dJob = "k8s_job"
 )
@@ -43,6 +49,7 @@ var AllResources = []string{
 	Service,
 	ServiceProfile,
 	StatefulSet,
+	TrafficSplit,
 }
 
 // StatAllResourceTypes represents the resources to query in StatSummary when Resource.Type is "all"
@@ -58,6 +65,11 @@ var StatAllResourceTypes = []string{
 	Authority,
 }
 
+// DefaultTopLevelOwnerKinds are the kinds at which owner resolution stops,
+// even if they are themselves owned by another object (e.g. a Deployment
+// managed by an operator).
+var DefaultTopLevelOwnerKinds = []string{Deployment, DaemonSet, StatefulSet}
+
 // GetConfig returns kubernetes config based on the current environment.
 // If fpath is provided, loads configuration from that file. Otherwise,
 // GetConfig uses default strategy to load configuration from $KUBECONFIG,
@@ -100,6 +112,8 @@ func CanonicalResourceNameFromFriendlyName(friendlyName string) (string, error)
 		return ServiceProfile, nil
 	case "sts", "statefulset", "statefulsets":
 		return StatefulSet, nil
+	case "ts", "trafficsplit", "trafficsplits":
+		return TrafficSplit, nil
 	case "all":
 		return All, nil
 	}
@@ -133,6 +147,8 @@ func ShortNameFromCanonicalResourceName(canonicalName string) string {
 		return "sp"
 	case StatefulSet:
 		return "sts"
+	case TrafficSplit:
+		return "ts"
 	default:
 		return ""
 	}
@@ -28,12 +28,14 @@ func TestGetConfig(t *testing.T) {
 func TestCanonicalResourceNameFromFriendlyName(t *testing.T) {
 	t.Run("Returns canonical name for all known variants", func(t *testing.T) {
 		expectations := map[string]string{
-			"po":          Pod,
-			"pod":         Pod,
-			"deployment":  Deployment,
-			"deployments": Deployment,
-			"au":          Authority,
-			"authorities": Authority,
+			"po":            Pod,
+			"pod":           Pod,
+			"deployment":    Deployment,
+			"deployments":   Deployment,
+			"au":            Authority,
+			"authorities":   Authority,
+			"ts":            TrafficSplit,
+			"trafficsplits": TrafficSplit,
 		}
 
 		for input, expectedName := range expectations {
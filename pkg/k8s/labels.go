@@ -270,6 +270,13 @@ func CreatedByAnnotationValue() string {
 	return fmt.Sprintf("linkerd/cli %s", version.Version)
 }
 
+// ProxyOwnerLabel returns the label injected into mesh-enabled apps to
+// identify the owner of the given kind that this proxy belongs to, e.g.
+// linkerd.io/proxy-cronjob.
+func ProxyOwnerLabel(kind string) string {
+	return fmt.Sprintf("%s/proxy-%s", Prefix, kind)
+}
+
 // GetServiceAccountAndNS returns the pod's serviceaccount and namespace.
 func GetServiceAccountAndNS(pod *corev1.Pod) (sa string, ns string) {
 	sa = pod.Spec.ServiceAccountName
@@ -43,3 +43,16 @@ func TestGetPodLabels(t *testing.T) {
 		}
 	})
 }
+
+func TestProxyOwnerLabel(t *testing.T) {
+	for kind, expected := range map[string]string{
+		Deployment:  ProxyDeploymentLabel,
+		Job:         ProxyJobLabel,
+		StatefulSet: ProxyStatefulSetLabel,
+		"cronjob":   "linkerd.io/proxy-cronjob",
+	} {
+		if label := ProxyOwnerLabel(kind); label != expected {
+			t.Fatalf("Expected label [%s] for kind [%s], got [%s]", expected, kind, label)
+		}
+	}
+}
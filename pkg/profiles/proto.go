@@ -5,12 +5,32 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strings"
 
 	"github.com/emicklei/proto"
 	sp "github.com/linkerd/linkerd2/controller/gen/apis/serviceprofile/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const httpRuleOption = "(google.api.http)"
+
+// httpRulePatterns maps the pattern fields of a google.api.HttpRule to the
+// HTTP method they bind. The `custom` pattern carries its own method.
+var httpRulePatterns = map[string]string{
+	"get":    http.MethodGet,
+	"put":    http.MethodPut,
+	"post":   http.MethodPost,
+	"delete": http.MethodDelete,
+	"patch":  http.MethodPatch,
+}
+
+// httpBinding is a single REST mapping of an RPC, declared through a
+// google.api.http option.
+type httpBinding struct {
+	method string
+	path   string
+}
+
 // RenderProto reads a protobuf definition file and renders the corresponding
 // ServiceProfile to a buffer, given a namespace, service, and control plane
 // namespace.
@@ -38,6 +58,7 @@ func protoToServiceProfile(parser *proto.Parser, namespace, name string) (*sp.Se
 
 	routes := make([]*sp.RouteSpec, 0)
 	pkg := ""
+	var walkErr error
 
 	handle := func(visitee proto.Visitee) {
 		switch typed := visitee.(type) {
@@ -53,11 +74,38 @@ func protoToServiceProfile(parser *proto.Parser, namespace, name string) (*sp.Se
 					},
 				}
 				routes = append(routes, route)
+
+				// HTTP transcoding cannot carry a stream of request messages, so
+				// REST mappings are only generated for unary and server streaming
+				// RPCs.
+				if typed.StreamsRequest {
+					return
+				}
+
+				for _, binding := range httpBindings(typed) {
+					pathRegex, err := pathTemplateToRegex(binding.path)
+					if err != nil {
+						if walkErr == nil {
+							walkErr = fmt.Errorf("invalid google.api.http path for %s.%s/%s: %s", pkg, service.Name, typed.Name, err)
+						}
+						continue
+					}
+					routes = append(routes, &sp.RouteSpec{
+						Name: fmt.Sprintf("%s %s", binding.method, binding.path),
+						Condition: &sp.RequestMatch{
+							Method:    binding.method,
+							PathRegex: pathRegex,
+						},
+					})
+				}
 			}
 		}
 	}
 
 	proto.Walk(definition, handle)
+	if walkErr != nil {
+		return nil, walkErr
+	}
 
 	return &sp.ServiceProfile{
 		ObjectMeta: metav1.ObjectMeta{
@@ -70,3 +118,127 @@ func protoToServiceProfile(parser *proto.Parser, namespace, name string) (*sp.Se
 		},
 	}, nil
 }
+
+// httpBindings returns the REST mappings declared on an RPC through the
+// google.api.http option, including any additional_bindings. Both the
+// aggregate form, `option (google.api.http) = { get: "/v1/foo" };`, and the
+// field form, `option (google.api.http).get = "/v1/foo";`, are supported.
+func httpBindings(rpc *proto.RPC) []httpBinding {
+	bindings := make([]httpBinding, 0)
+
+	for _, element := range rpc.Elements {
+		option, ok := element.(*proto.Option)
+		if !ok {
+			continue
+		}
+
+		if option.Name == httpRuleOption {
+			bindings = append(bindings, httpRuleBindings(option.Constant.OrderedMap)...)
+			continue
+		}
+
+		if strings.HasPrefix(option.Name, httpRuleOption+".") {
+			field := strings.TrimPrefix(option.Name, httpRuleOption+".")
+			if method, ok := httpRulePatterns[field]; ok {
+				bindings = append(bindings, httpBinding{method: method, path: option.Constant.Source})
+			}
+		}
+	}
+
+	return bindings
+}
+
+// httpRuleBindings converts an aggregate google.api.HttpRule literal into its
+// bindings. The rule's own pattern comes first, followed by its
+// additional_bindings in declaration order.
+func httpRuleBindings(rule proto.LiteralMap) []httpBinding {
+	bindings := make([]httpBinding, 0)
+	additional := make([]httpBinding, 0)
+
+	for _, field := range rule {
+		if method, ok := httpRulePatterns[field.Name]; ok {
+			bindings = append(bindings, httpBinding{method: method, path: field.Source})
+			continue
+		}
+
+		switch field.Name {
+		case "custom":
+			kind, _ := field.OrderedMap.Get("kind")
+			path, _ := field.OrderedMap.Get("path")
+			if kind.Source != "" && path.Source != "" {
+				bindings = append(bindings, httpBinding{method: strings.ToUpper(kind.Source), path: path.Source})
+			}
+		case "additional_bindings":
+			additional = append(additional, httpRuleBindings(field.OrderedMap)...)
+		}
+	}
+
+	return append(bindings, additional...)
+}
+
+// pathTemplateToRegex converts a google.api.http path template into a path
+// regex. Variables and single wildcards match one path segment, double
+// wildcards match any number of segments, and literals are matched exactly,
+// so "/v1/{name=shelves/*}/books/**:search" becomes
+// "/v1/shelves/[^/]*/books/.*:search".
+func pathTemplateToRegex(template string) (string, error) {
+	if !strings.HasPrefix(template, "/") {
+		return "", fmt.Errorf("path template \"%s\" must start with \"/\"", template)
+	}
+
+	path, verb := template, ""
+	if i := strings.LastIndex(template, ":"); i > strings.LastIndex(template, "}") && i > strings.LastIndex(template, "/") {
+		path, verb = template[:i], template[i:]
+	}
+
+	var regex strings.Builder
+	for len(path) > 0 {
+		if path[0] != '{' {
+			end := strings.IndexByte(path, '{')
+			if end < 0 {
+				end = len(path)
+			}
+			if strings.ContainsAny(path[:end], "}") {
+				return "", fmt.Errorf("path template \"%s\" has an unmatched \"}\"", template)
+			}
+			regex.WriteString(segmentsToRegex(path[:end]))
+			path = path[end:]
+			continue
+		}
+
+		end := strings.IndexByte(path, '}')
+		if end < 0 {
+			return "", fmt.Errorf("path template \"%s\" has an unmatched \"{\"", template)
+		}
+		variable := path[1:end]
+		if strings.ContainsAny(variable, "{") {
+			return "", fmt.Errorf("path template \"%s\" has a nested variable", template)
+		}
+		if i := strings.IndexByte(variable, '='); i >= 0 {
+			regex.WriteString(segmentsToRegex(variable[i+1:]))
+		} else {
+			regex.WriteString("[^/]*")
+		}
+		path = path[end+1:]
+	}
+
+	regex.WriteString(regexp.QuoteMeta(verb))
+	return regex.String(), nil
+}
+
+// segmentsToRegex converts a run of literal and wildcard path segments into a
+// regex, preserving the separators between them.
+func segmentsToRegex(segments string) string {
+	parts := strings.Split(segments, "/")
+	for i, part := range parts {
+		switch part {
+		case "*":
+			parts[i] = "[^/]*"
+		case "**":
+			parts[i] = ".*"
+		default:
+			parts[i] = regexp.QuoteMeta(part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
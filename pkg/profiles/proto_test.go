@@ -60,3 +60,120 @@ service VotingService {
 		t.Fatalf("ServiceProfiles are not equal: %v", err)
 	}
 }
+
+func TestProtoToServiceProfileWithHTTPAnnotations(t *testing.T) {
+	namespace := "myns"
+	name := "mysvc"
+
+	protobuf := `syntax = "proto3";
+
+package library.v1;
+
+import "google/api/annotations.proto";
+
+service LibraryService {
+	rpc GetBook (GetBookRequest) returns (Book) {
+		option (google.api.http) = {
+			get: "/v1/{name=shelves/*/books/*}"
+			additional_bindings {
+				get: "/v1/books/{id}"
+			}
+			additional_bindings {
+				custom: {
+					kind: "HEAD"
+					path: "/v1/books/{id}"
+				}
+			}
+		};
+	}
+	rpc DeleteBook (DeleteBookRequest) returns (Empty) {
+		option (google.api.http).delete = "/v1/{name=shelves/**}:purge";
+	}
+	rpc WatchBooks (WatchBooksRequest) returns (stream Book) {
+		option (google.api.http) = { get: "/v1/books:watch" };
+	}
+	rpc UploadBooks (stream Book) returns (Empty) {
+		option (google.api.http) = { post: "/v1/books:upload" body: "*" };
+	}
+}`
+
+	parser := proto.NewParser(strings.NewReader(protobuf))
+
+	route := func(name, method, pathRegex string) *sp.RouteSpec {
+		return &sp.RouteSpec{
+			Name: name,
+			Condition: &sp.RequestMatch{
+				PathRegex: pathRegex,
+				Method:    method,
+			},
+		}
+	}
+
+	expectedServiceProfile := sp.ServiceProfile{
+		TypeMeta: serviceProfileMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "." + namespace + ".svc.cluster.local",
+			Namespace: namespace,
+		},
+		Spec: sp.ServiceProfileSpec{
+			Routes: []*sp.RouteSpec{
+				route("GetBook", "POST", `/library\.v1\.LibraryService/GetBook`),
+				route("GET /v1/{name=shelves/*/books/*}", "GET", `/v1/shelves/[^/]*/books/[^/]*`),
+				route("GET /v1/books/{id}", "GET", `/v1/books/[^/]*`),
+				route("HEAD /v1/books/{id}", "HEAD", `/v1/books/[^/]*`),
+				route("DeleteBook", "POST", `/library\.v1\.LibraryService/DeleteBook`),
+				route("DELETE /v1/{name=shelves/**}:purge", "DELETE", `/v1/shelves/.*:purge`),
+				route("WatchBooks", "POST", `/library\.v1\.LibraryService/WatchBooks`),
+				route("GET /v1/books:watch", "GET", `/v1/books:watch`),
+				route("UploadBooks", "POST", `/library\.v1\.LibraryService/UploadBooks`),
+			},
+		},
+	}
+
+	actualServiceProfile, err := protoToServiceProfile(parser, namespace, name)
+	if err != nil {
+		t.Fatalf("Failed to create ServiceProfile: %v", err)
+	}
+
+	err = ServiceProfileYamlEquals(*actualServiceProfile, expectedServiceProfile)
+	if err != nil {
+		t.Fatalf("ServiceProfiles are not equal: %v", err)
+	}
+}
+
+func TestPathTemplateToRegex(t *testing.T) {
+	testCases := []struct {
+		template string
+		regex    string
+		err      string
+	}{
+		{"/v1/shelves", `/v1/shelves`, ""},
+		{"/v1/{shelf}/books", `/v1/[^/]*/books`, ""},
+		{"/v1/{name=shelves/*}", `/v1/shelves/[^/]*`, ""},
+		{"/v1/{name=**}", `/v1/.*`, ""},
+		{"/v1/books/{id}:undelete", `/v1/books/[^/]*:undelete`, ""},
+		{"/v1.2/a+b", `/v1\.2/a\+b`, ""},
+		{"v1/books", "", `path template "v1/books" must start with "/"`},
+		{"/v1/{name", "", `path template "/v1/{name" has an unmatched "{"`},
+		{"/v1/name}", "", `path template "/v1/name}" has an unmatched "}"`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc // pin
+		t.Run(tc.template, func(t *testing.T) {
+			regex, err := pathTemplateToRegex(tc.template)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("Expected error \"%s\", got: %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if regex != tc.regex {
+				t.Fatalf("Expected regex \"%s\", got \"%s\"", tc.regex, regex)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package tap
+
+import (
+	"errors"
+	"strings"
+
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
+	"github.com/linkerd/linkerd2/pkg/k8s"
+)
+
+// streamKey identifies an HTTP stream within a tap event stream.
+type streamKey struct {
+	src    string
+	dst    string
+	base   uint32
+	stream uint64
+}
+
+// eventFilter selects the recorded events that the tap server would have
+// reported for a TapByResource request. Response events are selected if the
+// request event of the same stream was, and Sampling events if the request
+// asked for them.
+type eventFilter struct {
+	targets        []*pb.Resource
+	match          *pb.TapByResourceRequest_Match
+	reportSampling bool
+	streams        map[streamKey]bool
+}
+
+func newEventFilter(req *pb.TapByResourceRequest) (*eventFilter, error) {
+	targets := []*pb.Resource{}
+	for _, target := range Targets(req) {
+		if target.GetLabelSelector() != "" || target.GetLabels() != nil {
+			// recorded events only carry the labels identifying a pod's owner
+			return nil, errors.New("label selectors cannot be applied to recorded tap events")
+		}
+		targets = append(targets, target.GetResource())
+	}
+
+	return &eventFilter{
+		targets:        targets,
+		match:          req.GetMatch(),
+		reportSampling: req.GetReportSampling(),
+		streams:        make(map[streamKey]bool),
+	}, nil
+}
+
+func (f *eventFilter) matches(event *pb.TapEvent) bool {
+	if event.GetSampling() != nil {
+		return f.reportSampling
+	}
+
+	key := streamKey{
+		src: addr.PublicAddressToString(event.GetSource()),
+		dst: addr.PublicAddressToString(event.GetDestination()),
+	}
+
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *pb.TapEvent_Http_RequestInit_:
+		key.base, key.stream = ev.RequestInit.GetId().GetBase(), ev.RequestInit.GetId().GetStream()
+		matched := f.targeted(event) && matches(f.match, event, ev.RequestInit)
+		f.streams[key] = matched
+		return matched
+
+	case *pb.TapEvent_Http_ResponseInit_:
+		key.base, key.stream = ev.ResponseInit.GetId().GetBase(), ev.ResponseInit.GetId().GetStream()
+		return f.streams[key]
+
+	case *pb.TapEvent_Http_ResponseEnd_:
+		key.base, key.stream = ev.ResponseEnd.GetId().GetBase(), ev.ResponseEnd.GetId().GetStream()
+		matched := f.streams[key]
+		delete(f.streams, key)
+		return matched
+
+	default:
+		return false
+	}
+}
+
+// targeted returns whether event was reported by a proxy of one of the
+// target resources, which is the destination of inbound events and the source
+// of outbound ones.
+func (f *eventFilter) targeted(event *pb.TapEvent) bool {
+	if len(f.targets) == 0 {
+		return true
+	}
+
+	for _, target := range f.targets {
+		src := resourceMatches(target, event.GetSourceMeta().GetLabels())
+		dst := resourceMatches(target, event.GetDestinationMeta().GetLabels())
+		matched := src || dst
+		switch event.GetProxyDirection() {
+		case pb.TapEvent_INBOUND:
+			matched = dst
+		case pb.TapEvent_OUTBOUND:
+			matched = src
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(match *pb.TapByResourceRequest_Match, event *pb.TapEvent, req *pb.TapEvent_Http_RequestInit) bool {
+	switch typed := match.GetMatch().(type) {
+	case *pb.TapByResourceRequest_Match_All:
+		for _, m := range typed.All.GetMatches() {
+			if !matches(m, event, req) {
+				return false
+			}
+		}
+		return true
+
+	case *pb.TapByResourceRequest_Match_Any:
+		for _, m := range typed.Any.GetMatches() {
+			if matches(m, event, req) {
+				return true
+			}
+		}
+		return false
+
+	case *pb.TapByResourceRequest_Match_Not:
+		return !matches(typed.Not, event, req)
+
+	case *pb.TapByResourceRequest_Match_Destinations:
+		return resourceMatches(typed.Destinations.GetResource(), event.GetDestinationMeta().GetLabels())
+
+	case *pb.TapByResourceRequest_Match_Response_:
+		// responses are matched by a ResponseFilter once they have ended
+		return true
+
+	case *pb.TapByResourceRequest_Match_Http_:
+		switch httpTyped := typed.Http.GetMatch().(type) {
+		case *pb.TapByResourceRequest_Match_Http_Scheme:
+			return strings.EqualFold(SchemeString(req.GetScheme()), httpTyped.Scheme)
+		case *pb.TapByResourceRequest_Match_Http_Method:
+			return strings.EqualFold(MethodString(req.GetMethod()), httpTyped.Method)
+		case *pb.TapByResourceRequest_Match_Http_Authority:
+			return req.GetAuthority() == httpTyped.Authority
+		case *pb.TapByResourceRequest_Match_Http_Path:
+			return strings.HasPrefix(req.GetPath(), httpTyped.Path)
+		default:
+			return false
+		}
+
+	default:
+		// no match selects every event
+		return true
+	}
+}
+
+// resourceMatches returns whether the endpoint labels of a tap event identify
+// a pod of resource.
+func resourceMatches(resource *pb.Resource, labels map[string]string) bool {
+	name, ok := labels[k8s.KindToL5DLabel(resource.GetType())]
+	if !ok || (resource.GetName() != "" && name != resource.GetName()) {
+		return false
+	}
+	if resource.GetType() != k8s.Namespace && resource.GetNamespace() != "" && labels[k8s.Namespace] != resource.GetNamespace() {
+		return false
+	}
+	return true
+}
+
+// SchemeString returns the name of a tap event's scheme, or an empty string
+// if it has none.
+func SchemeString(scheme *pb.Scheme) string {
+	if scheme.GetType() == nil {
+		return ""
+	}
+	if s, ok := scheme.GetType().(*pb.Scheme_Unregistered); ok {
+		return s.Unregistered
+	}
+	return scheme.GetRegistered().String()
+}
+
+// MethodString returns the name of a tap event's HTTP method, or an empty
+// string if it has none.
+func MethodString(method *pb.HttpMethod) string {
+	if method.GetType() == nil {
+		return ""
+	}
+	if m, ok := method.GetType().(*pb.HttpMethod_Unregistered); ok {
+		return m.Unregistered
+	}
+	return method.GetRegistered().String()
+}
+
+// Targets returns the selections of the pods tapped by req: its target,
+// followed by any further targets.
+func Targets(req *pb.TapByResourceRequest) []*pb.ResourceSelection {
+	targets := []*pb.ResourceSelection{}
+	if req.GetTarget() != nil {
+		targets = append(targets, req.GetTarget())
+	}
+	for _, target := range req.GetTargets() {
+		if target != nil {
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
@@ -0,0 +1,263 @@
+package tap
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"google.golang.org/grpc"
+)
+
+// numBytesForRecordLength is the size of the little-endian length prefix that
+// precedes every TapRecord in a recording.
+const numBytesForRecordLength = 4
+
+// maxRecordSize bounds the size of a single TapRecord, so that a corrupt
+// length prefix cannot make a Replayer allocate gigabytes. It matches the
+// default maximum size of the gRPC messages tap events arrive in.
+const maxRecordSize = 4 * 1024 * 1024
+
+// Recorder writes tap events, along with the time at which they were
+// received, to a recording that can later be read by a Replayer.
+type Recorder struct {
+	sync.Mutex
+	w   io.Writer
+	now func() time.Time
+}
+
+// NewRecorder returns a Recorder that writes to w.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w, now: time.Now}
+}
+
+// Record appends event to the recording, timestamped with the current time.
+func (r *Recorder) Record(event *pb.TapEvent) error {
+	timestamp, err := ptypes.TimestampProto(r.now())
+	if err != nil {
+		return err
+	}
+	record := &pb.TapRecord{
+		Timestamp: timestamp,
+		Event:     event,
+	}
+	bytes, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if len(bytes) > maxRecordSize {
+		return fmt.Errorf("record of %d bytes exceeds the maximum of %d bytes", len(bytes), maxRecordSize)
+	}
+
+	length := make([]byte, numBytesForRecordLength)
+	binary.LittleEndian.PutUint32(length, uint32(len(bytes)))
+
+	r.Lock()
+	defer r.Unlock()
+	if _, err := r.w.Write(length); err != nil {
+		return err
+	}
+	_, err = r.w.Write(bytes)
+	return err
+}
+
+// Replayer reads the tap records of a recording made by a Recorder.
+type Replayer struct {
+	r *bufio.Reader
+}
+
+// NewReplayer returns a Replayer that reads from r.
+func NewReplayer(r io.Reader) *Replayer {
+	return &Replayer{r: bufio.NewReader(r)}
+}
+
+// Next returns the next record of the recording, or io.EOF once all records
+// have been read.
+func (r *Replayer) Next() (*pb.TapRecord, error) {
+	length := make([]byte, numBytesForRecordLength)
+	if _, err := io.ReadFull(r.r, length); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("error while reading record length: %s", err)
+	}
+
+	size := binary.LittleEndian.Uint32(length)
+	if size > maxRecordSize {
+		return nil, fmt.Errorf("error while decoding record: length of %d bytes exceeds the maximum of %d bytes", size, maxRecordSize)
+	}
+
+	bytes := make([]byte, size)
+	if _, err := io.ReadFull(r.r, bytes); err != nil {
+		return nil, fmt.Errorf("error while reading record: %s", err)
+	}
+
+	record := &pb.TapRecord{}
+	if err := proto.Unmarshal(bytes, record); err != nil {
+		return nil, fmt.Errorf("error while decoding record: %s", err)
+	}
+	return record, nil
+}
+
+type recordingClient struct {
+	pb.ApiClient
+	recorder *Recorder
+}
+
+// NewRecordingClient returns a Public API client that records the events of
+// every TapByResource stream it opens with recorder, and otherwise behaves
+// like client.
+func NewRecordingClient(client pb.ApiClient, recorder *Recorder) pb.ApiClient {
+	return &recordingClient{ApiClient: client, recorder: recorder}
+}
+
+func (c *recordingClient) TapByResource(ctx context.Context, req *pb.TapByResourceRequest, opts ...grpc.CallOption) (pb.Api_TapByResourceClient, error) {
+	stream, err := c.ApiClient.TapByResource(ctx, req, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingStream{Api_TapByResourceClient: stream, recorder: c.recorder}, nil
+}
+
+type recordingStream struct {
+	pb.Api_TapByResourceClient
+	recorder *Recorder
+}
+
+func (s *recordingStream) Recv() (*pb.TapEvent, error) {
+	event, err := s.Api_TapByResourceClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.recorder.Record(event); err != nil {
+		return nil, fmt.Errorf("failed to record tap event: %s", err)
+	}
+	return event, nil
+}
+
+type replayClient struct {
+	pb.ApiClient
+	path string
+}
+
+// NewReplayClient returns a Public API client whose TapByResource streams
+// replay the events recorded in the file at path, filtered according to the
+// request as the tap server would. It only supports TapByResource.
+func NewReplayClient(path string) pb.ApiClient {
+	return &replayClient{path: path}
+}
+
+func (c *replayClient) TapByResource(ctx context.Context, req *pb.TapByResourceRequest, _ ...grpc.CallOption) (pb.Api_TapByResourceClient, error) {
+	filter, err := newEventFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replayStream{
+		ctx:            ctx,
+		file:           file,
+		replayer:       NewReplayer(file),
+		filter:         filter,
+		responseFilter: NewResponseFilter(req.GetMatch()),
+		recorded:       make(map[*pb.TapEvent]time.Time),
+	}, nil
+}
+
+type replayStream struct {
+	grpc.ClientStream
+	ctx            context.Context
+	file           *os.File
+	replayer       *Replayer
+	filter         *eventFilter
+	responseFilter *ResponseFilter
+	released       []*pb.TapEvent
+
+	// recorded holds the time at which the events held back by
+	// responseFilter were recorded. The events it drops are only forgotten
+	// once recorded has doubled in size since they were last forgotten.
+	recorded     map[*pb.TapEvent]time.Time
+	recordedSize int
+
+	sync.Mutex
+	// received is the time at which the event last returned by Recv was
+	// recorded.
+	received time.Time
+}
+
+func (s *replayStream) Recv() (*pb.TapEvent, error) {
+	for len(s.released) == 0 {
+		if err := s.ctx.Err(); err != nil {
+			s.file.Close()
+			return nil, err
+		}
+
+		record, err := s.replayer.Next()
+		if err != nil {
+			s.file.Close()
+			return nil, err
+		}
+		if s.filter.matches(record.GetEvent()) {
+			if timestamp, err := ptypes.Timestamp(record.GetTimestamp()); err == nil {
+				s.recorded[record.GetEvent()] = timestamp
+			}
+			s.released = s.responseFilter.Filter(record.GetEvent())
+			if len(s.recorded) > 2*s.recordedSize {
+				s.forgetDropped()
+			}
+		}
+	}
+
+	event := s.released[0]
+	s.released = s.released[1:]
+	if timestamp, ok := s.recorded[event]; ok {
+		delete(s.recorded, event)
+		s.Lock()
+		s.received = timestamp
+		s.Unlock()
+	}
+	return event, nil
+}
+
+// forgetDropped forgets the time at which the events that responseFilter
+// has dropped were recorded.
+func (s *replayStream) forgetDropped() {
+	held := s.responseFilter.held()
+	for _, event := range s.released {
+		held[event] = true
+	}
+	for event := range s.recorded {
+		if !held[event] {
+			delete(s.recorded, event)
+		}
+	}
+	s.recordedSize = len(s.recorded)
+}
+
+func (s *replayStream) now() time.Time {
+	s.Lock()
+	defer s.Unlock()
+	return s.received
+}
+
+// Clock returns a func that reports the time at which the event last
+// received from stream arrived. For streams that replay a recording, this is
+// the time at which the event was recorded, so that replays are rendered as
+// the original tap was. For other streams, it is the current time.
+func Clock(stream pb.Api_TapByResourceClient) func() time.Time {
+	if replay, ok := stream.(*replayStream); ok {
+		return replay.now
+	}
+	return time.Now
+}
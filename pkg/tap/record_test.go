@@ -0,0 +1,331 @@
+package tap
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkerd/linkerd2/controller/api/util"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"google.golang.org/grpc"
+)
+
+// fakeTapClient is a Public API client whose TapByResource streams return
+// events. The mocks of the public API package cannot be used here, as it
+// imports this package.
+type fakeTapClient struct {
+	pb.ApiClient
+	events []pb.TapEvent
+}
+
+func (c *fakeTapClient) TapByResource(context.Context, *pb.TapByResourceRequest, ...grpc.CallOption) (pb.Api_TapByResourceClient, error) {
+	return &fakeTapStream{events: c.events}, nil
+}
+
+type fakeTapStream struct {
+	grpc.ClientStream
+	events []pb.TapEvent
+}
+
+func (s *fakeTapStream) Recv() (*pb.TapEvent, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	event := s.events[0]
+	s.events = s.events[1:]
+	return &event, nil
+}
+
+func tapEvent(stream uint64, direction pb.TapEvent_ProxyDirection, src, dst map[string]string, http *pb.TapEvent_Http) pb.TapEvent {
+	return pb.TapEvent{
+		Source:          &pb.TcpAddress{Ip: &pb.IPAddress{Ip: &pb.IPAddress_Ipv4{Ipv4: uint32(stream)}}},
+		SourceMeta:      &pb.TapEvent_EndpointMeta{Labels: src},
+		Destination:     &pb.TcpAddress{Ip: &pb.IPAddress{Ip: &pb.IPAddress_Ipv4{Ipv4: 9}}},
+		DestinationMeta: &pb.TapEvent_EndpointMeta{Labels: dst},
+		ProxyDirection:  direction,
+		Event:           &pb.TapEvent_Http_{Http: http},
+	}
+}
+
+func requestInit(stream uint64, method pb.HttpMethod_Registered, path string) *pb.TapEvent_Http {
+	return &pb.TapEvent_Http{
+		Event: &pb.TapEvent_Http_RequestInit_{
+			RequestInit: &pb.TapEvent_Http_RequestInit{
+				Id:     &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+				Method: &pb.HttpMethod{Type: &pb.HttpMethod_Registered_{Registered: method}},
+				Path:   path,
+			},
+		},
+	}
+}
+
+func responseEnd(stream uint64) *pb.TapEvent_Http {
+	return &pb.TapEvent_Http{
+		Event: &pb.TapEvent_Http_ResponseEnd_{
+			ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+				Id: &pb.TapEvent_Http_StreamId{Base: 1, Stream: stream},
+			},
+		},
+	}
+}
+
+func recordedEvents() []pb.TapEvent {
+	web := map[string]string{"deployment": "web", "namespace": "emojivoto", "pod": "web-5b9d4b5f8f-x8h9k"}
+	voting := map[string]string{"deployment": "voting", "namespace": "emojivoto"}
+	emoji := map[string]string{"deployment": "emoji", "namespace": "emojivoto"}
+	vote := map[string]string{"deployment": "vote-bot", "namespace": "emojivoto"}
+
+	return []pb.TapEvent{
+		tapEvent(1, pb.TapEvent_OUTBOUND, web, voting, requestInit(1, pb.HttpMethod_POST, "/emojivoto.v1.VotingService/VoteDoughnut")),
+		tapEvent(2, pb.TapEvent_OUTBOUND, web, emoji, requestInit(2, pb.HttpMethod_POST, "/emojivoto.v1.EmojiService/ListAll")),
+		tapEvent(3, pb.TapEvent_INBOUND, vote, web, requestInit(3, pb.HttpMethod_GET, "/api/list")),
+		tapEvent(4, pb.TapEvent_OUTBOUND, vote, web, requestInit(4, pb.HttpMethod_GET, "/api/vote")),
+		tapEvent(1, pb.TapEvent_OUTBOUND, web, voting, responseEnd(1)),
+		tapEvent(2, pb.TapEvent_OUTBOUND, web, emoji, responseEnd(2)),
+		tapEvent(3, pb.TapEvent_INBOUND, vote, web, responseEnd(3)),
+		tapEvent(4, pb.TapEvent_OUTBOUND, vote, web, responseEnd(4)),
+	}
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tap-record")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "capture.tap")
+
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	events := recordedEvents()
+	client := NewRecordingClient(&fakeTapClient{events: events}, NewRecorder(file))
+
+	stream, err := client.TapByResource(context.Background(), &pb.TapByResourceRequest{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+	}
+	file.Close()
+
+	expectations := []struct {
+		params   util.TapRequestParams
+		expected []int
+	}{
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", Namespace: "emojivoto"},
+			expected: []int{0, 1, 2, 4, 5, 6},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy", Namespace: "emojivoto"},
+			expected: []int{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", Resources: []string{"deploy/vote-bot"}, Namespace: "emojivoto"},
+			expected: []int{0, 1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", Namespace: "emojivoto", ToResource: "deploy/voting"},
+			expected: []int{0, 4},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "ns/emojivoto", Method: "get"},
+			expected: []int{2, 3, 6, 7},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/vote-bot", Namespace: "emojivoto", Path: "/api/vo"},
+			expected: []int{3, 7},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", Namespace: "default"},
+			expected: []int{},
+		},
+	}
+
+	for _, exp := range expectations {
+		req, err := util.BuildTapByResourceRequest(exp.params)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		stream, err := NewReplayClient(path).TapByResource(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		replayed := []*pb.TapEvent{}
+		for {
+			event, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			replayed = append(replayed, event)
+		}
+
+		if len(replayed) != len(exp.expected) {
+			t.Fatalf("Expected %d events for %+v, got %d: %+v", len(exp.expected), exp.params, len(replayed), replayed)
+		}
+		for i, idx := range exp.expected {
+			if !proto.Equal(replayed[i], &events[idx]) {
+				t.Fatalf("Expected event %d for %+v to be %+v, got %+v", i, exp.params, events[idx], replayed[i])
+			}
+		}
+	}
+}
+
+func TestReplayClock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tap-record")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ok := stream(1, 200, 0, 10*time.Millisecond)
+	failed := stream(2, 503, 0, 600*time.Millisecond)
+	interleaved := []pb.TapEvent{}
+	for i := 0; i < 3; i++ {
+		interleaved = append(interleaved, ok[i], failed[i])
+	}
+
+	expectations := []struct {
+		events   []pb.TapEvent
+		params   util.TapRequestParams
+		expected []int
+	}{
+		{
+			events:   recordedEvents(),
+			params:   util.TapRequestParams{Resource: "deploy/web", Namespace: "emojivoto"},
+			expected: []int{0, 1, 2, 4, 5, 6},
+		},
+		{
+			// the events of a stream are held back until its response ends,
+			// and are then received at the time they were recorded
+			events:   interleaved,
+			params:   util.TapRequestParams{Resource: "deploy/web", Namespace: "emojivoto", Status: "503"},
+			expected: []int{1, 3, 5},
+		},
+	}
+
+	captured := time.Date(2019, time.April, 1, 12, 0, 0, 0, time.UTC)
+	for i, exp := range expectations {
+		path := filepath.Join(dir, fmt.Sprintf("capture-%d.tap", i))
+		file, err := os.Create(path)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		recorder := NewRecorder(file)
+		for j := range exp.events {
+			recorder.now = func() time.Time { return captured.Add(time.Duration(j) * time.Second) }
+			if err := recorder.Record(&exp.events[j]); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+		file.Close()
+
+		req, err := util.BuildTapByResourceRequest(exp.params)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		stream, err := NewReplayClient(path).TapByResource(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		now := Clock(stream)
+		for _, idx := range exp.expected {
+			if _, err := stream.Recv(); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if expected := captured.Add(time.Duration(idx) * time.Second); !now().Equal(expected) {
+				t.Fatalf("Expected event %d for %+v to be received at %s, got %s", idx, exp.params, expected, now())
+			}
+		}
+		if _, err := stream.Recv(); err != io.EOF {
+			t.Fatalf("Expected EOF for %+v, got %v", exp.params, err)
+		}
+	}
+
+	if now := Clock(&fakeTapStream{})(); time.Since(now) > time.Minute {
+		t.Fatalf("Expected live streams to be received at the current time, got %s", now)
+	}
+}
+
+func TestReplayer(t *testing.T) {
+	t.Run("Reads timestamped records", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		recorder := NewRecorder(buf)
+		events := recordedEvents()
+		for i := range events {
+			if err := recorder.Record(&events[i]); err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+		}
+
+		replayer := NewReplayer(buf)
+		for i := range events {
+			record, err := replayer.Next()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if record.GetTimestamp() == nil {
+				t.Fatalf("Expected record %d to be timestamped", i)
+			}
+			if !proto.Equal(record.GetEvent(), &events[i]) {
+				t.Fatalf("Expected record %d to hold %+v, got %+v", i, events[i], record.GetEvent())
+			}
+		}
+		if _, err := replayer.Next(); err != io.EOF {
+			t.Fatalf("Expected EOF, got %v", err)
+		}
+	})
+
+	t.Run("Rejects truncated recordings", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		events := recordedEvents()
+		if err := NewRecorder(buf).Record(&events[0]); err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		replayer := NewReplayer(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+		if _, err := replayer.Next(); err == nil || err == io.EOF {
+			t.Fatalf("Expected an error for a truncated record, got %v", err)
+		}
+	})
+
+	t.Run("Rejects oversized record lengths", func(t *testing.T) {
+		// a length prefix of 0xffffffff, followed by a few bytes of garbage
+		recording := []byte{0xff, 0xff, 0xff, 0xff, 0x0a, 0x0b, 0x0c}
+
+		replayer := NewReplayer(bytes.NewReader(recording))
+		if _, err := replayer.Next(); err == nil || err == io.EOF {
+			t.Fatalf("Expected an error for an oversized record, got %v", err)
+		}
+	})
+
+	t.Run("Rejects label selectors", func(t *testing.T) {
+		req, err := util.BuildTapByResourceRequest(util.TapRequestParams{Resource: "deploy/web", LabelSelector: "app=web"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if _, err := NewReplayClient("capture.tap").TapByResource(context.Background(), req); err == nil {
+			t.Fatal("Expected label selectors to be rejected")
+		}
+	})
+}
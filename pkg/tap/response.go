@@ -0,0 +1,178 @@
+package tap
+
+import (
+	"container/list"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+	"github.com/linkerd/linkerd2/pkg/addr"
+)
+
+// maxPendingStreams bounds how many streams a ResponseFilter holds back at
+// once. When a stream starts while it is full, the oldest pending stream,
+// which is the likeliest never to end, is dropped to make room for it.
+const maxPendingStreams = 10000
+
+// ResponseFilter holds back the events of each HTTP stream until its response
+// has ended, and then releases them if the response matches every response
+// clause of a TapByResourceRequest match.
+type ResponseFilter struct {
+	clauses []*pb.TapByResourceRequest_Match_Response
+	pending map[streamKey]*list.Element
+	// byAge holds the pending streams, oldest first
+	byAge *list.List
+}
+
+type pendingStream struct {
+	key    streamKey
+	events []*pb.TapEvent
+}
+
+// ResponseMatches returns the response clauses of match, which must be a
+// flat `All` sequence as built by BuildTapByResourceRequest.
+func ResponseMatches(match *pb.TapByResourceRequest_Match) []*pb.TapByResourceRequest_Match_Response {
+	clauses := []*pb.TapByResourceRequest_Match_Response{}
+	for _, m := range match.GetAll().GetMatches() {
+		if response := m.GetResponse(); response != nil {
+			clauses = append(clauses, response)
+		}
+	}
+	return clauses
+}
+
+// ValidateResponseMatch returns an error if a response clause can never
+// match.
+func ValidateResponseMatch(clause *pb.TapByResourceRequest_Match_Response) error {
+	switch typed := clause.GetMatch().(type) {
+	case *pb.TapByResourceRequest_Match_Response_Status:
+		if typed.Status.GetMin() > typed.Status.GetMax() {
+			return fmt.Errorf("invalid status range %d-%d", typed.Status.GetMin(), typed.Status.GetMax())
+		}
+	case *pb.TapByResourceRequest_Match_Response_SlowerThan:
+		if _, err := ptypes.Duration(typed.SlowerThan); err != nil {
+			return fmt.Errorf("invalid latency threshold: %s", err)
+		}
+	case *pb.TapByResourceRequest_Match_Response_GrpcStatus:
+	default:
+		return fmt.Errorf("unknown response match type: %v", typed)
+	}
+	return nil
+}
+
+// NewResponseFilter returns a ResponseFilter for the response clauses of
+// match. If there are none, it lets every event through as it is received.
+func NewResponseFilter(match *pb.TapByResourceRequest_Match) *ResponseFilter {
+	return &ResponseFilter{
+		clauses: ResponseMatches(match),
+		pending: make(map[streamKey]*list.Element),
+		byAge:   list.New(),
+	}
+}
+
+// Filter takes the next tap event and returns the events that are released
+// by it, in the order in which they were received. Events other than HTTP
+// events are released as they are received.
+func (f *ResponseFilter) Filter(event *pb.TapEvent) []*pb.TapEvent {
+	if len(f.clauses) == 0 || event.GetHttp() == nil {
+		return []*pb.TapEvent{event}
+	}
+
+	key := streamKey{
+		src: addr.PublicAddressToString(event.GetSource()),
+		dst: addr.PublicAddressToString(event.GetDestination()),
+	}
+
+	switch ev := event.GetHttp().GetEvent().(type) {
+	case *pb.TapEvent_Http_RequestInit_:
+		key.base, key.stream = ev.RequestInit.GetId().GetBase(), ev.RequestInit.GetId().GetStream()
+		if elem, ok := f.pending[key]; ok {
+			f.byAge.Remove(elem)
+		} else if f.byAge.Len() >= maxPendingStreams {
+			oldest := f.byAge.Remove(f.byAge.Front()).(*pendingStream)
+			delete(f.pending, oldest.key)
+		}
+		f.pending[key] = f.byAge.PushBack(&pendingStream{key: key, events: []*pb.TapEvent{event}})
+
+	case *pb.TapEvent_Http_ResponseInit_:
+		key.base, key.stream = ev.ResponseInit.GetId().GetBase(), ev.ResponseInit.GetId().GetStream()
+		if elem, ok := f.pending[key]; ok {
+			stream := elem.Value.(*pendingStream)
+			stream.events = append(stream.events, event)
+		}
+
+	case *pb.TapEvent_Http_ResponseEnd_:
+		key.base, key.stream = ev.ResponseEnd.GetId().GetBase(), ev.ResponseEnd.GetId().GetStream()
+		elem, ok := f.pending[key]
+		if !ok {
+			return nil
+		}
+		delete(f.pending, key)
+		stream := f.byAge.Remove(elem).(*pendingStream)
+
+		events := append(stream.events, event)
+		if f.matches(events) {
+			return events
+		}
+	}
+
+	return nil
+}
+
+// held returns the events held back by the filter, pending the end of their
+// stream.
+func (f *ResponseFilter) held() map[*pb.TapEvent]bool {
+	held := make(map[*pb.TapEvent]bool)
+	for elem := f.byAge.Front(); elem != nil; elem = elem.Next() {
+		for _, event := range elem.Value.(*pendingStream).events {
+			held[event] = true
+		}
+	}
+	return held
+}
+
+func (f *ResponseFilter) matches(events []*pb.TapEvent) bool {
+	var rspInit *pb.TapEvent_Http_ResponseInit
+	var rspEnd *pb.TapEvent_Http_ResponseEnd
+	for _, event := range events {
+		switch ev := event.GetHttp().GetEvent().(type) {
+		case *pb.TapEvent_Http_ResponseInit_:
+			rspInit = ev.ResponseInit
+		case *pb.TapEvent_Http_ResponseEnd_:
+			rspEnd = ev.ResponseEnd
+		}
+	}
+
+	for _, clause := range f.clauses {
+		switch typed := clause.GetMatch().(type) {
+		case *pb.TapByResourceRequest_Match_Response_Status:
+			// streams reset before a response was received have no status
+			if rspInit == nil ||
+				rspInit.GetHttpStatus() < typed.Status.GetMin() ||
+				rspInit.GetHttpStatus() > typed.Status.GetMax() {
+				return false
+			}
+
+		case *pb.TapByResourceRequest_Match_Response_GrpcStatus:
+			grpcStatus, ok := rspEnd.GetEos().GetEnd().(*pb.Eos_GrpcStatusCode)
+			if !ok || grpcStatus.GrpcStatusCode != typed.GrpcStatus {
+				return false
+			}
+
+		case *pb.TapByResourceRequest_Match_Response_SlowerThan:
+			threshold, err := ptypes.Duration(typed.SlowerThan)
+			if err != nil {
+				return false
+			}
+			latency, err := ptypes.Duration(rspEnd.GetSinceRequestInit())
+			if err != nil || latency <= threshold {
+				return false
+			}
+
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,141 @@
+package tap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/linkerd/linkerd2/controller/api/util"
+	pb "github.com/linkerd/linkerd2/controller/gen/public"
+)
+
+func stream(id uint64, status uint32, grpcStatus uint32, latency time.Duration) []pb.TapEvent {
+	src := map[string]string{"deployment": "web", "namespace": "emojivoto"}
+	dst := map[string]string{"deployment": "voting", "namespace": "emojivoto"}
+
+	rspInit := &pb.TapEvent_Http{
+		Event: &pb.TapEvent_Http_ResponseInit_{
+			ResponseInit: &pb.TapEvent_Http_ResponseInit{
+				Id:         &pb.TapEvent_Http_StreamId{Base: 1, Stream: id},
+				HttpStatus: status,
+			},
+		},
+	}
+	rspEnd := &pb.TapEvent_Http{
+		Event: &pb.TapEvent_Http_ResponseEnd_{
+			ResponseEnd: &pb.TapEvent_Http_ResponseEnd{
+				Id:               &pb.TapEvent_Http_StreamId{Base: 1, Stream: id},
+				SinceRequestInit: ptypes.DurationProto(latency),
+				Eos:              &pb.Eos{End: &pb.Eos_GrpcStatusCode{GrpcStatusCode: grpcStatus}},
+			},
+		},
+	}
+
+	return []pb.TapEvent{
+		tapEvent(id, pb.TapEvent_OUTBOUND, src, dst, requestInit(id, pb.HttpMethod_POST, "/emojivoto.v1.VotingService/VoteDoughnut")),
+		tapEvent(id, pb.TapEvent_OUTBOUND, src, dst, rspInit),
+		tapEvent(id, pb.TapEvent_OUTBOUND, src, dst, rspEnd),
+	}
+}
+
+func TestResponseFilter(t *testing.T) {
+	ok := stream(1, 200, 0, 10*time.Millisecond)
+	slow := stream(2, 200, 0, 800*time.Millisecond)
+	unavailable := stream(3, 200, 14, 20*time.Millisecond)
+	failed := stream(4, 503, 0, 600*time.Millisecond)
+
+	// interleave the streams, as a proxy would report them
+	events := []pb.TapEvent{}
+	for i := 0; i < 3; i++ {
+		events = append(events, ok[i], slow[i], unavailable[i], failed[i])
+	}
+
+	expectations := []struct {
+		params   util.TapRequestParams
+		expected [][]pb.TapEvent
+	}{
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web"},
+			expected: [][]pb.TapEvent{events},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", Status: "5xx"},
+			expected: [][]pb.TapEvent{failed},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", SlowerThan: 500 * time.Millisecond},
+			expected: [][]pb.TapEvent{slow, failed},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", Status: "2xx", SlowerThan: 500 * time.Millisecond},
+			expected: [][]pb.TapEvent{slow},
+		},
+		{
+			params:   util.TapRequestParams{Resource: "deploy/web", GrpcStatus: "Unavailable"},
+			expected: [][]pb.TapEvent{unavailable},
+		},
+	}
+
+	for _, exp := range expectations {
+		req, err := util.BuildTapByResourceRequest(exp.params)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		filter := NewResponseFilter(req.GetMatch())
+		released := []*pb.TapEvent{}
+		for i := range events {
+			released = append(released, filter.Filter(&events[i])...)
+		}
+
+		expected := []pb.TapEvent{}
+		for _, s := range exp.expected {
+			expected = append(expected, s...)
+		}
+		if len(released) != len(expected) {
+			t.Fatalf("Expected %d events for %+v, got %d", len(expected), exp.params, len(released))
+		}
+		for i := range expected {
+			if !proto.Equal(released[i], &expected[i]) {
+				t.Fatalf("Expected event %d for %+v to be %+v, got %+v", i, exp.params, expected[i], released[i])
+			}
+		}
+	}
+}
+
+func TestResponseFilterEvictsOldestPendingStreams(t *testing.T) {
+	req, err := util.BuildTapByResourceRequest(util.TapRequestParams{Resource: "deploy/web", Status: "5xx"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	filter := NewResponseFilter(req.GetMatch())
+
+	// fill the filter with streams that have not ended, such as long-lived
+	// gRPC streams
+	neverEnding := []pb.TapEvent{}
+	for id := uint64(1); id <= maxPendingStreams; id++ {
+		neverEnding = append(neverEnding, stream(id, 503, 0, time.Second)...)
+		filter.Filter(&neverEnding[len(neverEnding)-3])
+	}
+
+	failed := stream(maxPendingStreams+1, 503, 0, time.Second)
+	released := []*pb.TapEvent{}
+	for i := range failed {
+		released = append(released, filter.Filter(&failed[i])...)
+	}
+	if len(released) != len(failed) {
+		t.Fatalf("Expected the new stream's %d events to be released, got %d", len(failed), len(released))
+	}
+
+	// the oldest stream made room for the new one, while the next oldest is
+	// still pending
+	filter.Filter(&neverEnding[1])
+	if released := filter.Filter(&neverEnding[2]); len(released) != 0 {
+		t.Fatalf("Expected the oldest stream to have been evicted, got %d events", len(released))
+	}
+	filter.Filter(&neverEnding[4])
+	if released := filter.Filter(&neverEnding[5]); len(released) != 3 {
+		t.Fatalf("Expected the second oldest stream to still be pending, got %d events", len(released))
+	}
+}
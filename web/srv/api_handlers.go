@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/golang/protobuf/jsonpb"
@@ -26,6 +27,7 @@ type (
 
 var (
 	defaultResourceType = k8s.Deployment
+	trueStr             = fmt.Sprintf("%t", true)
 	pbMarshaler         = jsonpb.Marshaler{EmitDefaults: true}
 	maxMessageSize      = 2048
 	websocketUpgrader   = websocket.Upgrader{
@@ -71,14 +73,36 @@ func (h *handler) handleAPIVersion(w http.ResponseWriter, req *http.Request, p h
 }
 
 func (h *handler) handleAPIPods(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
-	pods, err := h.apiClient.ListPods(req.Context(), &pb.ListPodsRequest{
+	listReq := &pb.ListPodsRequest{
 		Selector: &pb.ResourceSelection{
 			Resource: &pb.Resource{
 				Namespace: req.FormValue("namespace"),
 			},
 		},
-	})
+		PageToken:    req.FormValue("page_token"),
+		ProxyVersion: req.FormValue("proxy_version"),
+	}
+	if limit := req.FormValue("limit"); limit != "" {
+		l, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			renderJSONError(w, err, http.StatusBadRequest)
+			return
+		}
+		listReq.Limit = uint32(l)
+	}
+	if meshed := req.FormValue("meshed"); meshed != "" {
+		m, err := strconv.ParseBool(meshed)
+		if err != nil {
+			renderJSONError(w, err, http.StatusBadRequest)
+			return
+		}
+		listReq.Meshed = pb.ListPodsRequest_FALSE
+		if m {
+			listReq.Meshed = pb.ListPodsRequest_TRUE
+		}
+	}
 
+	pods, err := h.apiClient.ListPods(req.Context(), listReq)
 	if err != nil {
 		renderJSONError(w, err, http.StatusInternalServerError)
 		return
@@ -100,25 +124,27 @@ func (h *handler) handleAPIServices(w http.ResponseWriter, req *http.Request, p
 	renderJSONPb(w, services)
 }
 
-func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
-	trueStr := fmt.Sprintf("%t", true)
-
+// statSummaryRequest builds a StatSummaryRequest from the query parameters
+// shared by the tps-reports endpoints.
+func statSummaryRequest(req *http.Request) (*pb.StatSummaryRequest, error) {
 	requestParams := util.StatsSummaryRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:    req.FormValue("window"),
-			ResourceName:  req.FormValue("resource_name"),
-			ResourceType:  req.FormValue("resource_type"),
-			Namespace:     req.FormValue("namespace"),
-			AllNamespaces: req.FormValue("all_namespaces") == trueStr,
+			TimeWindow:       req.FormValue("window"),
+			ResourceName:     req.FormValue("resource_name"),
+			ResourceType:     req.FormValue("resource_type"),
+			Namespace:        req.FormValue("namespace"),
+			AllNamespaces:    req.FormValue("all_namespaces") == trueStr,
+			LatencyHistogram: req.FormValue("latency_histogram") == trueStr,
 		},
-		ToName:        req.FormValue("to_name"),
-		ToType:        req.FormValue("to_type"),
-		ToNamespace:   req.FormValue("to_namespace"),
-		FromName:      req.FormValue("from_name"),
-		FromType:      req.FormValue("from_type"),
-		FromNamespace: req.FormValue("from_namespace"),
-		SkipStats:     req.FormValue("skip_stats") == trueStr,
-		TCPStats:      req.FormValue("tcp_stats") == trueStr,
+		ToName:          req.FormValue("to_name"),
+		ToType:          req.FormValue("to_type"),
+		ToNamespace:     req.FormValue("to_namespace"),
+		FromName:        req.FormValue("from_name"),
+		FromType:        req.FormValue("from_type"),
+		FromNamespace:   req.FormValue("from_namespace"),
+		SkipStats:       req.FormValue("skip_stats") == trueStr,
+		TCPStats:        req.FormValue("tcp_stats") == trueStr,
+		StatusBreakdown: req.FormValue("status_breakdown") == trueStr,
 	}
 
 	// default to returning deployment stats
@@ -126,7 +152,11 @@ func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p http
 		requestParams.ResourceType = defaultResourceType
 	}
 
-	statRequest, err := util.BuildStatSummaryRequest(requestParams)
+	return util.BuildStatSummaryRequest(requestParams)
+}
+
+func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
+	statRequest, err := statSummaryRequest(req)
 	if err != nil {
 		renderJSONError(w, err, http.StatusInternalServerError)
 		return
@@ -140,13 +170,54 @@ func (h *handler) handleAPIStat(w http.ResponseWriter, req *http.Request, p http
 	renderJSONPb(w, result)
 }
 
+func (h *handler) handleAPIStatHistory(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
+	requestParams := util.StatTimeSeriesRequestParams{
+		StatsSummaryRequestParams: util.StatsSummaryRequestParams{
+			StatsBaseRequestParams: util.StatsBaseRequestParams{
+				TimeWindow:    req.FormValue("window"),
+				ResourceName:  req.FormValue("resource_name"),
+				ResourceType:  req.FormValue("resource_type"),
+				Namespace:     req.FormValue("namespace"),
+				AllNamespaces: req.FormValue("all_namespaces") == trueStr,
+			},
+			ToName:        req.FormValue("to_name"),
+			ToType:        req.FormValue("to_type"),
+			ToNamespace:   req.FormValue("to_namespace"),
+			FromName:      req.FormValue("from_name"),
+			FromType:      req.FormValue("from_type"),
+			FromNamespace: req.FormValue("from_namespace"),
+		},
+		Range: req.FormValue("range"),
+		Step:  req.FormValue("step"),
+	}
+
+	// default to returning deployment stats
+	if requestParams.ResourceType == "" {
+		requestParams.ResourceType = defaultResourceType
+	}
+
+	historyRequest, err := util.BuildStatTimeSeriesRequest(requestParams)
+	if err != nil {
+		renderJSONError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.apiClient.StatTimeSeries(req.Context(), historyRequest)
+	if err != nil {
+		renderJSONError(w, err, http.StatusInternalServerError)
+		return
+	}
+	renderJSONPb(w, result)
+}
+
 func (h *handler) handleAPITopRoutes(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
 	requestParams := util.TopRoutesRequestParams{
 		StatsBaseRequestParams: util.StatsBaseRequestParams{
-			TimeWindow:   req.FormValue("window"),
-			ResourceName: req.FormValue("resource_name"),
-			ResourceType: req.FormValue("resource_type"),
-			Namespace:    req.FormValue("namespace"),
+			TimeWindow:       req.FormValue("window"),
+			ResourceName:     req.FormValue("resource_name"),
+			ResourceType:     req.FormValue("resource_type"),
+			Namespace:        req.FormValue("namespace"),
+			LatencyHistogram: req.FormValue("latency_histogram") == trueStr,
 		},
 		ToName:      req.FormValue("to_name"),
 		ToType:      req.FormValue("to_type"),
@@ -252,10 +323,79 @@ func (h *handler) handleAPITap(w http.ResponseWriter, req *http.Request, p httpr
 	}
 }
 
+// handleAPIStatWatch streams a refreshed stat table over a websocket every
+// `interval`, for the same query parameters as handleAPIStat.
+func (h *handler) handleAPIStatWatch(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
+	statRequest, err := statSummaryRequest(req)
+	if err != nil {
+		renderJSONError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	ws, err := websocketUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		renderJSONError(w, err, http.StatusInternalServerError)
+		return
+	}
+	defer ws.Close()
+
+	watchReq := &pb.WatchStatSummaryRequest{
+		Request:  statRequest,
+		Interval: req.FormValue("interval"),
+	}
+
+	go func() {
+		watchClient, err := h.apiClient.WatchStatSummary(req.Context(), watchReq)
+		if err != nil {
+			websocketError(ws, websocket.CloseInternalServerErr, err.Error())
+			return
+		}
+		defer watchClient.CloseSend()
+
+		for {
+			rsp, err := watchClient.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				websocketError(ws, websocket.CloseInternalServerErr, err.Error())
+				break
+			}
+
+			buf := new(bytes.Buffer)
+			err = pbMarshaler.Marshal(buf, rsp)
+			if err != nil {
+				websocketError(ws, websocket.CloseInternalServerErr, err.Error())
+				break
+			}
+
+			if err := ws.WriteMessage(websocket.TextMessage, buf.Bytes()); err != nil {
+				if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure) {
+					log.Error(err)
+				}
+				break
+			}
+		}
+	}()
+
+	for {
+		_, _, err := ws.ReadMessage()
+		if err != nil {
+			log.Debugf("Received close frame: %v", err)
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure) {
+				log.Errorf("Unexpected close error: %s", err)
+			}
+			return
+		}
+	}
+}
+
 func (h *handler) handleAPIEdges(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
 	requestParams := util.EdgesRequestParams{
-		Namespace:    req.FormValue("namespace"),
-		ResourceType: req.FormValue("resource_type"),
+		Namespace:     req.FormValue("namespace"),
+		ResourceType:  req.FormValue("resource_type"),
+		TimeWindow:    req.FormValue("window"),
+		AllNamespaces: req.FormValue("all_namespaces") == fmt.Sprintf("%t", true),
 	}
 
 	edgesRequest, err := util.BuildEdgesRequest(requestParams)
@@ -271,3 +411,17 @@ func (h *handler) handleAPIEdges(w http.ResponseWriter, req *http.Request, p htt
 	}
 	renderJSONPb(w, result)
 }
+
+func (h *handler) handleAPISLOs(w http.ResponseWriter, req *http.Request, p httprouter.Params) {
+	sloStatusRequest := &pb.SLOStatusRequest{
+		Namespace: req.FormValue("namespace"),
+		Name:      req.FormValue("name"),
+	}
+
+	result, err := h.apiClient.SLOStatus(req.Context(), sloStatusRequest)
+	if err != nil {
+		renderJSONError(w, err, http.StatusInternalServerError)
+		return
+	}
+	renderJSONPb(w, result)
+}
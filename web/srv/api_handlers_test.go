@@ -53,3 +53,31 @@ func TestHandleApiVersion(t *testing.T) {
 		t.Errorf("Expected to find: %+v", expectedVersionJSON)
 	}
 }
+
+func TestHandleApiPods(t *testing.T) {
+	expectations := []struct {
+		query        string
+		expectedCode int
+	}{
+		{"", http.StatusOK},
+		{"?meshed=true", http.StatusOK},
+		{"?meshed=1", http.StatusOK},
+		{"?meshed=false", http.StatusOK},
+		{"?meshed=yes", http.StatusBadRequest},
+		{"?limit=-1", http.StatusBadRequest},
+	}
+
+	for _, exp := range expectations {
+		handler := &handler{
+			apiClient: &public.MockAPIClient{ListPodsResponseToReturn: &pb.ListPodsResponse{}},
+		}
+
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/api/pods"+exp.query, nil)
+		handler.handleAPIPods(recorder, req, httprouter.Params{})
+
+		if recorder.Code != exp.expectedCode {
+			t.Errorf("Expected /api/pods%s to return %d, got %d", exp.query, exp.expectedCode, recorder.Code)
+		}
+	}
+}
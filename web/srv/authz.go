@@ -0,0 +1,32 @@
+package srv
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/linkerd/linkerd2/controller/api/public"
+)
+
+const bearerPrefix = "Bearer "
+
+var errMissingBearerToken = errors.New("the Linkerd public API requires a Kubernetes bearer token: reach the dashboard through a proxy that authenticates users and forwards their token in the Authorization header")
+
+// withForwardedBearerToken makes the dashboard call the public API as its own
+// caller, by forwarding the bearer token the caller presented. API requests
+// without a token are rejected, rather than served with the dashboard's own
+// service account, so that each user only sees the namespaces RBAC allows
+// them to read.
+func withForwardedBearerToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		header := req.Header.Get("Authorization")
+		if strings.HasPrefix(header, bearerPrefix) {
+			token := strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+			req = req.WithContext(public.WithBearerToken(req.Context(), token))
+		} else if strings.HasPrefix(req.URL.Path, "/api/") {
+			renderJSONError(w, errMissingBearerToken, http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
@@ -0,0 +1,36 @@
+package srv
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithForwardedBearerToken(t *testing.T) {
+	expectations := []struct {
+		path          string
+		authorization string
+		expectedCode  int
+	}{
+		{"/api/pods", "Bearer user-token", http.StatusOK},
+		{"/api/pods", "", http.StatusUnauthorized},
+		{"/api/pods", "Basic dXNlcjpwYXNz", http.StatusUnauthorized},
+		{"/namespaces", "", http.StatusOK},
+		{"/dist/index_bundle.js", "", http.StatusOK},
+	}
+
+	for _, exp := range expectations {
+		handler := withForwardedBearerToken(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", exp.path, nil)
+		if exp.authorization != "" {
+			req.Header.Set("Authorization", exp.authorization)
+		}
+		handler.ServeHTTP(recorder, req)
+
+		if recorder.Code != exp.expectedCode {
+			t.Errorf("Expected %s with Authorization [%s] to return %d, got %d", exp.path, exp.authorization, exp.expectedCode, recorder.Code)
+		}
+	}
+}
@@ -48,7 +48,8 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 
 // NewServer returns an initialized `http.Server`, configured to listen on an
 // address, render templates, and serve static assets, for a given Linkerd
-// control plane.
+// control plane. When enableAuthz is set, API requests must carry the
+// caller's Kubernetes bearer token, which is forwarded to the public API.
 func NewServer(
 	addr string,
 	grafanaAddr string,
@@ -57,6 +58,7 @@ func NewServer(
 	uuid string,
 	controllerNamespace string,
 	reload bool,
+	enableAuthz bool,
 	apiClient public.APIClient,
 ) *http.Server {
 	server := &Server{
@@ -70,7 +72,11 @@ func NewServer(
 		HandleMethodNotAllowed: false, // disable 405s
 	}
 
-	wrappedServer := prometheus.WithTelemetry(server)
+	var routes http.Handler = server
+	if enableAuthz {
+		routes = withForwardedBearerToken(server)
+	}
+	wrappedServer := prometheus.WithTelemetry(routes)
 	handler := &handler{
 		apiClient:           apiClient,
 		render:              server.RenderTemplate,
@@ -120,11 +126,14 @@ func NewServer(
 	// but was renamed to avoid triggering ad blockers.
 	// See: https://github.com/linkerd/linkerd2/issues/970
 	server.router.GET("/api/tps-reports", handler.handleAPIStat)
+	server.router.GET("/api/tps-reports/history", handler.handleAPIStatHistory)
+	server.router.GET("/api/tps-reports/watch", handler.handleAPIStatWatch)
 	server.router.GET("/api/pods", handler.handleAPIPods)
 	server.router.GET("/api/services", handler.handleAPIServices)
 	server.router.GET("/api/tap", handler.handleAPITap)
 	server.router.GET("/api/routes", handler.handleAPITopRoutes)
 	server.router.GET("/api/edges", handler.handleAPIEdges)
+	server.router.GET("/api/slos", handler.handleAPISLOs)
 
 	// grafana proxy
 	server.router.DELETE("/grafana/*grafanapath", handler.handleGrafana)